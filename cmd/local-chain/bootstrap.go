@@ -3,8 +3,6 @@ package main
 import (
 	"log"
 
-	"local-chain/internal/pkg/crypto"
-
 	"local-chain/internal/types"
 
 	leveldbpkg "local-chain/internal/adapters/outbound/leveldb"
@@ -13,7 +11,9 @@ import (
 	"github.com/hashicorp/raft"
 )
 
-func configureBootstrap(r *raft.Raft, store *leveldbpkg.Store, superUser *types.User) {
+var genesisTxID = uuid.MustParse("10252f31-151b-457d-b8de-e4a6f1552b62")
+
+func configureBootstrap(r *raft.Raft) {
 	configFuture := r.BootstrapCluster(raft.Configuration{
 		Servers: []raft.Server{
 			{
@@ -22,7 +22,19 @@ func configureBootstrap(r *raft.Raft, store *leveldbpkg.Store, superUser *types.
 			},
 		},
 	})
-	genesisBlock := types.NewBlock(nil, []byte("genesis"))
+	if err := configFuture.Error(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// configureGenesis stores the genesis block and transaction unless the node already has them.
+// The genesis transaction doesn't depend on the node, so all replicas start from the same UTXO set.
+func configureGenesis(store *leveldbpkg.Store, superUser *types.User) {
+	if tx, err := store.Transaction().Get(genesisTxID); err == nil {
+		storeGenesisBlockTxs(store, tx)
+		return
+	}
+	genesisBlock := types.NewBlock(0, nil, []byte("genesis"))
 	if err := store.Blockchain().Put(genesisBlock); err != nil {
 		log.Fatal(err)
 	}
@@ -32,19 +44,33 @@ func configureBootstrap(r *raft.Raft, store *leveldbpkg.Store, superUser *types.
 	if err := store.Transaction().Put(tx); err != nil {
 		log.Fatal(err)
 	}
-	for _, output := range outputs {
-		pubKey, _ := crypto.PublicKeyFromBytes(output.PubKey)
-		if err := store.Utxo().Put(crypto.PublicKeyToBytes(pubKey), types.NewUTXO(tx.ID, tx.GetHash(), 0)); err != nil {
-			log.Fatal(err)
-		}
+	if err := store.BlockTransactions().Put(types.NewBlockTxsEnvelope(genesisBlock, types.Transactions{tx})); err != nil {
+		log.Fatal(err)
 	}
-	if err := configFuture.Error(); err != nil {
+	if err := store.Utxo().Apply(tx); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// storeGenesisBlockTxs stores the genesis transaction as the transactions of the genesis block on nodes
+// created without them: raft snapshots carry the blocks with their transactions
+func storeGenesisBlockTxs(store *leveldbpkg.Store, tx *types.Transaction) {
+	if _, err := store.BlockTransactions().GetByBlockTimestamp(tx.BlockTimestamp); err == nil {
+		return
+	}
+	genesisBlock, err := store.Blockchain().GetByTimestamp(tx.BlockTimestamp)
+	if err != nil || genesisBlock == nil {
+		log.Printf("genesis block %d not found: %v", tx.BlockTimestamp, err)
+		return
+	}
+	if err = store.BlockTransactions().Put(types.NewBlockTxsEnvelope(genesisBlock, types.Transactions{tx})); err != nil {
 		log.Fatal(err)
 	}
 }
 
 func genesisTx(genesisBlock *types.Block, outputs []*types.TxOut) *types.Transaction {
 	return &types.Transaction{
+		ID:             genesisTxID,
 		BlockTimestamp: genesisBlock.Timestamp,
 		Outputs:        outputs,
 		Hash:           []byte("genesis"),
@@ -54,11 +80,11 @@ func genesisTx(genesisBlock *types.Block, outputs []*types.TxOut) *types.Transac
 func genesisOutputs(superUser *types.User) []*types.TxOut {
 	return []*types.TxOut{
 		types.NewTxOut(
-			uuid.MustParse("10252f31-151b-457d-b8de-e4a6f1552b62"),
+			genesisTxID,
 			types.Amount{
 				Value: 1_000_000_000_000,
 				Unit:  100,
 			},
-			superUser.PublicKey),
+			types.AddressOf(superUser.PublicKey)),
 	}
 }
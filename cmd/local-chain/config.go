@@ -1,19 +1,40 @@
 package main
 
 import (
+	"fmt"
 	"io"
 	"log"
 	"net"
 	"net/netip"
 	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/raft"
 )
 
+const defaultMaxBlockSize = 1 << 20
+
 type Config struct {
 	Raft         *raft.Config
 	TCPTransport *TCPTransportConfig
+	Fees         *FeesConfig
+	Tokens       *TokensConfig
+}
+
+type FeesConfig struct {
+	// MinRelayFee is the lowest fee per kilobyte of transactions accepted into the pool
+	MinRelayFee uint64
+	// MaxBlockSize is the limit of the encoded size of block transactions in bytes
+	MaxBlockSize int
+	// Collector is the PEM public key collected fees are credited to, the super user's key if not set
+	Collector []byte
+}
+
+type TokensConfig struct {
+	// Minters are the PEM public keys authorized to mint tokens, the super user's key if none is set
+	Minters [][]byte
 }
 
 type TCPTransportConfig struct {
@@ -29,6 +50,16 @@ func NewConfig(serverID raft.ServerID) (*Config, error) {
 		log.Printf("error parse raft addr: %v", err)
 		return nil, err
 	}
+	fees, err := newFeesConfig()
+	if err != nil {
+		log.Printf("error parse fees config: %v", err)
+		return nil, err
+	}
+	tokens, err := newTokensConfig()
+	if err != nil {
+		log.Printf("error parse tokens config: %v", err)
+		return nil, err
+	}
 	return &Config{
 		Raft: &raft.Config{
 			ProtocolVersion:    raft.ProtocolVersionMax,
@@ -50,5 +81,48 @@ func NewConfig(serverID raft.ServerID) (*Config, error) {
 			Timeout:   10 * time.Second,
 			LogOutput: os.Stderr,
 		},
+		Fees:   fees,
+		Tokens: tokens,
 	}, nil
 }
+
+func newFeesConfig() (*FeesConfig, error) {
+	cfg := &FeesConfig{MaxBlockSize: defaultMaxBlockSize}
+	if minRelayFee != "" {
+		fee, err := strconv.ParseUint(minRelayFee, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MIN_RELAY_FEE: %w", err)
+		}
+		cfg.MinRelayFee = fee
+	}
+	if maxBlockSize != "" {
+		size, err := strconv.Atoi(maxBlockSize)
+		if err != nil {
+			return nil, fmt.Errorf("invalid MAX_BLOCK_SIZE: %w", err)
+		}
+		cfg.MaxBlockSize = size
+	}
+	if feeCollectorKey != "" {
+		key, err := os.ReadFile(feeCollectorKey)
+		if err != nil {
+			return nil, fmt.Errorf("can't read FEE_COLLECTOR_KEY: %w", err)
+		}
+		cfg.Collector = key
+	}
+	return cfg, nil
+}
+
+func newTokensConfig() (*TokensConfig, error) {
+	cfg := &TokensConfig{}
+	if tokenMinterKeys == "" {
+		return cfg, nil
+	}
+	for _, path := range strings.Split(tokenMinterKeys, ",") {
+		key, err := os.ReadFile(strings.TrimSpace(path))
+		if err != nil {
+			return nil, fmt.Errorf("can't read TOKEN_MINTER_KEYS: %w", err)
+		}
+		cfg.Minters = append(cfg.Minters, key)
+	}
+	return cfg, nil
+}
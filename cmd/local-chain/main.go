@@ -1,12 +1,14 @@
 package main
 
 import (
+	"cmp"
 	"context"
 	"fmt"
 	"log"
 	"log/slog"
 	"os"
 	"runtime/debug"
+	"strings"
 
 	"local-chain/internal/pkg/grpc/interceptors"
 
@@ -14,8 +16,12 @@ import (
 	"local-chain/internal/adapters/inbound/grpc/mapper"
 	"local-chain/internal/adapters/outbound/inMem"
 	"local-chain/internal/pkg"
+	"local-chain/internal/pkg/coinselect"
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/pkg/keystore"
 	"local-chain/internal/runners"
 	"local-chain/internal/service"
+	"local-chain/internal/types"
 	transport2 "local-chain/transport/gen/transport"
 
 	"github.com/hashicorp/raft"
@@ -32,6 +38,15 @@ var (
 	raftAddr = os.Getenv("RAFT_ADDR")
 	grpcAddr = os.Getenv("GRPC_ADDR")
 	dbDir    = os.Getenv("DATA_DIR")
+	chainID  = cmp.Or(os.Getenv("CHAIN_ID"), types.DefaultChainID)
+
+	minRelayFee         = os.Getenv("MIN_RELAY_FEE")
+	maxBlockSize        = os.Getenv("MAX_BLOCK_SIZE")
+	feeCollectorKey     = os.Getenv("FEE_COLLECTOR_KEY")
+	tokenMinterKeys     = os.Getenv("TOKEN_MINTER_KEYS")
+	coinSelection       = cmp.Or(os.Getenv("COIN_SELECTION"), coinselect.StrategyBranchAndBound)
+	keystoreDir         = cmp.Or(os.Getenv("KEYSTORE_DIR"), dbDir+"/keystore")
+	superUserPassphrase = os.Getenv("SUPER_USER_PASSPHRASE")
 
 	logDb      = dbDir + "/log.dat"
 	stableDb   = dbDir + "/stable.dat"
@@ -71,8 +86,23 @@ func main() {
 			log.Printf("error closing store: %v", err)
 		}
 	}()
+	if err = store.IndexUsers(); err != nil {
+		log.Printf("error indexing users: %v", err)
+		return
+	}
+	keys, err := keystore.New(keystoreDir, keystore.StandardScryptN)
+	if err != nil {
+		log.Printf("error open keystore: %v", err)
+		return
+	}
+	superUser := initSuperUser(store.User(), keys)
+	// every node derives the same genesis state before raft starts applying blocks spending it
+	configureGenesis(store, superUser)
+
+	minters := tokenMinters(cfg.Tokens, superUser)
+
 	txPool := inMem.NewTxPool()
-	fsmStore := fsm.New(store, txPool)
+	fsmStore := fsm.New(store, txPool, service.NewBlockValidator(store, chainID, cfg.Fees.MaxBlockSize, minters))
 
 	logStore, err := raftboltdb.NewBoltStore(logDb)
 	if err != nil {
@@ -122,15 +152,17 @@ func main() {
 		log.Fatal(err)
 	}
 
-	user := service.NewUserService(store.User())
-	um := mapper.NewUserMapper()
-	superUser := initSuperUser(store.User())
-
 	if bootstrap {
-		configureBootstrap(r, store, superUser)
+		configureBootstrap(r)
+	}
+	coinSelector, err := coinselect.New(coinSelection)
+	if err != nil {
+		log.Fatal(err)
 	}
-	transactor := service.NewTransactor(store, txPool)
-	tm := mapper.NewTransactionMapper()
+	transactor := service.NewTransactor(store, txPool, r, chainID, cfg.Fees.MinRelayFee, coinSelector, minters, keys)
+	user := service.NewUserService(store.User(), keys, transactor, transactor, r)
+	um := mapper.NewUserMapper()
+	tm := mapper.NewTransactionMapper(user)
 	bm := mapper.NewBlockMapper()
 
 	localChainManager := grpc2.NewLocalChain(serverID, r, tm, transactor, user, um, store.Blockchain(), store.Transaction(), bm)
@@ -144,7 +176,16 @@ func main() {
 		leaderRedirectInterceptor.UnaryInterceptor(),
 	)
 
-	blockchain := service.NewBlockchain(r, store.Blockchain(), store.Transaction(), txPool)
+	// the collector is configured by its public key or its address
+	feeCollector := cmp.Or(string(cfg.Fees.Collector), string(superUser.PublicKey))
+	feeAddress, err := crypto.DecodeAddress(strings.TrimSpace(feeCollector))
+	if key, keyErr := crypto.PublicKeyFromBytes([]byte(feeCollector)); keyErr == nil {
+		feeAddress, err = crypto.PublicKeyHash(key), nil
+	}
+	if err != nil {
+		log.Fatalf("invalid fee collector: %v", err)
+	}
+	blockchain := service.NewBlockchain(r, store.Blockchain(), store.Transaction(), store.Utxo(), store.StandingOrder(), txPool, feeAddress, cfg.Fees.MaxBlockSize)
 	blockchainScheduler := runners.NewBlockchainScheduler(blockchain)
 
 	runnable := []pkg.Runner{
@@ -160,3 +201,19 @@ func main() {
 		logger.Info("runner finished successfully")
 	}
 }
+
+// tokenMinters returns the keys authorized to mint tokens, normalized the way outputs are locked to them
+func tokenMinters(cfg *TokensConfig, superUser *types.User) [][]byte {
+	keys := cfg.Minters
+	if len(keys) == 0 {
+		keys = [][]byte{superUser.PublicKey}
+	}
+	minters := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		if pubKey, err := crypto.PublicKeyFromBytes(key); err == nil {
+			key = crypto.PublicKeyToBytes(pubKey)
+		}
+		minters = append(minters, key)
+	}
+	return minters
+}
@@ -1,11 +1,13 @@
 package main
 
 import (
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
 
+	"local-chain/internal/pkg/keystore"
 	"local-chain/internal/service"
 
 	"local-chain/internal/types"
@@ -16,7 +18,10 @@ const (
 	superUserName = "admin"
 )
 
-func initSuperUser(us service.UserStore) *types.User {
+// initSuperUser registers the super user of the key files, its private key goes to the keystore
+// sealed under SUPER_USER_PASSPHRASE. Every node shares the key files, so the super user is genesis state
+// each node derives on its own: a stored super user was changed through raft and is kept as it is.
+func initSuperUser(us service.UserStore, keys service.KeyStore) *types.User {
 	privPath := filepath.Join(keysDir, fmt.Sprintf("%s-priv.pem", superUserName))
 	pubPath := filepath.Join(keysDir, fmt.Sprintf("%s-pub.pem", superUserName))
 
@@ -29,12 +34,23 @@ func initSuperUser(us service.UserStore) *types.User {
 		log.Printf("user_data_set: skip %s, can't read public key %q: %v", superUserName, pubPath, err)
 	}
 	user := &types.User{
-		Username:   superUserName,
-		PublicKey:  pubKey,
-		PrivateKey: privKey,
+		Username:  superUserName,
+		PublicKey: pubKey,
 	}
-	if err = us.Put(user); err != nil {
-		log.Printf("user_data_set: failed to add super user %s: %v", superUserName, err)
+	// the genesis state is derived from the key files, whatever key the stored super user has now
+	if _, getErr := us.Get(superUserName); getErr != nil {
+		if err = us.Put(user); err != nil {
+			log.Printf("user_data_set: failed to add super user %s: %v", superUserName, err)
+		}
+	}
+	switch {
+	case len(privKey) == 0:
+	case superUserPassphrase == "":
+		log.Printf("user_data_set: skip the key of %s, SUPER_USER_PASSPHRASE is not set", superUserName)
+	default:
+		if err = keys.Store(superUserName, privKey, superUserPassphrase); err != nil && !errors.Is(err, keystore.ErrExists) {
+			log.Printf("user_data_set: failed to store the key of %s: %v", superUserName, err)
+		}
 	}
 	return user
 }
@@ -17,6 +17,8 @@ func (bm *BlockMapper) BlockToRpc(block *types.Block) *grpcPkg.Block {
 		Timestamp:    block.Timestamp,
 		PreviousHash: block.PrevHash,
 		Hash:         block.Hash,
+		Height:       block.Height,
+		MerkleRoot:   block.MerkleRoot,
 	}
 }
 
@@ -1,27 +1,43 @@
 package mapper
 
 import (
+	"encoding/pem"
 	"fmt"
+	"math/big"
+	"strings"
 
 	"local-chain/internal/pkg/crypto"
 
 	grpcPkg "local-chain/transport/gen/transport"
 
 	"local-chain/internal/types"
+
+	"github.com/google/uuid"
 )
 
-type TransactionMapper struct{}
+// Users resolves the keys of users: the private keys the server keeps and the public keys of the registry.
+type Users interface {
+	Signer(username string) (crypto.Signer, error)
+	PublicKey(username string) (crypto.PublicKey, error)
+	AddressKey(address []byte) (crypto.PublicKey, error)
+}
+
+type TransactionMapper struct {
+	users Users
+}
 
-func NewTransactionMapper() *TransactionMapper {
-	return &TransactionMapper{}
+func NewTransactionMapper(users Users) *TransactionMapper {
+	return &TransactionMapper{
+		users: users,
+	}
 }
 
 func (tp *TransactionMapper) RpcToTransaction(req *grpcPkg.AddTransactionRequest) (*types.TransactionRequest, error) {
-	receiver, err := crypto.PublicKeyFromBytes(req.Receiver)
+	receiver, err := rpcToAddress(req.Receiver)
 	if err != nil {
-		return nil, fmt.Errorf("public key is not ECDSA")
+		return nil, err
 	}
-	sender, err := crypto.PrivateKeyFromBytes(req.Sender)
+	sender, err := tp.rpcToSigner(req.Sender)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse private key: %v", err)
 	}
@@ -30,17 +46,612 @@ func (tp *TransactionMapper) RpcToTransaction(req *grpcPkg.AddTransactionRequest
 		Sender:   sender,
 		Receiver: receiver,
 		Amount:   types.Amount{Value: req.GetAmount().GetValue(), Unit: req.GetAmount().GetUnit()},
+		Fee:      req.GetFee(),
+		LockTime: req.GetLockTime(),
+	}, nil
+}
+
+func (tp *TransactionMapper) RpcToBatchTransaction(req *grpcPkg.AddBatchTransactionRequest) (*types.BatchTransactionRequest, error) {
+	sender, err := tp.rpcToSigner(req.GetSender())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	payments, err := rpcToPayments(req.GetPayments())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BatchTransactionRequest{
+		Sender:   sender,
+		Payments: payments,
+		Fee:      req.GetFee(),
+		LockTime: req.GetLockTime(),
+	}, nil
+}
+
+func (tp *TransactionMapper) RpcToWalletTransaction(req *grpcPkg.SendFromWalletRequest) (*types.WalletTransactionRequest, error) {
+	payments, err := rpcToPayments(req.GetPayments())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.WalletTransactionRequest{
+		Username: req.GetUsername(),
+		Payments: payments,
+		Fee:      req.GetFee(),
+		LockTime: req.GetLockTime(),
+	}, nil
+}
+
+func (tp *TransactionMapper) RpcToMultisigTransaction(req *grpcPkg.CreateMultisigTransactionRequest) (*types.MultisigTransactionRequest, error) {
+	lock, err := rpcToMultisigLock(req.GetThreshold(), req.GetPubKeys())
+	if err != nil {
+		return nil, err
+	}
+	payments, err := rpcToPayments(req.GetPayments())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MultisigTransactionRequest{
+		Lock:     lock,
+		Payments: payments,
+		Fee:      req.GetFee(),
+		LockTime: req.GetLockTime(),
+	}, nil
+}
+
+func (tp *TransactionMapper) RpcToPartiallySignedTransaction(req *grpcPkg.SubmitPartiallySignedTransactionRequest) (*types.PartiallySignedTx, error) {
+	return types.DecodePartiallySignedTx(req.GetPsbt())
+}
+
+func (tp *TransactionMapper) RpcToHTLC(req *grpcPkg.CreateHTLCRequest) (*types.HTLCRequest, error) {
+	sender, err := tp.rpcToSigner(req.GetSender())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	receiver, err := tp.rpcToPublicKey(req.GetReceiver())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse receiver: %w", err)
+	}
+
+	return &types.HTLCRequest{
+		Sender:   sender,
+		Receiver: receiver,
+		Amount:   types.Amount{Value: req.GetAmount().GetValue(), Unit: req.GetAmount().GetUnit()},
+		Hash:     req.GetHash(),
+		Deadline: req.GetDeadline(),
+		Fee:      req.GetFee(),
+	}, nil
+}
+
+func (tp *TransactionMapper) RpcToHTLCClaim(req *grpcPkg.ClaimHTLCRequest) (*types.HTLCClaimRequest, error) {
+	receiver, err := tp.rpcToSigner(req.GetReceiver())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	outpoint, err := rpcToUTXO(req.GetHtlc())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.HTLCClaimRequest{
+		Receiver: receiver,
+		Outpoint: outpoint,
+		Preimage: req.GetPreimage(),
+		Fee:      req.GetFee(),
+	}, nil
+}
+
+func (tp *TransactionMapper) RpcToHTLCRefund(req *grpcPkg.RefundHTLCRequest) (*types.HTLCRefundRequest, error) {
+	sender, err := tp.rpcToSigner(req.GetSender())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	outpoint, err := rpcToUTXO(req.GetHtlc())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.HTLCRefundRequest{
+		Sender:   sender,
+		Outpoint: outpoint,
+		Fee:      req.GetFee(),
 	}, nil
 }
 
+func (tp *TransactionMapper) RpcToNotarize(req *grpcPkg.NotarizeRequest) (*types.NotarizeRequest, error) {
+	sender, err := tp.rpcToSigner(req.GetSender())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+
+	return &types.NotarizeRequest{
+		Sender: sender,
+		Data:   req.GetData(),
+		Fee:    req.GetFee(),
+	}, nil
+}
+
+func (tp *TransactionMapper) RpcToAssetIssue(req *grpcPkg.IssueAssetRequest) (*types.AssetIssueRequest, error) {
+	issuer, err := tp.rpcToSigner(req.GetIssuer())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+
+	return &types.AssetIssueRequest{
+		Issuer:   issuer,
+		Name:     req.GetName(),
+		Decimals: req.GetDecimals(),
+		Supply:   req.GetSupply(),
+		Fee:      req.GetFee(),
+	}, nil
+}
+
+func (tp *TransactionMapper) AssetToRpc(asset *types.Asset) *grpcPkg.Asset {
+	if asset == nil {
+		return nil
+	}
+	return &grpcPkg.Asset{
+		Id:       asset.ID,
+		Name:     asset.Name,
+		Decimals: asset.Decimals,
+		Supply:   asset.Supply,
+		Issuer:   asset.Issuer,
+	}
+}
+
+func (tp *TransactionMapper) RpcToTokenMint(req *grpcPkg.MintTokenRequest) (*types.TokenMintRequest, error) {
+	minter, err := tp.rpcToSigner(req.GetMinter())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	var owner []byte
+	if len(req.GetOwner()) > 0 {
+		if owner, err = rpcToAddress(req.GetOwner()); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.TokenMintRequest{
+		Minter:       minter,
+		Owner:        owner,
+		MetadataHash: req.GetMetadataHash(),
+		Fee:          req.GetFee(),
+	}, nil
+}
+
+func (tp *TransactionMapper) RpcToTokenTransfer(req *grpcPkg.TransferTokenRequest) (*types.TokenTransferRequest, error) {
+	owner, err := tp.rpcToSigner(req.GetOwner())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	receiver, err := rpcToAddress(req.GetReceiver())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.TokenTransferRequest{
+		Owner:    owner,
+		Receiver: receiver,
+		TokenID:  req.GetTokenId(),
+		Fee:      req.GetFee(),
+	}, nil
+}
+
+func (tp *TransactionMapper) TokenToRpc(token *types.Token) *grpcPkg.Token {
+	if token == nil {
+		return nil
+	}
+	return &grpcPkg.Token{
+		Id:           token.ID,
+		MetadataHash: token.MetadataHash,
+		Minter:       token.Minter,
+	}
+}
+
+func (tp *TransactionMapper) TokenProvenanceToRpc(provenance *types.TokenProvenance) *grpcPkg.GetTokenResponse {
+	resp := &grpcPkg.GetTokenResponse{
+		Token: tp.TokenToRpc(provenance.Token),
+		Owner: provenance.Owner,
+	}
+	for _, transfer := range provenance.History {
+		resp.History = append(resp.History, &grpcPkg.TokenTransfer{
+			TxId:           transfer.TxID.String(),
+			Owner:          transfer.Owner,
+			BlockHeight:    transfer.BlockHeight,
+			BlockTimestamp: transfer.BlockTimestamp,
+		})
+	}
+	return resp
+}
+
+func (tp *TransactionMapper) RpcToEscrow(req *grpcPkg.CreateEscrowRequest) (*types.EscrowRequest, error) {
+	buyer, err := tp.rpcToSigner(req.GetBuyer())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	seller, err := tp.rpcToPublicKey(req.GetSeller())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse seller: %w", err)
+	}
+	arbiter, err := tp.rpcToPublicKey(req.GetArbiter())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse arbiter: %w", err)
+	}
+
+	return &types.EscrowRequest{
+		Buyer:   buyer,
+		Seller:  seller,
+		Arbiter: arbiter,
+		Amount:  types.Amount{Value: req.GetAmount().GetValue(), Unit: req.GetAmount().GetUnit()},
+		Timeout: req.GetTimeout(),
+		Fee:     req.GetFee(),
+	}, nil
+}
+
+func (tp *TransactionMapper) RpcToEscrowRelease(req *grpcPkg.ReleaseEscrowRequest) (*types.EscrowReleaseRequest, error) {
+	buyer, err := tp.rpcToSigner(req.GetBuyer())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	seller, err := tp.rpcToSigner(req.GetSeller())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	outpoint, err := rpcToUTXO(req.GetEscrow())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EscrowReleaseRequest{
+		Buyer:    buyer,
+		Seller:   seller,
+		Outpoint: outpoint,
+		Fee:      req.GetFee(),
+	}, nil
+}
+
+func (tp *TransactionMapper) RpcToEscrowDispute(req *grpcPkg.DisputeEscrowRequest) (*types.EscrowDisputeRequest, error) {
+	arbiter, err := tp.rpcToSigner(req.GetArbiter())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	party, err := tp.rpcToSigner(req.GetParty())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	outpoint, err := rpcToUTXO(req.GetEscrow())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EscrowDisputeRequest{
+		Arbiter:  arbiter,
+		Party:    party,
+		Outpoint: outpoint,
+		Fee:      req.GetFee(),
+	}, nil
+}
+
+func (tp *TransactionMapper) RpcToEscrowRefund(req *grpcPkg.RefundEscrowRequest) (*types.EscrowRefundRequest, error) {
+	buyer, err := tp.rpcToSigner(req.GetBuyer())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	outpoint, err := rpcToUTXO(req.GetEscrow())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.EscrowRefundRequest{
+		Buyer:    buyer,
+		Outpoint: outpoint,
+		Fee:      req.GetFee(),
+	}, nil
+}
+
+func (tp *TransactionMapper) EscrowToRpc(escrow *types.Escrow) *grpcPkg.Escrow {
+	return &grpcPkg.Escrow{
+		Outpoint: &grpcPkg.Utxo{
+			TxHash: escrow.Outpoint.TxHash,
+			Index:  escrow.Outpoint.Index,
+			TxId:   escrow.Outpoint.TxID.String(),
+		},
+		Amount:      &grpcPkg.Amount{Value: escrow.Amount.Value, Unit: escrow.Amount.Unit},
+		Buyer:       escrow.Buyer,
+		Seller:      escrow.Seller,
+		Arbiter:     escrow.Arbiter,
+		Timeout:     escrow.Timeout,
+		BlockHeight: escrow.BlockHeight,
+	}
+}
+
+func (tp *TransactionMapper) RpcToStandingOrder(req *grpcPkg.CreateStandingOrderRequest) (*types.StandingOrderRequest, error) {
+	payer, err := tp.rpcToSigner(req.GetPayer())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+	receiver, err := rpcToAddress(req.GetReceiver())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.StandingOrderRequest{
+		Payer:       payer,
+		Receiver:    receiver,
+		Amount:      types.Amount{Value: req.GetAmount().GetValue(), Unit: req.GetAmount().GetUnit()},
+		Start:       req.GetStart(),
+		Interval:    req.GetInterval(),
+		MaxPayments: req.GetMaxPayments(),
+		End:         req.GetEnd(),
+		Fee:         req.GetFee(),
+	}, nil
+}
+
+func (tp *TransactionMapper) RpcToStandingOrderCancel(req *grpcPkg.CancelStandingOrderRequest) (*types.StandingOrderCancelRequest, error) {
+	payer, err := tp.rpcToSigner(req.GetPayer())
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %v", err)
+	}
+
+	return &types.StandingOrderCancelRequest{
+		Payer:   payer,
+		OrderID: req.GetOrderId(),
+		Fee:     req.GetFee(),
+	}, nil
+}
+
+func (tp *TransactionMapper) StandingOrderToRpc(order *types.StandingOrder) *grpcPkg.StandingOrder {
+	if order == nil {
+		return nil
+	}
+	return &grpcPkg.StandingOrder{
+		Id:          order.ID,
+		Payer:       order.Payer,
+		Receiver:    order.Receiver,
+		Amount:      &grpcPkg.Amount{Value: order.Amount.Value, Unit: order.Amount.Unit},
+		Start:       order.Start,
+		Interval:    order.Interval,
+		MaxPayments: order.MaxPayments,
+		End:         order.End,
+	}
+}
+
+func (tp *TransactionMapper) StandingOrderRecordToRpc(record *types.StandingOrderRecord) *grpcPkg.StandingOrder {
+	resp := tp.StandingOrderToRpc(record.Order)
+	resp.Cancelled = record.Cancelled
+	for _, payment := range record.Payments {
+		resp.Payments = append(resp.Payments, &grpcPkg.OrderPayment{
+			Seq:            payment.Seq,
+			TxId:           payment.TxID.String(),
+			BlockHeight:    payment.BlockHeight,
+			BlockTimestamp: payment.BlockTimestamp,
+			Failure:        payment.Failure,
+		})
+	}
+	return resp
+}
+
+func (tp *TransactionMapper) BalanceToRpc(balance *types.Balance) *grpcPkg.GetBalanceResponse {
+	resp := &grpcPkg.GetBalanceResponse{
+		Amount: &grpcPkg.Amount{Value: balance.Amount.Value, Unit: balance.Amount.Unit},
+	}
+	for _, asset := range balance.Assets {
+		resp.Assets = append(resp.Assets, &grpcPkg.AssetBalance{
+			AssetId: asset.AssetID,
+			Asset:   tp.AssetToRpc(asset.Asset),
+			Value:   asset.Value,
+		})
+	}
+	return resp
+}
+
+func (tp *TransactionMapper) MerkleProofToRpc(proof types.MerkleProof) []*grpcPkg.MerkleStep {
+	steps := make([]*grpcPkg.MerkleStep, 0, len(proof))
+	for _, step := range proof {
+		steps = append(steps, &grpcPkg.MerkleStep{Hash: step.Hash, Left: step.Left})
+	}
+	return steps
+}
+
+func rpcToUTXO(utxo *grpcPkg.Utxo) (*types.UTXO, error) {
+	if utxo == nil {
+		return nil, fmt.Errorf("output must be provided")
+	}
+	id, err := uuid.Parse(utxo.GetTxId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction id: %v", err)
+	}
+	return types.NewUTXO(id, utxo.GetTxHash(), utxo.GetIndex()), nil
+}
+
+func rpcToPayments(rpcPayments []*grpcPkg.Payment) ([]types.Payment, error) {
+	payments := make([]types.Payment, 0, len(rpcPayments))
+	for i, payment := range rpcPayments {
+		amount := types.Amount{Value: payment.GetAmount().GetValue(), Unit: payment.GetAmount().GetUnit()}
+		if len(payment.GetScript()) > 0 {
+			payments = append(payments, types.Payment{Script: payment.GetScript(), Amount: amount, AssetID: payment.GetAssetId()})
+			continue
+		}
+		if payment.GetThreshold() > 0 {
+			lock, err := rpcToMultisigLock(payment.GetThreshold(), payment.GetReceivers())
+			if err != nil {
+				return nil, fmt.Errorf("payment %d: %w", i, err)
+			}
+			payments = append(payments, types.Payment{Multisig: lock, Amount: amount, AssetID: payment.GetAssetId()})
+			continue
+		}
+		receiver, err := rpcToAddress(payment.GetReceiver())
+		if err != nil {
+			return nil, fmt.Errorf("payment %d: %w", i, err)
+		}
+		payments = append(payments, types.Payment{Receiver: receiver, Amount: amount, AssetID: payment.GetAssetId()})
+	}
+	return payments, nil
+}
+
+// rpcToAddress accepts a receiver given by its address or, for clients not migrated yet, by its PEM public key.
+func rpcToAddress(receiver []byte) ([]byte, error) {
+	if pubKey, err := crypto.PublicKeyFromBytes(receiver); err == nil {
+		return crypto.PublicKeyHash(pubKey), nil
+	}
+	address, err := crypto.DecodeAddress(strings.TrimSpace(string(receiver)))
+	if err != nil {
+		return nil, fmt.Errorf("receiver is neither an address nor a public key: %w", err)
+	}
+	return address, nil
+}
+
+// rpcToSigner parses a PEM private key, anything else is the username of a user whose key the server keeps.
+func (tp *TransactionMapper) rpcToSigner(key []byte) (crypto.Signer, error) {
+	if block, _ := pem.Decode(key); block != nil {
+		return crypto.PrivateKeyFromBytes(key)
+	}
+	return tp.users.Signer(string(key))
+}
+
+// rpcToPublicKey resolves a receiver given as a public key, an address or a username to the public key a script
+// locks to. The key of an address or a username is the current key of the registered user.
+func (tp *TransactionMapper) rpcToPublicKey(receiver []byte) (crypto.PublicKey, error) {
+	if block, _ := pem.Decode(receiver); block != nil {
+		pubKey, err := crypto.PublicKeyFromBytes(receiver)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse public key: %w", err)
+		}
+		return pubKey, nil
+	}
+	name := strings.TrimSpace(string(receiver))
+	if address, err := crypto.DecodeAddress(name); err == nil {
+		return tp.users.AddressKey(address)
+	}
+	pubKey, err := tp.users.PublicKey(name)
+	if err != nil {
+		return nil, fmt.Errorf("receiver %q is neither a public key, an address nor a username: %w", name, err)
+	}
+	return pubKey, nil
+}
+
+func rpcToMultisigLock(threshold uint32, rpcPubKeys [][]byte) (*types.MultisigLock, error) {
+	pubKeys := make([]crypto.PublicKey, 0, len(rpcPubKeys))
+	for i, rpcPubKey := range rpcPubKeys {
+		pubKey, err := crypto.PublicKeyFromBytes(rpcPubKey)
+		if err != nil {
+			return nil, fmt.Errorf("multisig key %d: %v", i, err)
+		}
+		pubKeys = append(pubKeys, pubKey)
+	}
+	return types.NewMultisigLock(threshold, pubKeys...)
+}
+
+func (tp *TransactionMapper) RpcToSignedTransaction(req *grpcPkg.SubmitSignedTransactionRequest) (*types.Transaction, error) {
+	rpcTx := req.GetTransaction()
+	if rpcTx == nil {
+		return nil, fmt.Errorf("transaction must be provided")
+	}
+	id, err := uuid.Parse(rpcTx.GetId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction id: %v", err)
+	}
+	tx := &types.Transaction{
+		ID:        id,
+		Timestamp: rpcTx.GetTimestamp(),
+		Hash:      rpcTx.GetHash(),
+		Fee:       rpcTx.GetFee(),
+		LockTime:  rpcTx.GetLockTime(),
+	}
+	if issuance := rpcTx.GetIssuance(); issuance != nil {
+		tx.Issuance = &types.Asset{
+			ID:       issuance.GetId(),
+			Name:     issuance.GetName(),
+			Decimals: issuance.GetDecimals(),
+			Supply:   issuance.GetSupply(),
+			Issuer:   issuance.GetIssuer(),
+		}
+	}
+	if mint := rpcTx.GetMint(); mint != nil {
+		tx.Mint = &types.Token{
+			ID:           mint.GetId(),
+			MetadataHash: mint.GetMetadataHash(),
+			Minter:       mint.GetMinter(),
+		}
+	}
+	if order := rpcTx.GetStandingOrder(); order != nil {
+		tx.StandingOrder = &types.StandingOrder{
+			ID:          order.GetId(),
+			Payer:       order.GetPayer(),
+			Receiver:    order.GetReceiver(),
+			Amount:      types.Amount{Value: order.GetAmount().GetValue(), Unit: order.GetAmount().GetUnit()},
+			Start:       order.GetStart(),
+			Interval:    order.GetInterval(),
+			MaxPayments: order.GetMaxPayments(),
+			End:         order.GetEnd(),
+		}
+	}
+	tx.CancelOrder = rpcTx.GetCancelOrder()
+	if execution := rpcTx.GetExecution(); execution != nil {
+		tx.Execution = &types.OrderExecution{
+			OrderID: execution.GetOrderId(),
+			Seq:     execution.GetSeq(),
+			Failure: execution.GetFailure(),
+		}
+	}
+	for i, in := range rpcTx.GetInputs() {
+		if in.GetPrev() == nil {
+			return nil, fmt.Errorf("input %d: previous output must be provided", i)
+		}
+		prevID, err := uuid.Parse(in.GetPrev().GetTxId())
+		if err != nil {
+			return nil, fmt.Errorf("input %d: invalid previous transaction id: %v", i, err)
+		}
+		txIn := types.NewTxIn(
+			types.NewUTXO(prevID, in.GetPrev().GetTxHash(), in.GetPrev().GetIndex()),
+			in.GetPubKey(),
+			new(big.Int).SetBytes(in.GetSignatureR()),
+			new(big.Int).SetBytes(in.GetSignatureS()),
+			in.GetNSequence(),
+		)
+		txIn.Script = in.GetScript()
+		for _, sig := range in.GetMultisigSignatures() {
+			txIn.Signatures = append(txIn.Signatures, &types.Signature{
+				PubKey:     sig.GetPubKey(),
+				SignatureR: new(big.Int).SetBytes(sig.GetSignatureR()),
+				SignatureS: new(big.Int).SetBytes(sig.GetSignatureS()),
+			})
+		}
+		tx.AddInput(txIn)
+	}
+	for _, out := range rpcTx.GetOutputs() {
+		txOut := types.NewTxOut(
+			tx.ID,
+			types.Amount{Value: out.GetAmount().GetValue(), Unit: out.GetAmount().GetUnit()},
+			out.GetAddress(),
+		)
+		txOut.PubKey = out.GetPubKey()
+		txOut.Threshold, txOut.PubKeys, txOut.Script = out.GetThreshold(), out.GetPubKeys(), out.GetScript()
+		txOut.AssetID, txOut.TokenID, txOut.MetadataHash = out.GetAssetId(), out.GetTokenId(), out.GetMetadataHash()
+		tx.AddOutput(txOut)
+	}
+
+	return tx, nil
+}
+
+// RpcToBalanceRequest takes the owner's public key, or the key of the sender for older clients.
 func (tp *TransactionMapper) RpcToBalanceRequest(req *grpcPkg.GetBalanceRequest) (*types.BalanceRequest, error) {
-	sender, err := crypto.PrivateKeyFromBytes(req.Sender)
+	if len(req.GetOwner()) > 0 {
+		owner, err := crypto.PublicKeyFromBytes(req.GetOwner())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse public key: %w", err)
+		}
+		return &types.BalanceRequest{Owner: owner}, nil
+	}
+	sender, err := tp.rpcToSigner(req.GetSender())
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse private key: %v", err)
 	}
 
 	return &types.BalanceRequest{
-		Sender: sender,
+		Owner: sender.Public(),
 	}, nil
 }
 
@@ -52,8 +663,24 @@ func (tp *TransactionMapper) TransactionToRpc(tx *types.Transaction) *grpcPkg.Tr
 	for i, in := range tx.Inputs {
 		inputs[i] = &grpcPkg.Input{
 			PubKey:     in.PubKey,
-			SignatureR: in.SignatureR.Bytes(),
-			SignatureS: in.SignatureS.Bytes(),
+			SignatureR: bigBytes(in.SignatureR),
+			SignatureS: bigBytes(in.SignatureS),
+			NSequence:  in.NSequence,
+			Script:     in.Script,
+		}
+		for _, sig := range in.Signatures {
+			inputs[i].MultisigSignatures = append(inputs[i].MultisigSignatures, &grpcPkg.Signature{
+				PubKey:     sig.PubKey,
+				SignatureR: bigBytes(sig.SignatureR),
+				SignatureS: bigBytes(sig.SignatureS),
+			})
+		}
+		if in.Prev != nil {
+			inputs[i].Prev = &grpcPkg.Utxo{
+				TxHash: in.Prev.TxHash,
+				Index:  in.Prev.Index,
+				TxId:   in.Prev.TxID.String(),
+			}
 		}
 	}
 	outputs := make([]*grpcPkg.Output, len(tx.Outputs))
@@ -63,15 +690,50 @@ func (tp *TransactionMapper) TransactionToRpc(tx *types.Transaction) *grpcPkg.Tr
 				Value: out.Amount.Value,
 				Unit:  out.Amount.Unit,
 			},
-			PubKey: out.PubKey,
+			PubKey:       out.PubKey,
+			Threshold:    out.Threshold,
+			PubKeys:      out.PubKeys,
+			Script:       out.Script,
+			AssetId:      out.AssetID,
+			TokenId:      out.TokenID,
+			MetadataHash: out.MetadataHash,
+			Address:      out.Address,
 		}
 	}
 
 	return &grpcPkg.Transaction{
 		Id:             tx.ID.String(),
+		Timestamp:      tx.Timestamp,
 		Hash:           tx.GetHash(),
 		BlockTimestamp: tx.BlockTimestamp,
 		Inputs:         inputs,
 		Outputs:        outputs,
+		Fee:            tx.Fee,
+		LockTime:       tx.LockTime,
+		BlockHeight:    tx.BlockHeight,
+		Issuance:       tp.AssetToRpc(tx.Issuance),
+		Mint:           tp.TokenToRpc(tx.Mint),
+		StandingOrder:  tp.StandingOrderToRpc(tx.StandingOrder),
+		CancelOrder:    tx.CancelOrder,
+		Execution:      orderExecutionToRpc(tx.Execution),
+	}
+}
+
+func orderExecutionToRpc(execution *types.OrderExecution) *grpcPkg.OrderExecution {
+	if execution == nil {
+		return nil
+	}
+	return &grpcPkg.OrderExecution{
+		OrderId: execution.OrderID,
+		Seq:     execution.Seq,
+		Failure: execution.Failure,
+	}
+}
+
+// bigBytes encodes a signature part, inputs of a partially signed transaction may have none yet
+func bigBytes(v *big.Int) []byte {
+	if v == nil {
+		return nil
 	}
+	return v.Bytes()
 }
@@ -1,9 +1,16 @@
 package mapper
 
 import (
+	"fmt"
+
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/pkg/hdwallet"
+
 	grpcPkg "local-chain/transport/gen/transport"
 
 	"local-chain/internal/types"
+
+	"github.com/google/uuid"
 )
 
 type UserMapper struct{}
@@ -14,16 +21,106 @@ func NewUserMapper() *UserMapper {
 
 func (u *UserMapper) RpcToUser(req *grpcPkg.AddUserRequest) *types.User {
 	return &types.User{
-		Username:   req.GetUser().GetUsername(),
-		PublicKey:  req.GetUser().GetPublicKey(),
-		PrivateKey: req.GetUser().GetPrivateKey(),
+		Username:    req.GetUser().GetUsername(),
+		PublicKey:   req.GetUser().GetPublicKey(),
+		PrivateKey:  req.GetUser().GetPrivateKey(),
+		DisplayName: req.GetUser().GetDisplayName(),
+		Email:       req.GetUser().GetEmail(),
+	}
+}
+
+func (u *UserMapper) RpcToUserUpdate(req *grpcPkg.UpdateUserRequest) *types.UserUpdate {
+	return &types.UserUpdate{
+		Username:    req.GetUsername(),
+		DisplayName: req.DisplayName,
+		Email:       req.Email,
+	}
+}
+
+func (u *UserMapper) RpcToUserFilter(req *grpcPkg.ListUsersRequest) (types.UserFilter, error) {
+	filter := types.UserFilter{Deleted: req.GetIncludeDeleted(), Prefix: req.GetUsernamePrefix()}
+	if req.GetStatus() != "" {
+		status, err := types.ParseUserStatus(req.GetStatus())
+		if err != nil {
+			return types.UserFilter{}, err
+		}
+		filter.Status = &status
+	}
+	return filter, nil
+}
+
+// RpcToWallet makes the user of the wallet of the seed phrase and returns the seed, the user's key is the first
+// receive key: the seed derives it, the user keeps the public key of the wallet only.
+func (u *UserMapper) RpcToWallet(req *grpcPkg.CreateWalletRequest) (*types.User, []byte, error) {
+	seed, err := hdwallet.Seed(req.GetMnemonic(), req.GetPassphrase())
+	if err != nil {
+		return nil, nil, err
+	}
+	wallet, err := types.NewWallet(seed)
+	if err != nil {
+		return nil, nil, err
+	}
+	key, err := wallet.PublicKey(types.ReceiveChain, 0)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to derive the user key: %v", err)
+	}
+
+	return &types.User{
+		Username:  req.GetUsername(),
+		PublicKey: crypto.PublicKeyToBytes(key),
+		Wallet:    wallet,
+	}, seed, nil
+}
+
+// RpcToKeyRotation maps the rotation request, the algorithm applies only when no private key is given.
+func (u *UserMapper) RpcToKeyRotation(req *grpcPkg.RotateUserKeyRequest) (*types.KeyRotationRequest, error) {
+	rotation := &types.KeyRotationRequest{
+		Username:   req.GetUsername(),
+		Passphrase: req.GetPassphrase(),
+		Fee:        req.GetFee(),
+	}
+	if len(req.GetPrivateKey()) > 0 {
+		key, err := crypto.PrivateKeyFromBytes(req.GetPrivateKey())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse private key: %v", err)
+		}
+		rotation.NewKey = key
+		return rotation, nil
 	}
+	if req.GetAlgorithm() != "" {
+		alg, err := crypto.ParseAlgorithm(req.GetAlgorithm())
+		if err != nil {
+			return nil, err
+		}
+		rotation.Algorithm = alg
+	}
+	return rotation, nil
+}
+
+func (u *UserMapper) KeyRotationToRpc(rotation *types.KeyRotation) *grpcPkg.KeyRotation {
+	rpcRotation := &grpcPkg.KeyRotation{
+		OldKey:    rotation.OldKey,
+		NewKey:    rotation.NewKey,
+		RotatedAt: rotation.RotatedAt,
+	}
+	if rotation.SweepTxID != uuid.Nil {
+		rpcRotation.SweepTxId = rotation.SweepTxID.String()
+	}
+	return rpcRotation
 }
 
+// UserToRpc maps the user without its private key, the key never leaves the server.
 func (u *UserMapper) UserToRpc(user *types.User) *grpcPkg.User {
+	rotations := make([]*grpcPkg.KeyRotation, 0, len(user.Rotations))
+	for i := range user.Rotations {
+		rotations = append(rotations, u.KeyRotationToRpc(&user.Rotations[i]))
+	}
 	return &grpcPkg.User{
-		Username:   user.Username,
-		PublicKey:  user.PublicKey,
-		PrivateKey: user.PrivateKey,
+		Username:    user.Username,
+		PublicKey:   user.PublicKey,
+		Rotations:   rotations,
+		DisplayName: user.DisplayName,
+		Email:       user.Email,
+		Status:      user.Status.String(),
 	}
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"local-chain/internal/adapters/outbound/leveldb"
+	"local-chain/internal/pkg/crypto"
 
 	grpcPkg "local-chain/transport/gen/transport"
 
@@ -17,6 +18,9 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// defaultFeeEstimateBlocks is the number of recent blocks the fee estimate looks at when the request doesn't say
+const defaultFeeEstimateBlocks = 10
+
 type RaftAPI interface {
 	AddNonvoter(id raft.ServerID, address raft.ServerAddress, prevIndex uint64, timeout time.Duration) raft.IndexFuture
 	RemoveServer(id raft.ServerID, prevIndex uint64, timeout time.Duration) raft.IndexFuture
@@ -28,25 +32,88 @@ type RaftAPI interface {
 
 type Transactor interface {
 	CreateTx(txReq *types.TransactionRequest) (*types.Transaction, error)
-	GetBalance(req *types.BalanceRequest) (*types.Amount, error)
+	CreateBatchTx(txReq *types.BatchTransactionRequest) (*types.Transaction, error)
+	CreateWalletTx(req *types.WalletTransactionRequest) (*types.Transaction, error)
+	AddWallet(user *types.User, seed []byte, passphrase string) error
+	SubmitTx(tx *types.Transaction) (*types.Transaction, error)
+	CreateMultisigTx(txReq *types.MultisigTransactionRequest) (*types.PartiallySignedTx, error)
+	SubmitPartiallySignedTx(psbt *types.PartiallySignedTx) (*types.Transaction, error)
+	CreateHTLC(req *types.HTLCRequest) (*types.Transaction, error)
+	ClaimHTLC(req *types.HTLCClaimRequest) (*types.Transaction, error)
+	RefundHTLC(req *types.HTLCRefundRequest) (*types.Transaction, error)
+	Notarize(req *types.NotarizeRequest) (*types.Transaction, error)
+	ProveNotarization(data []byte) (*types.Notarization, error)
+	IssueAsset(req *types.AssetIssueRequest) (*types.Transaction, error)
+	ListAssets() ([]*types.Asset, error)
+	MintToken(req *types.TokenMintRequest) (*types.Transaction, error)
+	TransferToken(req *types.TokenTransferRequest) (*types.Transaction, error)
+	GetToken(tokenID []byte) (*types.TokenProvenance, error)
+	CreateEscrow(req *types.EscrowRequest) (*types.Transaction, *types.Transaction, error)
+	ReleaseEscrow(req *types.EscrowReleaseRequest) (*types.Transaction, error)
+	DisputeEscrow(req *types.EscrowDisputeRequest) (*types.Transaction, error)
+	RefundEscrow(req *types.EscrowRefundRequest) (*types.Transaction, error)
+	ListEscrows(pubKey []byte) ([]*types.Escrow, error)
+	CreateStandingOrder(req *types.StandingOrderRequest) (*types.Transaction, error)
+	CancelStandingOrder(req *types.StandingOrderCancelRequest) (*types.Transaction, error)
+	ListStandingOrders(pubKey []byte) ([]*types.StandingOrderRecord, error)
+	GetBalance(req *types.BalanceRequest) (*types.Balance, error)
+	EstimateFee(blocks int) (uint64, error)
 	VerifyTx(txID uuid.UUID) (*types.Transaction, error)
 }
 
 type transactionMapper interface {
 	RpcToTransaction(req *grpcPkg.AddTransactionRequest) (*types.TransactionRequest, error)
+	RpcToBatchTransaction(req *grpcPkg.AddBatchTransactionRequest) (*types.BatchTransactionRequest, error)
+	RpcToWalletTransaction(req *grpcPkg.SendFromWalletRequest) (*types.WalletTransactionRequest, error)
+	RpcToSignedTransaction(req *grpcPkg.SubmitSignedTransactionRequest) (*types.Transaction, error)
+	RpcToMultisigTransaction(req *grpcPkg.CreateMultisigTransactionRequest) (*types.MultisigTransactionRequest, error)
+	RpcToPartiallySignedTransaction(req *grpcPkg.SubmitPartiallySignedTransactionRequest) (*types.PartiallySignedTx, error)
+	RpcToHTLC(req *grpcPkg.CreateHTLCRequest) (*types.HTLCRequest, error)
+	RpcToHTLCClaim(req *grpcPkg.ClaimHTLCRequest) (*types.HTLCClaimRequest, error)
+	RpcToHTLCRefund(req *grpcPkg.RefundHTLCRequest) (*types.HTLCRefundRequest, error)
+	RpcToNotarize(req *grpcPkg.NotarizeRequest) (*types.NotarizeRequest, error)
+	MerkleProofToRpc(proof types.MerkleProof) []*grpcPkg.MerkleStep
+	RpcToAssetIssue(req *grpcPkg.IssueAssetRequest) (*types.AssetIssueRequest, error)
+	AssetToRpc(asset *types.Asset) *grpcPkg.Asset
+	RpcToTokenMint(req *grpcPkg.MintTokenRequest) (*types.TokenMintRequest, error)
+	RpcToTokenTransfer(req *grpcPkg.TransferTokenRequest) (*types.TokenTransferRequest, error)
+	TokenToRpc(token *types.Token) *grpcPkg.Token
+	TokenProvenanceToRpc(provenance *types.TokenProvenance) *grpcPkg.GetTokenResponse
+	RpcToEscrow(req *grpcPkg.CreateEscrowRequest) (*types.EscrowRequest, error)
+	RpcToEscrowRelease(req *grpcPkg.ReleaseEscrowRequest) (*types.EscrowReleaseRequest, error)
+	RpcToEscrowDispute(req *grpcPkg.DisputeEscrowRequest) (*types.EscrowDisputeRequest, error)
+	RpcToEscrowRefund(req *grpcPkg.RefundEscrowRequest) (*types.EscrowRefundRequest, error)
+	EscrowToRpc(escrow *types.Escrow) *grpcPkg.Escrow
+	RpcToStandingOrder(req *grpcPkg.CreateStandingOrderRequest) (*types.StandingOrderRequest, error)
+	RpcToStandingOrderCancel(req *grpcPkg.CancelStandingOrderRequest) (*types.StandingOrderCancelRequest, error)
+	StandingOrderRecordToRpc(record *types.StandingOrderRecord) *grpcPkg.StandingOrder
 	RpcToBalanceRequest(req *grpcPkg.GetBalanceRequest) (*types.BalanceRequest, error)
+	BalanceToRpc(balance *types.Balance) *grpcPkg.GetBalanceResponse
 	TransactionToRpc(tx *types.Transaction) *grpcPkg.Transaction
 }
 
 type User interface {
-	GetAllUsers() ([]*types.User, error)
+	ListUsers(filter types.UserFilter) ([]*types.User, error)
 	GetUser(username string) (*types.User, error)
-	AddUser(user *types.User) error
+	AddUser(user *types.User, passphrase string) error
+	UpdateUser(update *types.UserUpdate) (*types.User, error)
+	DisableUser(username string) error
+	EnableUser(username string) error
+	DeleteUser(username string) error
+	Unlock(username, passphrase string, timeout time.Duration) error
+	Lock(username string)
+	RotateKey(req *types.KeyRotationRequest) (*types.KeyRotation, *types.Transaction, error)
+	DeriveAddress(username string) (string, error)
 }
 
 type UserMapper interface {
 	RpcToUser(req *grpcPkg.AddUserRequest) *types.User
+	RpcToWallet(req *grpcPkg.CreateWalletRequest) (*types.User, []byte, error)
 	UserToRpc(user *types.User) *grpcPkg.User
+	RpcToUserUpdate(req *grpcPkg.UpdateUserRequest) *types.UserUpdate
+	RpcToUserFilter(req *grpcPkg.ListUsersRequest) (types.UserFilter, error)
+	RpcToKeyRotation(req *grpcPkg.RotateUserKeyRequest) (*types.KeyRotationRequest, error)
+	KeyRotationToRpc(rotation *types.KeyRotation) *grpcPkg.KeyRotation
 }
 
 type BlockchainStore interface {
@@ -149,26 +216,403 @@ func (s *LocalChainServer) AddTransaction(ctx context.Context, req *grpcPkg.AddT
 	return &grpcPkg.AddTransactionResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
 }
 
+func (s *LocalChainServer) AddBatchTransaction(
+	ctx context.Context,
+	req *grpcPkg.AddBatchTransactionRequest,
+) (*grpcPkg.AddBatchTransactionResponse, error) {
+	if len(req.GetPayments()) == 0 {
+		return nil, errors.New("at least one payment must be provided")
+	}
+	txReq, err := s.tm.RpcToBatchTransaction(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal add batch transaction request: %w", err)
+	}
+	tx, err := s.transactor.CreateBatchTx(txReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.CreateBatchTx: %w", err)
+	}
+
+	return &grpcPkg.AddBatchTransactionResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
+}
+
+func (s *LocalChainServer) SubmitSignedTransaction(
+	ctx context.Context,
+	req *grpcPkg.SubmitSignedTransactionRequest,
+) (*grpcPkg.SubmitSignedTransactionResponse, error) {
+	tx, err := s.tm.RpcToSignedTransaction(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal submit signed transaction request: %w", err)
+	}
+	tx, err = s.transactor.SubmitTx(tx)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.SubmitTx: %w", err)
+	}
+
+	return &grpcPkg.SubmitSignedTransactionResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
+}
+
+func (s *LocalChainServer) CreateMultisigTransaction(
+	ctx context.Context,
+	req *grpcPkg.CreateMultisigTransactionRequest,
+) (*grpcPkg.CreateMultisigTransactionResponse, error) {
+	txReq, err := s.tm.RpcToMultisigTransaction(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal create multisig transaction request: %w", err)
+	}
+	psbt, err := s.transactor.CreateMultisigTx(txReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.CreateMultisigTx: %w", err)
+	}
+	blob, err := psbt.Encode()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode partially signed transaction: %w", err)
+	}
+
+	return &grpcPkg.CreateMultisigTransactionResponse{Psbt: blob, Transaction: s.tm.TransactionToRpc(psbt.Tx)}, nil
+}
+
+func (s *LocalChainServer) SubmitPartiallySignedTransaction(
+	ctx context.Context,
+	req *grpcPkg.SubmitPartiallySignedTransactionRequest,
+) (*grpcPkg.SubmitPartiallySignedTransactionResponse, error) {
+	psbt, err := s.tm.RpcToPartiallySignedTransaction(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal submit partially signed transaction request: %w", err)
+	}
+	tx, err := s.transactor.SubmitPartiallySignedTx(psbt)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.SubmitPartiallySignedTx: %w", err)
+	}
+
+	return &grpcPkg.SubmitPartiallySignedTransactionResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
+}
+
+func (s *LocalChainServer) CreateHTLC(
+	ctx context.Context,
+	req *grpcPkg.CreateHTLCRequest,
+) (*grpcPkg.CreateHTLCResponse, error) {
+	htlcReq, err := s.tm.RpcToHTLC(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal create htlc request: %w", err)
+	}
+	tx, err := s.transactor.CreateHTLC(htlcReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.CreateHTLC: %w", err)
+	}
+
+	return &grpcPkg.CreateHTLCResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
+}
+
+func (s *LocalChainServer) ClaimHTLC(
+	ctx context.Context,
+	req *grpcPkg.ClaimHTLCRequest,
+) (*grpcPkg.ClaimHTLCResponse, error) {
+	claimReq, err := s.tm.RpcToHTLCClaim(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal claim htlc request: %w", err)
+	}
+	tx, err := s.transactor.ClaimHTLC(claimReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.ClaimHTLC: %w", err)
+	}
+
+	return &grpcPkg.ClaimHTLCResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
+}
+
+func (s *LocalChainServer) RefundHTLC(
+	ctx context.Context,
+	req *grpcPkg.RefundHTLCRequest,
+) (*grpcPkg.RefundHTLCResponse, error) {
+	refundReq, err := s.tm.RpcToHTLCRefund(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal refund htlc request: %w", err)
+	}
+	tx, err := s.transactor.RefundHTLC(refundReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.RefundHTLC: %w", err)
+	}
+
+	return &grpcPkg.RefundHTLCResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
+}
+
+func (s *LocalChainServer) Notarize(
+	ctx context.Context,
+	req *grpcPkg.NotarizeRequest,
+) (*grpcPkg.NotarizeResponse, error) {
+	notarizeReq, err := s.tm.RpcToNotarize(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal notarize request: %w", err)
+	}
+	tx, err := s.transactor.Notarize(notarizeReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.Notarize: %w", err)
+	}
+
+	return &grpcPkg.NotarizeResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
+}
+
+func (s *LocalChainServer) ProveNotarization(
+	ctx context.Context,
+	req *grpcPkg.ProveNotarizationRequest,
+) (*grpcPkg.ProveNotarizationResponse, error) {
+	notarization, err := s.transactor.ProveNotarization(req.GetData())
+	if err != nil {
+		return nil, fmt.Errorf("transactor.ProveNotarization: %w", err)
+	}
+
+	return &grpcPkg.ProveNotarizationResponse{
+		Transaction: s.tm.TransactionToRpc(notarization.Tx),
+		Block:       s.blockMapper.BlockToRpc(notarization.Block),
+		Proof:       s.tm.MerkleProofToRpc(notarization.Proof),
+	}, nil
+}
+
+func (s *LocalChainServer) IssueAsset(
+	ctx context.Context,
+	req *grpcPkg.IssueAssetRequest,
+) (*grpcPkg.IssueAssetResponse, error) {
+	issueReq, err := s.tm.RpcToAssetIssue(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal issue asset request: %w", err)
+	}
+	tx, err := s.transactor.IssueAsset(issueReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.IssueAsset: %w", err)
+	}
+
+	return &grpcPkg.IssueAssetResponse{
+		Transaction: s.tm.TransactionToRpc(tx),
+		Asset:       s.tm.AssetToRpc(tx.Issuance),
+	}, nil
+}
+
+func (s *LocalChainServer) ListAssets(ctx context.Context, req *emptypb.Empty) (*grpcPkg.ListAssetsResponse, error) {
+	assets, err := s.transactor.ListAssets()
+	if err != nil {
+		return nil, fmt.Errorf("transactor.ListAssets: %w", err)
+	}
+	rpcAssets := make([]*grpcPkg.Asset, 0, len(assets))
+	for _, asset := range assets {
+		rpcAssets = append(rpcAssets, s.tm.AssetToRpc(asset))
+	}
+	return &grpcPkg.ListAssetsResponse{Assets: rpcAssets}, nil
+}
+
+func (s *LocalChainServer) MintToken(
+	ctx context.Context,
+	req *grpcPkg.MintTokenRequest,
+) (*grpcPkg.MintTokenResponse, error) {
+	mintReq, err := s.tm.RpcToTokenMint(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal mint token request: %w", err)
+	}
+	tx, err := s.transactor.MintToken(mintReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.MintToken: %w", err)
+	}
+
+	return &grpcPkg.MintTokenResponse{
+		Transaction: s.tm.TransactionToRpc(tx),
+		Token:       s.tm.TokenToRpc(tx.Mint),
+	}, nil
+}
+
+func (s *LocalChainServer) TransferToken(
+	ctx context.Context,
+	req *grpcPkg.TransferTokenRequest,
+) (*grpcPkg.TransferTokenResponse, error) {
+	transferReq, err := s.tm.RpcToTokenTransfer(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal transfer token request: %w", err)
+	}
+	tx, err := s.transactor.TransferToken(transferReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.TransferToken: %w", err)
+	}
+
+	return &grpcPkg.TransferTokenResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
+}
+
+func (s *LocalChainServer) GetToken(ctx context.Context, req *grpcPkg.GetTokenRequest) (*grpcPkg.GetTokenResponse, error) {
+	if len(req.GetTokenId()) == 0 {
+		return nil, errors.New("token id must be provided")
+	}
+	provenance, err := s.transactor.GetToken(req.GetTokenId())
+	if err != nil {
+		return nil, fmt.Errorf("transactor.GetToken: %w", err)
+	}
+	return s.tm.TokenProvenanceToRpc(provenance), nil
+}
+
+func (s *LocalChainServer) CreateEscrow(
+	ctx context.Context,
+	req *grpcPkg.CreateEscrowRequest,
+) (*grpcPkg.CreateEscrowResponse, error) {
+	escrowReq, err := s.tm.RpcToEscrow(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal create escrow request: %w", err)
+	}
+	tx, refund, err := s.transactor.CreateEscrow(escrowReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.CreateEscrow: %w", err)
+	}
+
+	return &grpcPkg.CreateEscrowResponse{
+		Transaction: s.tm.TransactionToRpc(tx),
+		Refund:      s.tm.TransactionToRpc(refund),
+	}, nil
+}
+
+func (s *LocalChainServer) ReleaseEscrow(
+	ctx context.Context,
+	req *grpcPkg.ReleaseEscrowRequest,
+) (*grpcPkg.ReleaseEscrowResponse, error) {
+	releaseReq, err := s.tm.RpcToEscrowRelease(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal release escrow request: %w", err)
+	}
+	tx, err := s.transactor.ReleaseEscrow(releaseReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.ReleaseEscrow: %w", err)
+	}
+
+	return &grpcPkg.ReleaseEscrowResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
+}
+
+func (s *LocalChainServer) DisputeEscrow(
+	ctx context.Context,
+	req *grpcPkg.DisputeEscrowRequest,
+) (*grpcPkg.DisputeEscrowResponse, error) {
+	disputeReq, err := s.tm.RpcToEscrowDispute(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal dispute escrow request: %w", err)
+	}
+	tx, err := s.transactor.DisputeEscrow(disputeReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.DisputeEscrow: %w", err)
+	}
+
+	return &grpcPkg.DisputeEscrowResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
+}
+
+func (s *LocalChainServer) RefundEscrow(
+	ctx context.Context,
+	req *grpcPkg.RefundEscrowRequest,
+) (*grpcPkg.RefundEscrowResponse, error) {
+	refundReq, err := s.tm.RpcToEscrowRefund(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal refund escrow request: %w", err)
+	}
+	tx, err := s.transactor.RefundEscrow(refundReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.RefundEscrow: %w", err)
+	}
+
+	return &grpcPkg.RefundEscrowResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
+}
+
+func (s *LocalChainServer) ListEscrows(
+	ctx context.Context,
+	req *grpcPkg.ListEscrowsRequest,
+) (*grpcPkg.ListEscrowsResponse, error) {
+	if len(req.GetParticipant()) == 0 {
+		return nil, errors.New("participant must be provided")
+	}
+	escrows, err := s.transactor.ListEscrows(req.GetParticipant())
+	if err != nil {
+		return nil, fmt.Errorf("transactor.ListEscrows: %w", err)
+	}
+	resp := &grpcPkg.ListEscrowsResponse{Escrows: make([]*grpcPkg.Escrow, 0, len(escrows))}
+	for _, escrow := range escrows {
+		resp.Escrows = append(resp.Escrows, s.tm.EscrowToRpc(escrow))
+	}
+	return resp, nil
+}
+
+func (s *LocalChainServer) CreateStandingOrder(
+	ctx context.Context,
+	req *grpcPkg.CreateStandingOrderRequest,
+) (*grpcPkg.CreateStandingOrderResponse, error) {
+	orderReq, err := s.tm.RpcToStandingOrder(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal create standing order request: %w", err)
+	}
+	tx, err := s.transactor.CreateStandingOrder(orderReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.CreateStandingOrder: %w", err)
+	}
+
+	return &grpcPkg.CreateStandingOrderResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
+}
+
+func (s *LocalChainServer) ListStandingOrders(
+	ctx context.Context,
+	req *grpcPkg.ListStandingOrdersRequest,
+) (*grpcPkg.ListStandingOrdersResponse, error) {
+	if len(req.GetPubKey()) == 0 {
+		return nil, errors.New("public key must be provided")
+	}
+	records, err := s.transactor.ListStandingOrders(req.GetPubKey())
+	if err != nil {
+		return nil, fmt.Errorf("transactor.ListStandingOrders: %w", err)
+	}
+	resp := &grpcPkg.ListStandingOrdersResponse{Orders: make([]*grpcPkg.StandingOrder, 0, len(records))}
+	for _, record := range records {
+		resp.Orders = append(resp.Orders, s.tm.StandingOrderRecordToRpc(record))
+	}
+	return resp, nil
+}
+
+func (s *LocalChainServer) CancelStandingOrder(
+	ctx context.Context,
+	req *grpcPkg.CancelStandingOrderRequest,
+) (*grpcPkg.CancelStandingOrderResponse, error) {
+	cancelReq, err := s.tm.RpcToStandingOrderCancel(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal cancel standing order request: %w", err)
+	}
+	tx, err := s.transactor.CancelStandingOrder(cancelReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.CancelStandingOrder: %w", err)
+	}
+
+	return &grpcPkg.CancelStandingOrderResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
+}
+
 func (s *LocalChainServer) GetBalance(ctx context.Context, req *grpcPkg.GetBalanceRequest) (*grpcPkg.GetBalanceResponse, error) {
 	resp := &grpcPkg.GetBalanceResponse{Amount: &grpcPkg.Amount{}}
 	balanceReq, err := s.tm.RpcToBalanceRequest(req)
 	if err != nil {
 		return &grpcPkg.GetBalanceResponse{}, fmt.Errorf("failed to marshal get balance request: %w", err)
 	}
-	amount, err := s.transactor.GetBalance(balanceReq)
+	balance, err := s.transactor.GetBalance(balanceReq)
 	if err != nil {
 		return resp, fmt.Errorf("transactor.GetBalance: %w", err)
 	}
-	resp.Amount = &grpcPkg.Amount{Value: amount.Value, Unit: amount.Unit}
 
-	return resp, err
+	return s.tm.BalanceToRpc(balance), nil
+}
+
+func (s *LocalChainServer) EstimateFee(ctx context.Context, req *grpcPkg.EstimateFeeRequest) (*grpcPkg.EstimateFeeResponse, error) {
+	blocks := int(req.GetBlocks())
+	if blocks == 0 {
+		blocks = defaultFeeEstimateBlocks
+	}
+	feeRate, err := s.transactor.EstimateFee(blocks)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.EstimateFee: %w", err)
+	}
+	return &grpcPkg.EstimateFeeResponse{FeeRate: feeRate}, nil
 }
 
 func (s *LocalChainServer) AddUser(ctx context.Context, req *grpcPkg.AddUserRequest) (*grpcPkg.AddUserResponse, error) {
 	if req.GetUser().GetUsername() == "" || len(req.GetUser().GetPrivateKey()) == 0 || len(req.GetUser().GetPublicKey()) == 0 {
 		return &grpcPkg.AddUserResponse{Success: false}, errors.New("username, private key and public key must be provided")
 	}
-	if err := s.user.AddUser(s.userMapper.RpcToUser(req)); err != nil {
+	if req.GetPassphrase() == "" {
+		return &grpcPkg.AddUserResponse{Success: false}, errors.New("passphrase must be provided")
+	}
+	if err := s.user.AddUser(s.userMapper.RpcToUser(req), req.GetPassphrase()); err != nil {
 		return &grpcPkg.AddUserResponse{Success: false}, fmt.Errorf("user.AddUser: %w", err)
 	}
 	return &grpcPkg.AddUserResponse{Success: true}, nil
@@ -190,10 +634,14 @@ func (s *LocalChainServer) GetUser(ctx context.Context, req *grpcPkg.GetUserRequ
 	}, nil
 }
 
-func (s *LocalChainServer) ListUsers(ctx context.Context, req *emptypb.Empty) (*grpcPkg.ListUsersResponse, error) {
-	users, err := s.user.GetAllUsers()
+func (s *LocalChainServer) ListUsers(ctx context.Context, req *grpcPkg.ListUsersRequest) (*grpcPkg.ListUsersResponse, error) {
+	filter, err := s.userMapper.RpcToUserFilter(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal list users request: %w", err)
+	}
+	users, err := s.user.ListUsers(filter)
 	if err != nil {
-		return nil, fmt.Errorf("user.GetAllUsers: %w", err)
+		return nil, fmt.Errorf("user.ListUsers: %w", err)
 	}
 	rpcUsers := make([]*grpcPkg.User, 0, len(users))
 	for _, user := range users {
@@ -202,6 +650,126 @@ func (s *LocalChainServer) ListUsers(ctx context.Context, req *emptypb.Empty) (*
 	return &grpcPkg.ListUsersResponse{Users: rpcUsers}, nil
 }
 
+func (s *LocalChainServer) UpdateUser(ctx context.Context, req *grpcPkg.UpdateUserRequest) (*grpcPkg.UpdateUserResponse, error) {
+	if req.GetUsername() == "" {
+		return nil, errors.New("username must be provided")
+	}
+	user, err := s.user.UpdateUser(s.userMapper.RpcToUserUpdate(req))
+	if err != nil {
+		return nil, fmt.Errorf("user.UpdateUser: %w", err)
+	}
+	return &grpcPkg.UpdateUserResponse{User: s.userMapper.UserToRpc(user)}, nil
+}
+
+func (s *LocalChainServer) DisableUser(ctx context.Context, req *grpcPkg.DisableUserRequest) (*grpcPkg.DisableUserResponse, error) {
+	if req.GetUsername() == "" {
+		return nil, errors.New("username must be provided")
+	}
+	if err := s.user.DisableUser(req.GetUsername()); err != nil {
+		return nil, fmt.Errorf("user.DisableUser: %w", err)
+	}
+	return &grpcPkg.DisableUserResponse{}, nil
+}
+
+func (s *LocalChainServer) EnableUser(ctx context.Context, req *grpcPkg.EnableUserRequest) (*grpcPkg.EnableUserResponse, error) {
+	if req.GetUsername() == "" {
+		return nil, errors.New("username must be provided")
+	}
+	if err := s.user.EnableUser(req.GetUsername()); err != nil {
+		return nil, fmt.Errorf("user.EnableUser: %w", err)
+	}
+	return &grpcPkg.EnableUserResponse{}, nil
+}
+
+func (s *LocalChainServer) DeleteUser(ctx context.Context, req *grpcPkg.DeleteUserRequest) (*grpcPkg.DeleteUserResponse, error) {
+	if req.GetUsername() == "" {
+		return nil, errors.New("username must be provided")
+	}
+	if err := s.user.DeleteUser(req.GetUsername()); err != nil {
+		return nil, fmt.Errorf("user.DeleteUser: %w", err)
+	}
+	return &grpcPkg.DeleteUserResponse{}, nil
+}
+
+func (s *LocalChainServer) UnlockUser(ctx context.Context, req *grpcPkg.UnlockUserRequest) (*grpcPkg.UnlockUserResponse, error) {
+	if req.GetUsername() == "" || req.GetPassphrase() == "" {
+		return nil, errors.New("username and passphrase must be provided")
+	}
+	timeout := time.Duration(req.GetTimeoutSeconds()) * time.Second
+	if err := s.user.Unlock(req.GetUsername(), req.GetPassphrase(), timeout); err != nil {
+		return nil, fmt.Errorf("user.Unlock: %w", err)
+	}
+	return &grpcPkg.UnlockUserResponse{}, nil
+}
+
+func (s *LocalChainServer) LockUser(ctx context.Context, req *grpcPkg.LockUserRequest) (*grpcPkg.LockUserResponse, error) {
+	if req.GetUsername() == "" {
+		return nil, errors.New("username must be provided")
+	}
+	s.user.Lock(req.GetUsername())
+	return &grpcPkg.LockUserResponse{}, nil
+}
+
+func (s *LocalChainServer) RotateUserKey(ctx context.Context, req *grpcPkg.RotateUserKeyRequest) (*grpcPkg.RotateUserKeyResponse, error) {
+	if req.GetUsername() == "" || req.GetPassphrase() == "" {
+		return nil, errors.New("username and passphrase must be provided")
+	}
+	rotationReq, err := s.userMapper.RpcToKeyRotation(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal rotate user key request: %w", err)
+	}
+	rotation, sweep, err := s.user.RotateKey(rotationReq)
+	if err != nil {
+		return nil, fmt.Errorf("user.RotateKey: %w", err)
+	}
+	resp := &grpcPkg.RotateUserKeyResponse{Rotation: s.userMapper.KeyRotationToRpc(rotation)}
+	if sweep != nil {
+		resp.Sweep = s.tm.TransactionToRpc(sweep)
+	}
+	return resp, nil
+}
+
+func (s *LocalChainServer) CreateWallet(ctx context.Context, req *grpcPkg.CreateWalletRequest) (*grpcPkg.CreateWalletResponse, error) {
+	if req.GetUsername() == "" {
+		return nil, errors.New("username must be provided")
+	}
+	if req.GetKeyPassphrase() == "" {
+		return nil, errors.New("key passphrase must be provided")
+	}
+	user, seed, err := s.userMapper.RpcToWallet(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal create wallet request: %w", err)
+	}
+	if err = s.transactor.AddWallet(user, seed, req.GetKeyPassphrase()); err != nil {
+		return nil, fmt.Errorf("transactor.AddWallet: %w", err)
+	}
+	return &grpcPkg.CreateWalletResponse{Address: crypto.EncodeAddress(types.AddressOf(user.PublicKey))}, nil
+}
+
+func (s *LocalChainServer) DeriveAddress(ctx context.Context, req *grpcPkg.DeriveAddressRequest) (*grpcPkg.DeriveAddressResponse, error) {
+	if req.GetUsername() == "" {
+		return nil, errors.New("username must be provided")
+	}
+	address, err := s.user.DeriveAddress(req.GetUsername())
+	if err != nil {
+		return nil, fmt.Errorf("user.DeriveAddress: %w", err)
+	}
+	return &grpcPkg.DeriveAddressResponse{Address: address}, nil
+}
+
+func (s *LocalChainServer) SendFromWallet(ctx context.Context, req *grpcPkg.SendFromWalletRequest) (*grpcPkg.SendFromWalletResponse, error) {
+	txReq, err := s.tm.RpcToWalletTransaction(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal send from wallet request: %w", err)
+	}
+	tx, err := s.transactor.CreateWalletTx(txReq)
+	if err != nil {
+		return nil, fmt.Errorf("transactor.CreateWalletTx: %w", err)
+	}
+
+	return &grpcPkg.SendFromWalletResponse{Transaction: s.tm.TransactionToRpc(tx)}, nil
+}
+
 func (s *LocalChainServer) GetBlockKeys(ctx context.Context, req *emptypb.Empty) (*grpcPkg.GetBlockKeysResponse, error) {
 	keys, err := s.blockchainStore.GetKeys()
 	if err != nil {
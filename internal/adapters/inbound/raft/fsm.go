@@ -1,32 +1,49 @@
 package raft
 
 import (
+	"bytes"
+	"cmp"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"io"
+	"slices"
 
-	"local-chain/internal/adapters/outbound/inMem"
+	"local-chain/internal/pkg/script"
+	"local-chain/internal/service"
 
 	"local-chain/internal/types"
 
+	"github.com/google/uuid"
+
 	"github.com/hashicorp/raft"
 
 	"local-chain/internal/adapters/outbound/leveldb"
 )
 
 type txPool interface {
-	GetPool() inMem.Pool
+	AddTx(tx *types.Transaction) error
+	Ordered() types.Transactions
+	Remove(ids ...uuid.UUID)
+	Evict(ids ...uuid.UUID)
+	Purge()
+}
+
+type blockValidator interface {
+	Validate(envelope *types.BlockTxsEnvelope) error
 }
 
 type Fsm struct {
-	store  *leveldb.Store
-	txPool txPool
+	store     *leveldb.Store
+	txPool    txPool
+	validator blockValidator
 }
 
-func New(store *leveldb.Store, txPool txPool) *Fsm {
+func New(store *leveldb.Store, txPool txPool, validator blockValidator) *Fsm {
 	return &Fsm{
-		store:  store,
-		txPool: txPool,
+		store:     store,
+		txPool:    txPool,
+		validator: validator,
 	}
 }
 
@@ -40,10 +57,16 @@ func (f *Fsm) Apply(log *raft.Log) interface{} {
 		switch envelope.Type {
 		case types.EnvelopeTypeBlock:
 			if err = f.addBlock(envelope.Data); err != nil {
-				return fmt.Errorf("add block error: %v", err)
+				return fmt.Errorf("add block error: %w", err)
 			}
 		case types.EnvelopeTypeTransaction:
-
+			if err = f.addTx(envelope.Data); err != nil {
+				return fmt.Errorf("add transaction error: %w", err)
+			}
+		case types.EnvelopeTypeUser:
+			if err = f.applyUser(envelope.Data); err != nil {
+				return fmt.Errorf("apply user error: %w", err)
+			}
 		}
 		return nil
 	default:
@@ -56,76 +79,232 @@ func (f *Fsm) addBlock(blockBytes []byte) error {
 	if err := blockTxsEnvelope.FromBytes(blockBytes); err != nil {
 		return fmt.Errorf("failed to decode block: %w", err)
 	}
-	// should we check if the block already exists?
+	existing, err := f.store.Blockchain().GetByTimestamp(blockTxsEnvelope.Block.Timestamp)
+	if err != nil {
+		return fmt.Errorf("failed to get block: %w", err)
+	}
+	if existing != nil && bytes.Equal(existing.Hash, blockTxsEnvelope.Block.Hash) {
+		// the block is already stored, the log is being replayed
+		f.txPool.Remove(blockTxsEnvelope.Txs.IDs()...)
+		return nil
+	}
+	// every replica validates the block on its own, so a faulty leader can't corrupt the state
+	if err = f.validator.Validate(blockTxsEnvelope); err != nil {
+		var validationErr *service.BlockValidationError
+		// a non-final transaction becomes valid later, it stays in the pool
+		if errors.As(err, &validationErr) && validationErr.TxID != uuid.Nil && !errors.Is(err, types.ErrNonFinal) {
+			// the offending transaction and its descendants can never be mined
+			f.txPool.Evict(validationErr.TxID)
+		}
+		return err
+	}
+	return f.applyBlock(blockTxsEnvelope)
+}
+
+// applyBlock stores the block and applies its transactions to the transaction, UTXO, asset, escrow
+// and standing order stores
+func (f *Fsm) applyBlock(blockTxsEnvelope *types.BlockTxsEnvelope) error {
 	if err := f.store.Blockchain().Put(blockTxsEnvelope.Block); err != nil {
 		return fmt.Errorf("failed to save block: %w", err)
 	}
 	if err := f.store.BlockTransactions().Put(blockTxsEnvelope); err != nil {
 		return fmt.Errorf("failed to save block transactions: %w", err)
 	}
-	for _, tx := range blockTxsEnvelope.Txs.SortByTimestamp() {
+	// transactions are applied in block order, the order they were validated in
+	for _, tx := range blockTxsEnvelope.Txs {
 		tx.BlockTimestamp = blockTxsEnvelope.Block.Timestamp
+		tx.BlockHeight = blockTxsEnvelope.Block.Height
 		if err := f.store.Transaction().Put(tx); err != nil {
 			return fmt.Errorf("failed to put transaction: %w", err)
 		}
-		if err := f.addUTXO(tx); err != nil {
-			return fmt.Errorf("failed to add UTXOs: %w", err)
+		if tx.Issuance != nil {
+			if err := f.store.Asset().Put(tx.Issuance); err != nil {
+				return fmt.Errorf("failed to put asset: %w", err)
+			}
+		}
+		if err := f.putEscrows(tx); err != nil {
+			return err
 		}
+		if err := f.applyStandingOrders(tx); err != nil {
+			return err
+		}
+	}
+	if err := f.store.Utxo().Apply(blockTxsEnvelope.Txs...); err != nil {
+		return fmt.Errorf("failed to apply UTXOs: %w", err)
 	}
+	// transactions added while the block was being assembled stay in the pool for the next block
+	f.txPool.Remove(blockTxsEnvelope.Txs.IDs()...)
 	return nil
 }
 
-func (f *Fsm) addUTXO(tx *types.Transaction) error {
-	for index, output := range tx.Outputs {
-		var (
-			err   error
-			utxos types.UTXOs
-		)
-		if index == 0 {
-			utxos, err = f.store.Utxo().Get(output.PubKey)
-			if err != nil {
-				return fmt.Errorf("failed to get utxos: %w", err)
-			}
+// putEscrows indexes the escrow outputs of the confirmed transaction by their parties
+func (f *Fsm) putEscrows(tx *types.Transaction) error {
+	for index, out := range tx.Outputs {
+		if !out.IsScript() {
+			continue
+		}
+		terms, err := script.ParseEscrow(out.Script)
+		if err != nil {
+			continue
 		}
-		if err = f.store.Utxo().Put(output.PubKey, append(utxos, types.NewUTXO(tx.ID, tx.GetHash(), uint32(index)))...); err != nil {
-			return fmt.Errorf("failed to put utxo: %w", err)
+		escrow := &types.Escrow{
+			Outpoint:    types.NewUTXO(tx.ID, tx.GetHash(), uint32(index)),
+			Amount:      out.Amount,
+			Buyer:       terms.Buyer,
+			Seller:      terms.Seller,
+			Arbiter:     terms.Arbiter,
+			Timeout:     terms.Timeout,
+			BlockHeight: tx.BlockHeight,
+		}
+		if err = f.store.Escrow().Put(escrow); err != nil {
+			return fmt.Errorf("failed to put escrow: %w", err)
+		}
+	}
+	return nil
+}
+
+// applyStandingOrders stores the standing order the confirmed transaction creates, cancels or pays
+func (f *Fsm) applyStandingOrders(tx *types.Transaction) error {
+	if tx.StandingOrder != nil {
+		if err := f.store.StandingOrder().Put(&types.StandingOrderRecord{Order: tx.StandingOrder}); err != nil {
+			return fmt.Errorf("failed to put standing order: %w", err)
 		}
 	}
+	var orderID []byte
+	switch {
+	case len(tx.CancelOrder) > 0:
+		orderID = tx.CancelOrder
+	case tx.Execution != nil:
+		orderID = tx.Execution.OrderID
+	default:
+		return nil
+	}
+	record, err := f.store.StandingOrder().Get(orderID)
+	if err != nil {
+		return fmt.Errorf("failed to get standing order: %w", err)
+	}
+	if record == nil {
+		return fmt.Errorf("standing order %x does not exist", orderID)
+	}
+	if len(tx.CancelOrder) > 0 {
+		record.Cancelled = true
+	} else {
+		record.Payments = append(record.Payments, types.OrderPayment{
+			Seq:            tx.Execution.Seq,
+			TxID:           tx.ID,
+			BlockHeight:    tx.BlockHeight,
+			BlockTimestamp: tx.BlockTimestamp,
+			Failure:        tx.Execution.Failure,
+		})
+	}
+	if err = f.store.StandingOrder().Put(record); err != nil {
+		return fmt.Errorf("failed to put standing order: %w", err)
+	}
 	return nil
 }
 
+func (f *Fsm) addTx(txBytes []byte) error {
+	tx := &types.Transaction{}
+	if err := tx.FromBytes(txBytes); err != nil {
+		return fmt.Errorf("failed to decode transaction: %w", err)
+	}
+	// a replayed log of a transaction confirmed since is refused as well, nobody waits for its response
+	confirmed, err := f.store.Transaction().Has(tx.ID)
+	if err != nil {
+		return fmt.Errorf("failed to get transaction: %w", err)
+	}
+	if confirmed {
+		return fmt.Errorf("transaction %s is already confirmed", tx.ID)
+	}
+	return f.txPool.AddTx(tx)
+}
+
+// applyUser changes the user registry. A replayed creation fails on the stored user and changes nothing,
+// updates carry the whole user, so the replay ends with the registry it had.
+func (f *Fsm) applyUser(cmdBytes []byte) error {
+	cmd := &types.UserCommand{}
+	if err := cmd.FromBytes(cmdBytes); err != nil {
+		return fmt.Errorf("failed to decode user command: %w", err)
+	}
+	if cmd.User == nil {
+		return errors.New("user command has no user")
+	}
+	switch cmd.Op {
+	case types.UserOpCreate:
+		return f.store.User().Put(cmd.User)
+	case types.UserOpUpdate:
+		return f.store.User().Update(cmd.User)
+	default:
+		return fmt.Errorf("unknown user operation %d", cmd.Op)
+	}
+}
+
 func (f *Fsm) Snapshot() (raft.FSMSnapshot, error) {
 	blocks, err := f.store.Blockchain().GetAll()
 	if err != nil {
 		return nil, err
 	}
-	return &FsmSnapshot{blocks: blocks}, nil
+	slices.SortFunc(blocks, func(a, b *types.Block) int { return cmp.Compare(a.Timestamp, b.Timestamp) })
+	// the blocks carry their transactions, every store derived from the chain is rebuilt from them
+	envelopes := make([]*types.BlockTxsEnvelope, 0, len(blocks))
+	for _, block := range blocks {
+		txs, err := f.store.BlockTransactions().GetByBlockTimestamp(block.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get transactions of block %d: %w", block.Timestamp, err)
+		}
+		envelopes = append(envelopes, types.NewBlockTxsEnvelope(block, txs))
+	}
+	users, err := f.store.User().GetAll()
+	if err != nil {
+		return nil, err
+	}
+	return &FsmSnapshot{blocks: envelopes, txs: f.txPool.Ordered(), users: users}, nil
 }
 
+// Restore replaces the state with the snapshot: the chain stores are cleared and the blocks are applied again
+// in chain order, so the UTXO set and the asset, escrow and standing order stores are rebuilt with them.
 func (f *Fsm) Restore(snapshot io.ReadCloser) error {
-	// Clear the current blockchain blocks to avoid conflicts
-	if err := f.store.Blockchain().Delete(); err != nil {
-		return fmt.Errorf("failed to clear blockchain blocks: %w", err)
-	}
-	for {
-		var length uint32
-		if err := binary.Read(snapshot, binary.BigEndian, &length); err != nil {
-			if err == io.EOF {
-				break // End of snapshot
-			}
-			return fmt.Errorf("failed to read block length: %w", err)
-		}
-		blockBytes := make([]byte, length)
-		if _, err := io.ReadFull(snapshot, blockBytes); err != nil {
-			return fmt.Errorf("failed to read block data: %w", err)
+	if err := f.store.ClearChain(); err != nil {
+		return fmt.Errorf("failed to clear the chain: %w", err)
+	}
+	blocksCount, err := readCount(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to read blocks count: %w", err)
+	}
+	for range blocksCount {
+		blockBytes, err := readRecord(snapshot)
+		if err != nil {
+			return fmt.Errorf("failed to read block: %w", err)
 		}
-		block := &types.Block{}
-		if err := block.FromBytes(blockBytes); err != nil {
+		blockTxsEnvelope := types.NewBlockTxsEnvelope(nil, nil)
+		if err := blockTxsEnvelope.FromBytes(blockBytes); err != nil {
 			return fmt.Errorf("failed to deserialize block: %w", err)
 		}
-		if err := f.store.Blockchain().Put(block); err != nil {
-			return fmt.Errorf("failed to store block %d: %w", block.Timestamp, err)
+		if err := f.applyBlock(blockTxsEnvelope); err != nil {
+			return fmt.Errorf("failed to restore block %d: %w", blockTxsEnvelope.Block.Timestamp, err)
+		}
+	}
+	// pending transactions are stored parents first, so they are added back in an order the pool accepts
+	f.txPool.Purge()
+	txsCount, err := readCount(snapshot)
+	if err != nil {
+		return fmt.Errorf("failed to read transactions count: %w", err)
+	}
+	for range txsCount {
+		txBytes, err := readRecord(snapshot)
+		if err != nil {
+			return fmt.Errorf("failed to read transaction: %w", err)
+		}
+		tx := &types.Transaction{}
+		if err := tx.FromBytes(txBytes); err != nil {
+			return fmt.Errorf("failed to deserialize transaction: %w", err)
 		}
+		if err := f.txPool.AddTx(tx); err != nil {
+			return fmt.Errorf("failed to restore pending transaction %s: %w", tx.ID, err)
+		}
+	}
+	if err := f.restoreUsers(snapshot); err != nil {
+		return err
 	}
 
 	if err := snapshot.Close(); err != nil {
@@ -135,29 +314,120 @@ func (f *Fsm) Restore(snapshot io.ReadCloser) error {
 	return nil
 }
 
+// restoreUsers replaces the user registry with the users of the snapshot. Snapshots taken before the registry
+// was replicated end with the pending transactions, the registry stays as it is then.
+func (f *Fsm) restoreUsers(snapshot io.Reader) error {
+	usersCount, err := readCount(snapshot)
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read users count: %w", err)
+	}
+	if err = f.store.User().Delete(); err != nil {
+		return fmt.Errorf("failed to clear users: %w", err)
+	}
+	for range usersCount {
+		userBytes, err := readRecord(snapshot)
+		if err != nil {
+			return fmt.Errorf("failed to read user: %w", err)
+		}
+		user := &types.User{}
+		if err := user.FromBytes(userBytes); err != nil {
+			return fmt.Errorf("failed to deserialize user: %w", err)
+		}
+		if err := f.store.User().Put(user); err != nil {
+			return fmt.Errorf("failed to store user %s: %w", user.Username, err)
+		}
+	}
+	return nil
+}
+
 type FsmSnapshot struct {
-	blocks types.Blocks
+	blocks []*types.BlockTxsEnvelope
+	txs    types.Transactions
+	users  []*types.User
 }
 
+// Persist writes the blocks with their transactions, the pending transactions and then the users,
+// each section starts with the number of records. A failed snapshot is cancelled.
 func (s *FsmSnapshot) Persist(sink raft.SnapshotSink) error {
+	if err := s.persist(sink); err != nil {
+		if cancelErr := sink.Cancel(); cancelErr != nil {
+			return errors.Join(err, cancelErr)
+		}
+		return err
+	}
+	return sink.Close()
+}
+
+func (s *FsmSnapshot) persist(sink raft.SnapshotSink) error {
+	if err := binary.Write(sink, binary.BigEndian, uint32(len(s.blocks))); err != nil {
+		return fmt.Errorf("failed to write blocks count: %w", err)
+	}
 	for _, block := range s.blocks {
 		blockBytes, err := block.ToBytes()
 		if err != nil {
-			return fmt.Errorf("failed to serialize block %d: %w", block.Timestamp, err)
+			return fmt.Errorf("failed to serialize block %d: %w", block.Block.Timestamp, err)
 		}
-		// Write block length (to allow deserialization)
-		length := uint32(len(blockBytes))
-		if err := binary.Write(sink, binary.BigEndian, length); err != nil {
-			return fmt.Errorf("failed to write block length: %w", err)
+		if err = writeRecord(sink, blockBytes); err != nil {
+			return fmt.Errorf("failed to write block %d: %w", block.Block.Timestamp, err)
+		}
+	}
+	if err := binary.Write(sink, binary.BigEndian, uint32(len(s.txs))); err != nil {
+		return fmt.Errorf("failed to write transactions count: %w", err)
+	}
+	for _, tx := range s.txs {
+		txBytes, err := tx.ToBytes()
+		if err != nil {
+			return fmt.Errorf("failed to serialize transaction %s: %w", tx.ID, err)
+		}
+		if err = writeRecord(sink, txBytes); err != nil {
+			return fmt.Errorf("failed to write transaction %s: %w", tx.ID, err)
+		}
+	}
+	if err := binary.Write(sink, binary.BigEndian, uint32(len(s.users))); err != nil {
+		return fmt.Errorf("failed to write users count: %w", err)
+	}
+	for _, user := range s.users {
+		userBytes, err := user.ToBytes()
+		if err != nil {
+			return fmt.Errorf("failed to serialize user %s: %w", user.Username, err)
 		}
-		// Write block data
-		if _, err := sink.Write(blockBytes); err != nil {
-			return fmt.Errorf("failed to write block data: %w", err)
+		if err = writeRecord(sink, userBytes); err != nil {
+			return fmt.Errorf("failed to write user %s: %w", user.Username, err)
 		}
 	}
-	return sink.Cancel() // nolint:errcheck
+	return nil
 }
 
 func (s *FsmSnapshot) Release() {
 	// release resources if needed
 }
+
+// writeRecord writes the data prefixed with its length (to allow deserialization)
+func writeRecord(w io.Writer, data []byte) error {
+	if err := binary.Write(w, binary.BigEndian, uint32(len(data))); err != nil {
+		return err
+	}
+	_, err := w.Write(data)
+	return err
+}
+
+func readRecord(r io.Reader) ([]byte, error) {
+	length, err := readCount(r)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]byte, length)
+	if _, err = io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+func readCount(r io.Reader) (uint32, error) {
+	var count uint32
+	err := binary.Read(r, binary.BigEndian, &count)
+	return count, err
+}
@@ -0,0 +1,263 @@
+package raft_test
+
+import (
+	"bytes"
+	"testing"
+
+	fsm "local-chain/internal/adapters/inbound/raft"
+	"local-chain/internal/adapters/outbound/inMem"
+	"local-chain/internal/adapters/outbound/leveldb"
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/types"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/require"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+// acceptAll is a block validator accepting every block, the tests are about applying them
+type acceptAll struct{}
+
+func (acceptAll) Validate(*types.BlockTxsEnvelope) error {
+	return nil
+}
+
+// snapshotSink keeps the snapshot in memory and records how it was finished
+type snapshotSink struct {
+	bytes.Buffer
+	closed    bool
+	cancelled bool
+}
+
+func (s *snapshotSink) ID() string {
+	return "test"
+}
+
+func (s *snapshotSink) Close() error {
+	s.closed = true
+	return nil
+}
+
+func (s *snapshotSink) Cancel() error {
+	s.cancelled = true
+	return nil
+}
+
+func newStore(t *testing.T) *leveldb.Store {
+	return leveldb.New(func(string) leveldb.Database {
+		db, err := goleveldb.Open(storage.NewMemStorage(), nil)
+		require.NoError(t, err)
+		return db
+	})
+}
+
+func apply(t *testing.T, f *fsm.Fsm, envelopeType types.EnvelopeType, data []byte) interface{} {
+	envelopeBytes, err := types.NewEnvelope(envelopeType, data).ToBytes()
+	require.NoError(t, err)
+	return f.Apply(&raft.Log{Type: raft.LogCommand, Data: envelopeBytes})
+}
+
+// snapshot persists the state of the FSM and restores it into a new FSM of the store
+func snapshot(t *testing.T, from *fsm.Fsm, to *fsm.Fsm) {
+	snap, err := from.Snapshot()
+	require.NoError(t, err)
+	sink := &snapshotSink{}
+	require.NoError(t, snap.Persist(sink))
+	require.True(t, sink.closed)
+	require.False(t, sink.cancelled)
+	require.NoError(t, to.Restore(&snapshotReader{Reader: bytes.NewReader(sink.Bytes())}))
+}
+
+type snapshotReader struct {
+	*bytes.Reader
+}
+
+func (r *snapshotReader) Close() error {
+	return nil
+}
+
+func TestFsm_SnapshotRestore(t *testing.T) {
+	alice := crypto.GenerateKeyEllipticP256()
+	aliceAddress := crypto.PublicKeyHash(&alice.PublicKey)
+
+	store := newStore(t)
+	f := fsm.New(store, inMem.NewTxPool(), acceptAll{})
+	// the block pays alice and issues an asset, the pending transaction spends the payment
+	payment := types.NewTransaction().WithOutput(types.NewAmount(100), &alice.PublicKey)
+	payment.Issuance = &types.Asset{
+		ID:     types.NewAssetID(payment.ID, "GOLD"),
+		Name:   "GOLD",
+		Supply: 10,
+		Issuer: crypto.PublicKeyToBytes(&alice.PublicKey),
+	}
+	payment.ComputeHash()
+	block := types.NewBlockTxsEnvelope(types.NewBlock(1, nil, nil), types.Transactions{payment})
+	blockBytes, err := block.ToBytes()
+	require.NoError(t, err)
+	require.Nil(t, apply(t, f, types.EnvelopeTypeBlock, blockBytes))
+	pending := types.NewTransaction().
+		WithInputs(types.NewTxIn(types.NewUTXO(payment.ID, payment.GetHash(), 0), nil, nil, nil, types.SequenceFinal)).
+		WithOutput(types.NewAmount(100), &alice.PublicKey)
+	pending.ComputeHash()
+	pendingBytes, err := pending.ToBytes()
+	require.NoError(t, err)
+	require.Nil(t, apply(t, f, types.EnvelopeTypeTransaction, pendingBytes))
+
+	// the restored replica had a chain of its own, the snapshot replaces it
+	restoredStore := newStore(t)
+	txPool := inMem.NewTxPool()
+	restored := fsm.New(restoredStore, txPool, acceptAll{})
+	stale := types.NewTransaction().WithOutput(types.NewAmount(50), &alice.PublicKey)
+	stale.ComputeHash()
+	staleBytes, err := types.NewBlockTxsEnvelope(types.NewBlock(1, nil, nil), types.Transactions{stale}).ToBytes()
+	require.NoError(t, err)
+	require.Nil(t, apply(t, restored, types.EnvelopeTypeBlock, staleBytes))
+
+	snapshot(t, f, restored)
+
+	last, err := restoredStore.Blockchain().GetLast()
+	require.NoError(t, err)
+	require.Equal(t, block.Block.Hash, last.Hash)
+	confirmed, err := restoredStore.Transaction().Has(payment.ID)
+	require.NoError(t, err)
+	require.True(t, confirmed)
+	confirmed, err = restoredStore.Transaction().Has(stale.ID)
+	require.NoError(t, err)
+	require.False(t, confirmed)
+	utxos, err := restoredStore.Utxo().GetByOwner(aliceAddress)
+	require.NoError(t, err)
+	require.Len(t, utxos, 1)
+	require.Equal(t, payment.ID, utxos[0].UTXO.TxID)
+	require.Equal(t, uint64(1), utxos[0].Height)
+	asset, err := restoredStore.Asset().Get(payment.Issuance.ID)
+	require.NoError(t, err)
+	require.Equal(t, payment.Issuance, asset)
+	require.Equal(t, []uuid.UUID{pending.ID}, txPool.Ordered().IDs())
+}
+
+func TestFsm_ApplyConfirmedTransaction(t *testing.T) {
+	alice := crypto.GenerateKeyEllipticP256()
+	f := fsm.New(newStore(t), inMem.NewTxPool(), acceptAll{})
+	tx := types.NewTransaction().WithOutput(types.NewAmount(100), &alice.PublicKey)
+	tx.ComputeHash()
+	blockBytes, err := types.NewBlockTxsEnvelope(types.NewBlock(1, nil, nil), types.Transactions{tx}).ToBytes()
+	require.NoError(t, err)
+	require.Nil(t, apply(t, f, types.EnvelopeTypeBlock, blockBytes))
+
+	// a transaction of a confirmed ID is refused, the client sees why
+	txBytes, err := tx.ToBytes()
+	require.NoError(t, err)
+	response := apply(t, f, types.EnvelopeTypeTransaction, txBytes)
+	require.Error(t, response.(error))
+}
+
+func userCommand(t *testing.T, op types.UserOp, user *types.User) []byte {
+	cmdBytes, err := (&types.UserCommand{Op: op, User: user}).ToBytes()
+	require.NoError(t, err)
+	return cmdBytes
+}
+
+// decoded is the user as the store decodes it, of empty rather than nil slices
+func decoded(t *testing.T, user *types.User) *types.User {
+	userBytes, err := user.ToBytes()
+	require.NoError(t, err)
+	decoded := &types.User{}
+	require.NoError(t, decoded.FromBytes(userBytes))
+	return decoded
+}
+
+func TestFsm_ApplyUser(t *testing.T) {
+	alice := crypto.GenerateKeyEllipticP256()
+	user := &types.User{Username: "alice", PublicKey: crypto.PublicKeyToBytes(&alice.PublicKey), Email: "alice@example.com"}
+	updated := *user
+	updated.Status = types.UserDisabled
+	bob := &types.User{Username: "bob", PublicKey: crypto.PublicKeyToBytes(&crypto.GenerateKeyEllipticP256().PublicKey)}
+
+	tests := []struct {
+		name    string
+		op      types.UserOp
+		user    *types.User
+		want    *types.User
+		wantErr bool
+	}{
+		{
+			name: "ok create",
+			op:   types.UserOpCreate,
+			user: user,
+			want: user,
+		},
+		{
+			name:    "err create of a taken username",
+			op:      types.UserOpCreate,
+			user:    &updated,
+			want:    user,
+			wantErr: true,
+		},
+		{
+			name: "ok update",
+			op:   types.UserOpUpdate,
+			user: &updated,
+			want: &updated,
+		},
+		{
+			name:    "err update of an unknown user",
+			op:      types.UserOpUpdate,
+			user:    bob,
+			wantErr: true,
+		},
+		{
+			name:    "err unknown operation",
+			op:      types.UserOpUpdate + 1,
+			user:    bob,
+			wantErr: true,
+		},
+	}
+	store := newStore(t)
+	f := fsm.New(store, inMem.NewTxPool(), acceptAll{})
+	// the cases apply one after another to the same replica
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := apply(t, f, types.EnvelopeTypeUser, userCommand(t, tt.op, tt.user))
+			if tt.wantErr {
+				require.Error(t, response.(error))
+			} else {
+				require.Nil(t, response)
+			}
+			stored, err := store.User().Get(tt.user.Username)
+			if tt.want == nil {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, decoded(t, tt.want), stored)
+		})
+	}
+}
+
+func TestFsm_SnapshotRestoreUsers(t *testing.T) {
+	alice := crypto.GenerateKeyEllipticP256()
+	f := fsm.New(newStore(t), inMem.NewTxPool(), acceptAll{})
+	user := &types.User{Username: "alice", PublicKey: crypto.PublicKeyToBytes(&alice.PublicKey), DisplayName: "Alice"}
+	require.Nil(t, apply(t, f, types.EnvelopeTypeUser, userCommand(t, types.UserOpCreate, user)))
+
+	// the restored replica registered a user of its own, the snapshot replaces the registry
+	restoredStore := newStore(t)
+	restored := fsm.New(restoredStore, inMem.NewTxPool(), acceptAll{})
+	stale := &types.User{Username: "mallory", PublicKey: crypto.PublicKeyToBytes(&crypto.GenerateKeyEllipticP256().PublicKey)}
+	require.Nil(t, apply(t, restored, types.EnvelopeTypeUser, userCommand(t, types.UserOpCreate, stale)))
+
+	snapshot(t, f, restored)
+
+	users, err := restoredStore.User().GetAll()
+	require.NoError(t, err)
+	require.Equal(t, []*types.User{decoded(t, user)}, users)
+	// the address index is rebuilt with the registry
+	byAddress, err := restoredStore.User().GetByAddress(crypto.PublicKeyHash(&alice.PublicKey))
+	require.NoError(t, err)
+	require.Equal(t, decoded(t, user), byAddress)
+	byAddress, err = restoredStore.User().GetByAddress(types.AddressOf(stale.PublicKey))
+	require.NoError(t, err)
+	require.Nil(t, byAddress)
+}
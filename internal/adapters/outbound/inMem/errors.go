@@ -0,0 +1,9 @@
+package inMem
+
+import "errors"
+
+var (
+	ErrTxAlreadyInPool = errors.New("transaction is already in the pool")
+	// ErrOutputAlreadySpent is returned when a transaction spends an output another pending transaction spends
+	ErrOutputAlreadySpent = errors.New("output is already spent by a pending transaction")
+)
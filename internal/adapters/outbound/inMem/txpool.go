@@ -1,6 +1,10 @@
 package inMem
 
 import (
+	"bytes"
+	"fmt"
+	"maps"
+	"sort"
 	"sync"
 
 	"local-chain/internal/types"
@@ -9,8 +13,9 @@ import (
 )
 
 type (
-	Pool      map[uuid.UUID]*types.Transaction
-	utxosPool map[string]types.UTXOs
+	Pool map[uuid.UUID]*types.Transaction
+	// txSet is a set of pending transactions, used for the edges of the dependency graph
+	txSet map[uuid.UUID]struct{}
 )
 
 func (pool Pool) AsSlice() types.Transactions {
@@ -22,59 +27,238 @@ func (pool Pool) AsSlice() types.Transactions {
 }
 
 type TxPool struct {
-	// general pool with transactions by tx hash
+	// general pool with transactions by tx id
 	pool Pool
-	// unspent transactions pool by owner
-	utxosPool utxosPool
-	mtx       sync.Mutex
+	// outpoints consumed by pending transactions: outpoint -> spending tx id
+	spent map[string]uuid.UUID
+	// outputs created by pending transactions by outpoint
+	created map[string]*types.UnspentOutput
+	// dependency graph of unconfirmed chains: tx id -> pending txs it spends from / pending txs spending from it
+	parents  map[uuid.UUID]txSet
+	children map[uuid.UUID]txSet
+	mtx      sync.Mutex
 }
 
 func NewTxPool() *TxPool {
 	return &TxPool{
-		pool:      make(Pool),
-		utxosPool: make(utxosPool),
+		pool:     make(Pool),
+		spent:    make(map[string]uuid.UUID),
+		created:  make(map[string]*types.UnspentOutput),
+		parents:  make(map[uuid.UUID]txSet),
+		children: make(map[uuid.UUID]txSet),
 	}
 }
 
+// AddTx puts the transaction into the pool.
+// The transaction is rejected if any of its inputs spends an output already spent by another pending transaction,
+// unless every such transaction waits for its lock time (see types.Transaction.LockTimeInForce) and this one
+// doesn't: the waiting transactions are replaced, evicted together with their descendants.
 func (txp *TxPool) AddTx(tx *types.Transaction) error {
 	txp.mtx.Lock()
 	defer txp.mtx.Unlock()
 
+	if _, ok := txp.pool[tx.ID]; ok {
+		return fmt.Errorf("%w: %s", ErrTxAlreadyInPool, tx.ID)
+	}
+	var replaced []uuid.UUID
+	for _, in := range tx.Inputs {
+		if in.Prev == nil {
+			continue
+		}
+		spender, ok := txp.spent[outpointKey(in.Prev)]
+		if !ok {
+			continue
+		}
+		if tx.LockTimeInForce() || !txp.pool[spender].LockTimeInForce() {
+			return fmt.Errorf("%w: output %s, transaction %s", ErrOutputAlreadySpent, outpointKey(in.Prev), spender)
+		}
+		replaced = append(replaced, spender)
+	}
+	for _, id := range replaced {
+		txp.evict(id)
+	}
+
 	txp.pool[tx.ID] = tx
+	txp.parents[tx.ID] = make(txSet)
+	txp.children[tx.ID] = make(txSet)
+	for _, in := range tx.Inputs {
+		if in.Prev == nil {
+			continue
+		}
+		txp.spent[outpointKey(in.Prev)] = tx.ID
+		if _, ok := txp.pool[in.Prev.TxID]; ok && in.Prev.TxID != tx.ID {
+			txp.parents[tx.ID][in.Prev.TxID] = struct{}{}
+			txp.children[in.Prev.TxID][tx.ID] = struct{}{}
+		}
+	}
+	for index, output := range tx.Outputs {
+		utxo := types.NewUTXO(tx.ID, tx.GetHash(), uint32(index))
+		txp.created[outpointKey(utxo)] = &types.UnspentOutput{UTXO: utxo, Output: output}
+	}
 	return nil
 }
 
-func (txp *TxPool) AddUtxos(pubKey []byte, utxos ...*types.UTXO) {
+// Get returns the pending transaction of the ID.
+func (txp *TxPool) Get(id uuid.UUID) (*types.Transaction, bool) {
 	txp.mtx.Lock()
 	defer txp.mtx.Unlock()
-	txp.utxosPool[string(pubKey)] = utxos
+	tx, ok := txp.pool[id]
+	return tx, ok
 }
 
+// GetPool returns a copy of the pool, the pool keeps changing while the copy is read
 func (txp *TxPool) GetPool() Pool {
 	txp.mtx.Lock()
 	defer txp.mtx.Unlock()
-	return txp.pool
+	return maps.Clone(txp.pool)
 }
 
-func (txp *TxPool) GetUTXOs(pubKey []byte) types.UTXOs {
+func (txp *TxPool) GetUnspentTx() Pool {
 	txp.mtx.Lock()
 	defer txp.mtx.Unlock()
-	utxos, ok := txp.utxosPool[string(pubKey)]
-	if !ok {
-		return nil
+	return maps.Clone(txp.pool)
+}
+
+// GetUTXOs returns outputs of pending transactions of the owner (see types.TxOut.Owner) that no pending transaction spends yet
+func (txp *TxPool) GetUTXOs(owner []byte) []*types.UnspentOutput {
+	txp.mtx.Lock()
+	defer txp.mtx.Unlock()
+	var utxos []*types.UnspentOutput
+	for key, utxo := range txp.created {
+		if _, ok := txp.spent[key]; ok {
+			continue
+		}
+		if bytes.Equal(utxo.Output.Owner(), owner) {
+			utxos = append(utxos, utxo)
+		}
 	}
+	sort.Slice(utxos, func(i, j int) bool {
+		return outpointKey(utxos[i].UTXO) < outpointKey(utxos[j].UTXO)
+	})
 	return utxos
 }
 
-func (txp *TxPool) GetUnspentTx() Pool {
+// IsSpent reports whether a pending transaction spends the output
+func (txp *TxPool) IsSpent(outpoint *types.UTXO) bool {
 	txp.mtx.Lock()
 	defer txp.mtx.Unlock()
-	return txp.pool
+	_, ok := txp.spent[outpointKey(outpoint)]
+	return ok
 }
 
+// Spender returns the pending transaction spending the output
+func (txp *TxPool) Spender(outpoint *types.UTXO) (*types.Transaction, bool) {
+	txp.mtx.Lock()
+	defer txp.mtx.Unlock()
+	id, ok := txp.spent[outpointKey(outpoint)]
+	if !ok {
+		return nil, false
+	}
+	return txp.pool[id], true
+}
+
+// Ordered returns pending transactions with every transaction placed after the transactions it spends from.
+// Among the transactions whose parents are already placed the highest fee rate goes first, then the oldest.
+func (txp *TxPool) Ordered() types.Transactions {
+	txp.mtx.Lock()
+	defer txp.mtx.Unlock()
+
+	feeRates := make(map[uuid.UUID]uint64, len(txp.pool))
+	waiting := make(map[uuid.UUID]int, len(txp.pool))
+	ready := make(types.Transactions, 0, len(txp.pool))
+	for id, tx := range txp.pool {
+		feeRates[id] = tx.FeeRate()
+		waiting[id] = len(txp.parents[id])
+		if waiting[id] == 0 {
+			ready = append(ready, tx)
+		}
+	}
+	ordered := make(types.Transactions, 0, len(txp.pool))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool {
+			if feeRates[ready[i].ID] != feeRates[ready[j].ID] {
+				return feeRates[ready[i].ID] > feeRates[ready[j].ID]
+			}
+			return ready[i].Timestamp < ready[j].Timestamp
+		})
+		tx := ready[0]
+		ready = ready[1:]
+		ordered = append(ordered, tx)
+		for child := range txp.children[tx.ID] {
+			waiting[child]--
+			if waiting[child] == 0 {
+				ready = append(ready, txp.pool[child])
+			}
+		}
+	}
+	return ordered
+}
+
+// Remove drops confirmed transactions from the pool.
+// Pending transactions spending their outputs stay in the pool, their parents are in the UTXO set now.
+func (txp *TxPool) Remove(ids ...uuid.UUID) {
+	txp.mtx.Lock()
+	defer txp.mtx.Unlock()
+	for _, id := range ids {
+		txp.remove(id)
+	}
+}
+
+// Evict drops invalid transactions from the pool together with every pending transaction depending on them.
+func (txp *TxPool) Evict(ids ...uuid.UUID) {
+	txp.mtx.Lock()
+	defer txp.mtx.Unlock()
+	for _, id := range ids {
+		txp.evict(id)
+	}
+}
+
+func (txp *TxPool) evict(id uuid.UUID) {
+	children := txp.children[id]
+	txp.remove(id)
+	for child := range children {
+		txp.evict(child)
+	}
+}
+
+func (txp *TxPool) remove(id uuid.UUID) {
+	tx, ok := txp.pool[id]
+	if !ok {
+		return
+	}
+	for _, in := range tx.Inputs {
+		if in.Prev == nil {
+			continue
+		}
+		if spender, ok := txp.spent[outpointKey(in.Prev)]; ok && spender == id {
+			delete(txp.spent, outpointKey(in.Prev))
+		}
+	}
+	for index := range tx.Outputs {
+		delete(txp.created, outpointKey(types.NewUTXO(tx.ID, tx.GetHash(), uint32(index))))
+	}
+	for parent := range txp.parents[id] {
+		delete(txp.children[parent], id)
+	}
+	for child := range txp.children[id] {
+		delete(txp.parents[child], id)
+	}
+	delete(txp.parents, id)
+	delete(txp.children, id)
+	delete(txp.pool, id)
+}
+
+// Purge drops every pending transaction
 func (txp *TxPool) Purge() {
 	txp.mtx.Lock()
 	defer txp.mtx.Unlock()
 	txp.pool = make(Pool)
-	txp.utxosPool = make(utxosPool)
+	txp.spent = make(map[string]uuid.UUID)
+	txp.created = make(map[string]*types.UnspentOutput)
+	txp.parents = make(map[uuid.UUID]txSet)
+	txp.children = make(map[uuid.UUID]txSet)
+}
+
+func outpointKey(utxo *types.UTXO) string {
+	return fmt.Sprintf("%s:%d", utxo.TxID, utxo.Index)
 }
@@ -0,0 +1,135 @@
+package inMem_test
+
+import (
+	"testing"
+
+	"local-chain/internal/adapters/outbound/inMem"
+	"local-chain/internal/pkg/crypto"
+
+	"local-chain/internal/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxPool(t1 *testing.T) {
+	from := crypto.GenerateKeyEllipticP256()
+	fromPubKey := crypto.PublicKeyToBytes(&from.PublicKey)
+	to := crypto.GenerateKeyEllipticP256()
+	spend := func(prev *types.Transaction, index uint32, amount uint64) *types.Transaction {
+		tx := types.NewTransaction().
+			WithInputs(types.NewTxIn(types.NewUTXO(prev.ID, prev.GetHash(), index), fromPubKey, nil, nil, 0)).
+			WithOutput(types.NewAmount(amount), &from.PublicKey)
+		tx.ComputeHash()
+		return tx
+	}
+	confirmed := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+	confirmed.ComputeHash()
+
+	tests := []struct {
+		name string
+		test func(t1 *testing.T, pool *inMem.TxPool)
+	}{
+		{
+			name: "err output spent by another pending transaction",
+			test: func(t1 *testing.T, pool *inMem.TxPool) {
+				pending, conflicting := spend(confirmed, 0, 100), spend(confirmed, 0, 100)
+				require.NoError(t1, pool.AddTx(pending))
+				require.ErrorIs(t1, pool.AddTx(conflicting), inMem.ErrOutputAlreadySpent)
+				require.True(t1, pool.IsSpent(types.NewUTXO(confirmed.ID, confirmed.GetHash(), 0)))
+				require.Len(t1, pool.GetPool(), 1)
+				got, ok := pool.Get(pending.ID)
+				require.True(t1, ok)
+				require.Equal(t1, pending, got)
+				_, ok = pool.Get(conflicting.ID)
+				require.False(t1, ok)
+			},
+		},
+		{
+			name: "ok chain of unconfirmed transactions ordered parents first",
+			test: func(t1 *testing.T, pool *inMem.TxPool) {
+				parent := spend(confirmed, 0, 100)
+				child := spend(parent, 0, 100)
+				grandChild := spend(child, 0, 100)
+				// the child is created after the grandchild to make timestamps disagree with the graph
+				child.Timestamp = grandChild.Timestamp + 1
+				require.NoError(t1, pool.AddTx(parent))
+				require.NoError(t1, pool.AddTx(child))
+				require.NoError(t1, pool.AddTx(grandChild))
+
+				require.Equal(t1, types.Transactions{parent, child, grandChild}, pool.Ordered())
+				utxos := pool.GetUTXOs(crypto.PublicKeyHash(&from.PublicKey))
+				require.Len(t1, utxos, 1)
+				require.Equal(t1, grandChild.ID, utxos[0].UTXO.TxID)
+				require.Empty(t1, pool.GetUTXOs(crypto.PublicKeyHash(&to.PublicKey)))
+			},
+		},
+		{
+			name: "ok higher fee rate goes first",
+			test: func(t1 *testing.T, pool *inMem.TxPool) {
+				other := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				cheap := spend(confirmed, 0, 100)
+				expensive := spend(other, 0, 90)
+				expensive.Fee = 10
+				expensive.Timestamp = cheap.Timestamp + 1
+				require.NoError(t1, pool.AddTx(cheap))
+				require.NoError(t1, pool.AddTx(expensive))
+
+				require.Equal(t1, types.Transactions{expensive, cheap}, pool.Ordered())
+			},
+		},
+		{
+			name: "ok remove confirmed parent keeps children",
+			test: func(t1 *testing.T, pool *inMem.TxPool) {
+				parent := spend(confirmed, 0, 100)
+				child := spend(parent, 0, 100)
+				require.NoError(t1, pool.AddTx(parent))
+				require.NoError(t1, pool.AddTx(child))
+
+				pool.Remove(parent.ID)
+				require.Equal(t1, types.Transactions{child}, pool.Ordered())
+				require.False(t1, pool.IsSpent(types.NewUTXO(confirmed.ID, confirmed.GetHash(), 0)))
+			},
+		},
+		{
+			name: "ok evict invalid parent drops descendants",
+			test: func(t1 *testing.T, pool *inMem.TxPool) {
+				parent := spend(confirmed, 0, 100)
+				child := spend(parent, 0, 100)
+				require.NoError(t1, pool.AddTx(parent))
+				require.NoError(t1, pool.AddTx(child))
+
+				pool.Evict(parent.ID)
+				require.Empty(t1, pool.Ordered())
+				require.Empty(t1, pool.GetUTXOs(crypto.PublicKeyHash(&from.PublicKey)))
+				// the output is free again
+				require.NoError(t1, pool.AddTx(spend(confirmed, 0, 100)))
+			},
+		},
+		{
+			name: "ok transaction replaces a conflicting one waiting for its lock time",
+			test: func(t1 *testing.T, pool *inMem.TxPool) {
+				refund := spend(confirmed, 0, 100)
+				refund.LockTime = 1_000
+				child := spend(refund, 0, 100)
+				require.NoError(t1, pool.AddTx(refund))
+				require.NoError(t1, pool.AddTx(child))
+				// another transaction waiting for its lock time can't replace it
+				later := spend(confirmed, 0, 100)
+				later.LockTime = 2_000
+				require.ErrorIs(t1, pool.AddTx(later), inMem.ErrOutputAlreadySpent)
+
+				release := spend(confirmed, 0, 100)
+				require.NoError(t1, pool.AddTx(release))
+				require.Equal(t1, types.Transactions{release}, pool.Ordered())
+				spender, ok := pool.Spender(types.NewUTXO(confirmed.ID, confirmed.GetHash(), 0))
+				require.True(t1, ok)
+				require.Equal(t1, release, spender)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			tt.test(t1, inMem.NewTxPool())
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package leveldb
+
+import (
+	"errors"
+	"fmt"
+
+	"local-chain/internal/types"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	leveldbErrors "github.com/syndtr/goleveldb/leveldb/errors"
+)
+
+// assetS keeps the definitions of the issued assets by asset ID
+type assetS struct {
+	db Database
+}
+
+func newAssetStore(conn Database) *assetS {
+	return &assetS{
+		db: conn,
+	}
+}
+
+func (s *assetS) GetAll() ([]*types.Asset, error) {
+	iterator := s.db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	var assets []*types.Asset
+	for iterator.Next() {
+		var asset *types.Asset
+		if err := rlp.DecodeBytes(iterator.Value(), &asset); err != nil {
+			return nil, fmt.Errorf("failed to decode asset: %w", err)
+		}
+		assets = append(assets, asset)
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, fmt.Errorf("AssetStore.GetAll iterate error: %w", err)
+	}
+	return assets, nil
+}
+
+// Get returns the asset or nil if no confirmed transaction issued it
+func (s *assetS) Get(id []byte) (*types.Asset, error) {
+	raw, err := s.db.Get(id, nil)
+	if err != nil && !errors.Is(err, leveldbErrors.ErrNotFound) {
+		return nil, fmt.Errorf("AssetStore.Get get asset error: %w", err)
+	}
+	if raw == nil {
+		return nil, nil
+	}
+	var asset *types.Asset
+	if err = rlp.DecodeBytes(raw, &asset); err != nil {
+		return nil, fmt.Errorf("failed to decode asset: %w", err)
+	}
+	return asset, nil
+}
+
+// Put stores the asset, the ID is derived from the issuance transaction, so storing it again changes nothing
+func (s *assetS) Put(asset *types.Asset) error {
+	encoded, err := rlp.EncodeToBytes(asset)
+	if err != nil {
+		return fmt.Errorf("failed to encode asset: %w", err)
+	}
+	if err = s.db.Put(asset.ID, encoded, nil); err != nil {
+		return fmt.Errorf("failed to put asset: %w", err)
+	}
+	return nil
+}
@@ -63,6 +63,26 @@ func (s *blockchainS) GetByTimestamp(t uint64) (*types.Block, error) {
 	return block, nil
 }
 
+// GetLast returns the most recent block or nil if the chain is empty.
+// Keys are nanosecond timestamps of the same length, so the last key is the latest block.
+func (s *blockchainS) GetLast() (*types.Block, error) {
+	iterator := s.db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	if !iterator.Last() {
+		if err := iterator.Error(); err != nil {
+			return nil, fmt.Errorf("blockchainStore.GetLast iterate error: %w", err)
+		}
+		return nil, nil
+	}
+	var block *types.Block
+	if err := rlp.DecodeBytes(iterator.Value(), &block); err != nil {
+		return nil, fmt.Errorf("failed to decode block: %w", err)
+	}
+
+	return block, nil
+}
+
 func (s *blockchainS) Put(block *types.Block) error {
 	existingBlock, err := s.GetByTimestamp(block.Timestamp)
 	if err != nil {
@@ -0,0 +1,68 @@
+package leveldb
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"local-chain/internal/types"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+// participantPrefix keys escrows by participant key hash and outpoint
+const participantPrefix = "participant/"
+
+// escrowS indexes the confirmed escrows by each of their parties, spent escrows stay indexed
+type escrowS struct {
+	db Database
+}
+
+func newEscrowStore(conn Database) *escrowS {
+	return &escrowS{
+		db: conn,
+	}
+}
+
+// GetByParticipant lists the escrows the key is the buyer, the seller or the arbiter of, ordered by outpoint
+func (s *escrowS) GetByParticipant(pubKey []byte) ([]*types.Escrow, error) {
+	iterator := s.db.NewIterator(util.BytesPrefix(participantIndexPrefix(pubKey)), nil)
+	defer iterator.Release()
+
+	var escrows []*types.Escrow
+	for iterator.Next() {
+		var escrow *types.Escrow
+		if err := rlp.DecodeBytes(iterator.Value(), &escrow); err != nil {
+			return nil, fmt.Errorf("failed to decode escrow: %w", err)
+		}
+		escrows = append(escrows, escrow)
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, fmt.Errorf("EscrowStore.GetByParticipant iterate error: %w", err)
+	}
+	return escrows, nil
+}
+
+// Put indexes the escrow by each of its parties
+func (s *escrowS) Put(escrow *types.Escrow) error {
+	encoded, err := rlp.EncodeToBytes(escrow)
+	if err != nil {
+		return fmt.Errorf("failed to encode escrow: %w", err)
+	}
+	batch := new(goleveldb.Batch)
+	for _, pubKey := range escrow.Participants() {
+		key := append(participantIndexPrefix(pubKey), fmt.Sprintf("%s:%d", escrow.Outpoint.TxID, escrow.Outpoint.Index)...)
+		batch.Put(key, encoded)
+	}
+	if err = s.db.Write(batch, nil); err != nil {
+		return fmt.Errorf("failed to put escrow: %w", err)
+	}
+	return nil
+}
+
+func participantIndexPrefix(pubKey []byte) []byte {
+	hash := sha256.Sum256(pubKey)
+	return []byte(participantPrefix + hex.EncodeToString(hash[:]) + "/")
+}
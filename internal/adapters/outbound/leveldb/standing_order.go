@@ -0,0 +1,69 @@
+package leveldb
+
+import (
+	"errors"
+	"fmt"
+
+	"local-chain/internal/types"
+
+	"github.com/ethereum/go-ethereum/rlp"
+	leveldbErrors "github.com/syndtr/goleveldb/leveldb/errors"
+)
+
+// standingOrderS keeps the confirmed standing orders with their payments by order ID
+type standingOrderS struct {
+	db Database
+}
+
+func newStandingOrderStore(conn Database) *standingOrderS {
+	return &standingOrderS{
+		db: conn,
+	}
+}
+
+// GetAll lists the standing orders, cancelled and ended ones included, ordered by ID
+func (s *standingOrderS) GetAll() ([]*types.StandingOrderRecord, error) {
+	iterator := s.db.NewIterator(nil, nil)
+	defer iterator.Release()
+
+	var records []*types.StandingOrderRecord
+	for iterator.Next() {
+		var record *types.StandingOrderRecord
+		if err := rlp.DecodeBytes(iterator.Value(), &record); err != nil {
+			return nil, fmt.Errorf("failed to decode standing order: %w", err)
+		}
+		records = append(records, record)
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, fmt.Errorf("StandingOrderStore.GetAll iterate error: %w", err)
+	}
+	return records, nil
+}
+
+// Get returns the standing order or nil if no confirmed transaction created it
+func (s *standingOrderS) Get(id []byte) (*types.StandingOrderRecord, error) {
+	raw, err := s.db.Get(id, nil)
+	if err != nil && !errors.Is(err, leveldbErrors.ErrNotFound) {
+		return nil, fmt.Errorf("StandingOrderStore.Get get standing order error: %w", err)
+	}
+	if raw == nil {
+		return nil, nil
+	}
+	var record *types.StandingOrderRecord
+	if err = rlp.DecodeBytes(raw, &record); err != nil {
+		return nil, fmt.Errorf("failed to decode standing order: %w", err)
+	}
+	return record, nil
+}
+
+// Put stores the standing order with its state and payments, replacing the stored one
+func (s *standingOrderS) Put(record *types.StandingOrderRecord) error {
+	encoded, err := rlp.EncodeToBytes(record)
+	if err != nil {
+		return fmt.Errorf("failed to encode standing order: %w", err)
+	}
+	if err = s.db.Put(record.Order.ID, encoded, nil); err != nil {
+		return fmt.Errorf("failed to put standing order: %w", err)
+	}
+	return nil
+}
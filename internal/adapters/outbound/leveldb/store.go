@@ -5,6 +5,7 @@ import (
 
 	"local-chain/internal/service"
 
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/iterator"
 	"github.com/syndtr/goleveldb/leveldb/opt"
 	"github.com/syndtr/goleveldb/leveldb/util"
@@ -14,6 +15,7 @@ type Database interface {
 	Get(key []byte, ro *opt.ReadOptions) (value []byte, err error)
 	Put(key, value []byte, wo *opt.WriteOptions) error
 	Delete(key []byte, wo *opt.WriteOptions) error
+	Write(batch *goleveldb.Batch, wo *opt.WriteOptions) error
 	NewIterator(slice *util.Range, ro *opt.ReadOptions) iterator.Iterator
 	Close() error
 }
@@ -24,6 +26,9 @@ type Store struct {
 	utxo              *utxoS
 	user              *userS
 	blockTransactions *blockTransactionsS
+	asset             *assetS
+	escrow            *escrowS
+	standingOrder     *standingOrderS
 }
 
 type dbF func(subPath string) Database
@@ -33,8 +38,11 @@ func New(newDB dbF) *Store {
 		transaction:       newTransactionStore(newDB("transaction")),
 		blockchain:        newBlockchainStore(newDB("blockchain")),
 		utxo:              newUtxoStore(newDB("utxo")),
-		user:              newUserStore(newDB("user")),
+		user:              newUserStore(newDB("user"), newDB("user_address")),
 		blockTransactions: newBlockTransactionsStore(newDB("block_transactions")),
+		asset:             newAssetStore(newDB("asset")),
+		escrow:            newEscrowStore(newDB("escrow")),
+		standingOrder:     newStandingOrderStore(newDB("standing_order")),
 	}
 }
 
@@ -58,6 +66,56 @@ func (s *Store) BlockTransactions() service.BlockTxStore {
 	return s.blockTransactions
 }
 
+func (s *Store) Asset() service.AssetStore {
+	return s.asset
+}
+
+func (s *Store) Escrow() service.EscrowStore {
+	return s.escrow
+}
+
+func (s *Store) StandingOrder() service.StandingOrderStore {
+	return s.standingOrder
+}
+
+// IndexUsers indexes the users by the addresses of their keys, if the store was written before the index.
+func (s *Store) IndexUsers() error {
+	return s.user.reindex()
+}
+
+// ClearChain deletes the blocks and every store derived from them, the users stay.
+func (s *Store) ClearChain() error {
+	for name, db := range map[string]Database{
+		"blockchain":         s.blockchain.db,
+		"transaction":        s.transaction.db,
+		"utxo":               s.utxo.db,
+		"block transactions": s.blockTransactions.db,
+		"asset":              s.asset.db,
+		"escrow":             s.escrow.db,
+		"standing order":     s.standingOrder.db,
+	} {
+		if err := deleteAll(db); err != nil {
+			return fmt.Errorf("failed to clear %s store: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// deleteAll deletes every key of the database in one batch
+func deleteAll(db Database) error {
+	iter := db.NewIterator(nil, nil)
+	defer iter.Release()
+
+	batch := new(goleveldb.Batch)
+	for iter.Next() {
+		batch.Delete(iter.Key())
+	}
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("failed to iterate over keys: %w", err)
+	}
+	return db.Write(batch, nil)
+}
+
 func (s *Store) Close() error {
 	if err := s.blockchain.db.Close(); err != nil {
 		return fmt.Errorf("error closing blockchain store: %w", err)
@@ -75,5 +133,21 @@ func (s *Store) Close() error {
 		return fmt.Errorf("error closing user store: %w", err)
 	}
 
+	if err := s.user.addresses.Close(); err != nil {
+		return fmt.Errorf("error closing user address store: %w", err)
+	}
+
+	if err := s.asset.db.Close(); err != nil {
+		return fmt.Errorf("error closing asset store: %w", err)
+	}
+
+	if err := s.escrow.db.Close(); err != nil {
+		return fmt.Errorf("error closing escrow store: %w", err)
+	}
+
+	if err := s.standingOrder.db.Close(); err != nil {
+		return fmt.Errorf("error closing standing order store: %w", err)
+	}
+
 	return nil
 }
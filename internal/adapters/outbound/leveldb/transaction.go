@@ -1,14 +1,21 @@
 package leveldb
 
 import (
+	"encoding/hex"
+	"errors"
 	"fmt"
 
 	"local-chain/internal/types"
 
 	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/google/uuid"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
+	leveldbErrors "github.com/syndtr/goleveldb/leveldb/errors"
 )
 
+// dataPrefix is the secondary index: payload of a data output -> ID of the first transaction carrying it
+const dataPrefix = "data/"
+
 type transactionS struct {
 	db Database
 }
@@ -32,14 +39,63 @@ func (s *transactionS) Get(id uuid.UUID) (*types.Transaction, error) {
 	return tx, nil
 }
 
+// Has reports whether the transaction of the ID is stored, i.e. confirmed.
+func (s *transactionS) Has(id uuid.UUID) (bool, error) {
+	_, err := s.db.Get([]byte(id.String()), nil)
+	if errors.Is(err, leveldbErrors.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to get transaction: %w", err)
+	}
+	return true, nil
+}
+
+// GetByData returns the first stored transaction with a data output carrying the data, ErrNotFound if there is none.
+func (s *transactionS) GetByData(data []byte) (*types.Transaction, error) {
+	id, err := s.db.Get(dataKey(data), nil)
+	if errors.Is(err, leveldbErrors.ErrNotFound) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get data index: %w", err)
+	}
+	txID, err := uuid.ParseBytes(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse transaction id: %w", err)
+	}
+	return s.Get(txID)
+}
+
+// Put stores the transaction and indexes its data outputs, data anchored earlier keeps pointing to its first transaction.
 func (s *transactionS) Put(tx *types.Transaction) error {
 	encoded, err := rlp.EncodeToBytes(tx)
 	if err != nil {
 		return fmt.Errorf("failed to encode transaction: %w", err)
 	}
-	if err = s.db.Put([]byte(tx.ID.String()), encoded, nil); err != nil {
+	batch := new(goleveldb.Batch)
+	batch.Put([]byte(tx.ID.String()), encoded)
+	for _, out := range tx.Outputs {
+		if !out.IsData() {
+			continue
+		}
+		key := dataKey(out.Data())
+		_, err = s.db.Get(key, nil)
+		if err == nil {
+			continue
+		}
+		if !errors.Is(err, leveldbErrors.ErrNotFound) {
+			return fmt.Errorf("failed to get data index: %w", err)
+		}
+		batch.Put(key, []byte(tx.ID.String()))
+	}
+	if err = s.db.Write(batch, nil); err != nil {
 		return fmt.Errorf("failed to put transaction: %w", err)
 	}
 
 	return nil
 }
+
+func dataKey(data []byte) []byte {
+	return []byte(dataPrefix + hex.EncodeToString(data))
+}
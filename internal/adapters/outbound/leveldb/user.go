@@ -7,16 +7,20 @@ import (
 	"local-chain/internal/types"
 
 	"github.com/ethereum/go-ethereum/rlp"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
 	leveldbErrors "github.com/syndtr/goleveldb/leveldb/errors"
 )
 
 type userS struct {
 	db Database
+	// addresses indexes the users by the addresses of their keys: address -> username
+	addresses Database
 }
 
-func newUserStore(conn Database) *userS {
+func newUserStore(conn Database, addresses Database) *userS {
 	return &userS{
-		db: conn,
+		db:        conn,
+		addresses: addresses,
 	}
 }
 
@@ -34,9 +38,9 @@ func (s *userS) GetAll() ([]*types.User, error) {
 		if raw == nil {
 			return nil, ErrNotFound
 		}
-		var user *types.User
-		if err = rlp.DecodeBytes(raw, &user); err != nil {
-			return nil, fmt.Errorf("failed to decode user: %w", err)
+		user, err := decodeUser(raw)
+		if err != nil {
+			return nil, err
 		}
 		users = append(users, user)
 	}
@@ -51,12 +55,25 @@ func (s *userS) Get(username string) (*types.User, error) {
 	if raw == nil {
 		return nil, ErrNotFound
 	}
-	var user *types.User
-	if err = rlp.DecodeBytes(raw, &user); err != nil {
-		return nil, fmt.Errorf("failed to decode user: %w", err)
-	}
+	return decodeUser(raw)
+}
 
-	return user, nil
+// GetByAddress returns the user a key of the address belongs to: its current key, a key it rotated away
+// or a used key of its wallet. Nil if no user has the address.
+func (s *userS) GetByAddress(address []byte) (*types.User, error) {
+	username, err := s.addresses.Get(address, nil)
+	if errors.Is(err, leveldbErrors.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("UserStore.GetByAddress get address error: %w", err)
+	}
+	user, err := s.Get(string(username))
+	// the index is written ahead of the user, a user that failed to be written has no address
+	if errors.Is(err, ErrNotFound) {
+		return nil, nil
+	}
+	return user, err
 }
 
 func (s *userS) Put(user *types.User) error {
@@ -74,12 +91,90 @@ func (s *userS) Put(user *types.User) error {
 	if err != nil {
 		return fmt.Errorf("failed to encode user: %w", err)
 	}
+	if err = s.index(user); err != nil {
+		return err
+	}
 	if err = s.db.Put([]byte(user.Username), encoded, nil); err != nil {
 		return fmt.Errorf("failed to put new user: %w", err)
 	}
 	return nil
 }
 
+// Update overwrites a stored user, e.g. when its wallet hands out a new key. The user keeps the keys it had,
+// so the addresses it had stay indexed.
+func (s *userS) Update(user *types.User) error {
+	if _, err := s.Get(user.Username); err != nil {
+		return fmt.Errorf("UserStore.Update get user error: %w", err)
+	}
+	encoded, err := rlp.EncodeToBytes(user)
+	if err != nil {
+		return fmt.Errorf("failed to encode user: %w", err)
+	}
+	if err = s.index(user); err != nil {
+		return err
+	}
+	if err = s.db.Put([]byte(user.Username), encoded, nil); err != nil {
+		return fmt.Errorf("failed to update user: %w", err)
+	}
+	return nil
+}
+
+// Delete removes every user, e.g. before the registry of a snapshot is restored.
+func (s *userS) Delete() error {
+	keys, err := s.getKeys()
+	if err != nil {
+		return fmt.Errorf("failed to get keys for deletion: %w", err)
+	}
+	for _, key := range keys {
+		if err := s.db.Delete(key, nil); err != nil {
+			return fmt.Errorf("failed to delete user %s: %w", string(key), err)
+		}
+	}
+	if err = deleteAll(s.addresses); err != nil {
+		return fmt.Errorf("failed to delete address index: %w", err)
+	}
+	return nil
+}
+
+// reindex indexes every user when the address index is empty, for a user store written before the index.
+func (s *userS) reindex() error {
+	iterator := s.addresses.NewIterator(nil, nil)
+	indexed := iterator.Next()
+	iterator.Release()
+	if err := iterator.Error(); err != nil {
+		return fmt.Errorf("failed to iterate over addresses: %w", err)
+	}
+	if indexed {
+		return nil
+	}
+	users, err := s.GetAll()
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		if err = s.index(user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// index maps the addresses of the user's keys to the user
+func (s *userS) index(user *types.User) error {
+	addresses, err := user.Addresses()
+	if err != nil {
+		return fmt.Errorf("failed to index user: %w", err)
+	}
+	batch := new(goleveldb.Batch)
+	for _, address := range addresses {
+		batch.Put(address, []byte(user.Username))
+	}
+	if err = s.addresses.Write(batch, nil); err != nil {
+		return fmt.Errorf("failed to index user: %w", err)
+	}
+	return nil
+}
+
 func (s *userS) getKeys() ([][]byte, error) {
 	iterator := s.db.NewIterator(nil, nil)
 	defer iterator.Release()
@@ -97,3 +192,31 @@ func (s *userS) getKeys() ([][]byte, error) {
 	}
 	return keys, nil
 }
+
+// legacyUser is the encoding of users stored before their personal data and status
+type legacyUser struct {
+	Username   string
+	PublicKey  []byte
+	PrivateKey []byte
+	Wallet     *types.Wallet       `rlp:"nil"`
+	Rotations  []types.KeyRotation `rlp:"tail"`
+}
+
+// decodeUser decodes a user of either encoding, a legacy user is active
+func decodeUser(raw []byte) (*types.User, error) {
+	var user *types.User
+	if err := rlp.DecodeBytes(raw, &user); err == nil {
+		return user, nil
+	}
+	var legacy legacyUser
+	if err := rlp.DecodeBytes(raw, &legacy); err != nil {
+		return nil, fmt.Errorf("failed to decode user: %w", err)
+	}
+	return &types.User{
+		Username:   legacy.Username,
+		PublicKey:  legacy.PublicKey,
+		PrivateKey: legacy.PrivateKey,
+		Wallet:     legacy.Wallet,
+		Rotations:  legacy.Rotations,
+	}, nil
+}
@@ -0,0 +1,97 @@
+package leveldb_test
+
+import (
+	"testing"
+
+	"local-chain/internal/adapters/outbound/leveldb"
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/types"
+
+	"github.com/stretchr/testify/require"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+func newStore(t *testing.T) *leveldb.Store {
+	return leveldb.New(func(string) leveldb.Database {
+		db, err := goleveldb.Open(storage.NewMemStorage(), nil)
+		require.NoError(t, err)
+		return db
+	})
+}
+
+func TestUserS_GetByAddress(t *testing.T) {
+	store := newStore(t)
+	users := store.User()
+
+	alice := crypto.GenerateKeyEllipticP256()
+	aliceUser := &types.User{Username: "alice", PublicKey: crypto.PublicKeyToBytes(&alice.PublicKey)}
+	require.NoError(t, users.Put(aliceUser))
+	wallet, err := types.NewWallet(make([]byte, 32))
+	require.NoError(t, err)
+	wallet.Change = 1
+	bobKey, err := wallet.PublicKey(types.ReceiveChain, 0)
+	require.NoError(t, err)
+	require.NoError(t, users.Put(&types.User{Username: "bob", PublicKey: crypto.PublicKeyToBytes(bobKey), Wallet: wallet}))
+
+	// alice rotates her key, her old key stays hers
+	rotated := crypto.GenerateKeyEllipticP256()
+	aliceUser.Rotations = append(aliceUser.Rotations, types.KeyRotation{
+		OldKey: aliceUser.PublicKey,
+		NewKey: crypto.PublicKeyToBytes(&rotated.PublicKey),
+	})
+	aliceUser.PublicKey = crypto.PublicKeyToBytes(&rotated.PublicKey)
+	require.NoError(t, users.Update(aliceUser))
+
+	change, err := wallet.PublicKey(types.ChangeChain, 0)
+	require.NoError(t, err)
+	unused, err := wallet.PublicKey(types.ReceiveChain, 1)
+	require.NoError(t, err)
+	tests := []struct {
+		name     string
+		address  []byte
+		username string
+	}{
+		{
+			name:     "ok current key",
+			address:  crypto.PublicKeyHash(&rotated.PublicKey),
+			username: "alice",
+		},
+		{
+			name:     "ok rotated key",
+			address:  crypto.PublicKeyHash(&alice.PublicKey),
+			username: "alice",
+		},
+		{
+			name:     "ok change key of the wallet",
+			address:  crypto.PublicKeyHash(change),
+			username: "bob",
+		},
+		{
+			name:    "ok key of the wallet not handed out yet",
+			address: crypto.PublicKeyHash(unused),
+		},
+		{
+			name:    "ok key of no user",
+			address: crypto.PublicKeyHash(&crypto.GenerateKeyEllipticP256().PublicKey),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			user, err := users.GetByAddress(tt.address)
+			require.NoError(t, err)
+			if tt.username == "" {
+				require.Nil(t, user)
+				return
+			}
+			require.NotNil(t, user)
+			require.Equal(t, tt.username, user.Username)
+		})
+	}
+
+	// deleting the users drops the index too
+	require.NoError(t, users.Delete())
+	user, err := users.GetByAddress(crypto.PublicKeyHash(&alice.PublicKey))
+	require.NoError(t, err)
+	require.Nil(t, user)
+}
@@ -1,13 +1,24 @@
 package leveldb
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
 
 	"local-chain/internal/types"
 
 	"github.com/ethereum/go-ethereum/rlp"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
 	leveldbErrors "github.com/syndtr/goleveldb/leveldb/errors"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// outpointPrefix keys unspent outputs by "txID:index"
+	outpointPrefix = "outpoint/"
+	// ownerPrefix is the secondary index: owner key hash -> outpoints
+	ownerPrefix = "owner/"
 )
 
 type utxoS struct {
@@ -20,30 +31,122 @@ func newUtxoStore(conn Database) *utxoS {
 	}
 }
 
-func (s *utxoS) Get(pubKey []byte) ([]*types.UTXO, error) {
-	utxos := make([]*types.UTXO, 0)
-	value, err := s.db.Get(pubKey, nil)
+// Get returns the unspent output stored under the outpoint or nil if it doesn't exist or is spent.
+func (s *utxoS) Get(outpoint *types.UTXO) (*types.UnspentOutput, error) {
+	value, err := s.db.Get(outpointKey(outpoint.TxID.String(), outpoint.Index), nil)
 	if err != nil {
 		if errors.Is(err, leveldbErrors.ErrNotFound) {
 			return nil, nil
 		}
-		return utxos, fmt.Errorf("failed to get utxos: %w", err)
+		return nil, fmt.Errorf("failed to get utxo: %w", err)
 	}
-	if err = rlp.DecodeBytes(value, &utxos); err != nil {
-		return nil, fmt.Errorf("failed to decode utxos: %w", err)
+	unspent := &types.UnspentOutput{}
+	if err = rlp.DecodeBytes(value, unspent); err != nil {
+		return nil, fmt.Errorf("failed to decode utxo: %w", err)
+	}
+
+	return unspent, nil
+}
+
+// GetByOwner lists the unspent outputs of the owner: an address, the owner of a multisig lock or of a script.
+func (s *utxoS) GetByOwner(owner []byte) ([]*types.UnspentOutput, error) {
+	prefix := ownerIndexPrefix(owner)
+	iterator := s.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iterator.Release()
+
+	var outpoints [][]byte
+	for iterator.Next() {
+		outpoints = append(outpoints, append([]byte(outpointPrefix), iterator.Key()[len(prefix):]...))
+	}
+	if err := iterator.Error(); err != nil {
+		return nil, fmt.Errorf("failed to iterate over owner utxos: %w", err)
+	}
+
+	utxos := make([]*types.UnspentOutput, 0, len(outpoints))
+	for _, key := range outpoints {
+		value, err := s.db.Get(key, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get utxo %s: %w", key, err)
+		}
+		unspent := &types.UnspentOutput{}
+		if err = rlp.DecodeBytes(value, unspent); err != nil {
+			return nil, fmt.Errorf("failed to decode utxo: %w", err)
+		}
+		utxos = append(utxos, unspent)
 	}
 
 	return utxos, nil
 }
 
-func (s *utxoS) Put(pubKey []byte, utxos ...*types.UTXO) error {
-	encoded, err := rlp.EncodeToBytes(utxos)
-	if err != nil {
-		return fmt.Errorf("failed to encode utxos: %w", err)
+// Apply spends the outputs referenced by the transactions' inputs and stores their new outputs.
+// Transactions are applied in order in a single batch, so either the whole set changes or nothing does.
+// New outputs keep the height and the timestamp of the block that confirmed their transaction.
+func (s *utxoS) Apply(txs ...*types.Transaction) error {
+	batch := new(goleveldb.Batch)
+	// outputs created by the preceding transactions of the batch
+	pending := make(map[string]*types.UnspentOutput)
+	for _, tx := range txs {
+		for _, in := range tx.Inputs {
+			key := outpointKey(in.Prev.TxID.String(), in.Prev.Index)
+			spent, ok := pending[string(key)]
+			if !ok {
+				var err error
+				if spent, err = s.Get(in.Prev); err != nil {
+					return err
+				}
+			}
+			if spent == nil {
+				return fmt.Errorf("utxo %s:%d does not exist", in.Prev.TxID, in.Prev.Index)
+			}
+			delete(pending, string(key))
+			batch.Delete(key)
+			batch.Delete(ownerKey(spent.Output.Owner(), in.Prev.TxID.String(), in.Prev.Index))
+		}
+		for index, output := range tx.Outputs {
+			// data outputs can never be spent, they stay out of the set
+			if output.IsData() {
+				continue
+			}
+			unspent := &types.UnspentOutput{
+				UTXO:      types.NewUTXO(tx.ID, tx.GetHash(), uint32(index)),
+				Output:    output,
+				Height:    tx.BlockHeight,
+				Timestamp: tx.BlockTimestamp,
+			}
+			encoded, err := rlp.EncodeToBytes(unspent)
+			if err != nil {
+				return fmt.Errorf("failed to encode utxo: %w", err)
+			}
+			key := outpointKey(tx.ID.String(), uint32(index))
+			if _, ok := pending[string(key)]; ok {
+				return fmt.Errorf("utxo %s:%d already exists", tx.ID, index)
+			}
+			if _, err = s.db.Get(key, nil); err == nil {
+				return fmt.Errorf("utxo %s:%d already exists", tx.ID, index)
+			} else if !errors.Is(err, leveldbErrors.ErrNotFound) {
+				return fmt.Errorf("failed to get utxo: %w", err)
+			}
+			pending[string(key)] = unspent
+			batch.Put(key, encoded)
+			batch.Put(ownerKey(output.Owner(), tx.ID.String(), uint32(index)), nil)
+		}
 	}
-	if err = s.db.Put(pubKey, encoded, nil); err != nil {
-		return fmt.Errorf("failed to put utxos: %w", err)
+	if err := s.db.Write(batch, nil); err != nil {
+		return fmt.Errorf("failed to write utxos: %w", err)
 	}
 
 	return nil
 }
+
+func outpointKey(txID string, index uint32) []byte {
+	return []byte(fmt.Sprintf("%s%s:%d", outpointPrefix, txID, index))
+}
+
+func ownerIndexPrefix(owner []byte) []byte {
+	hash := sha256.Sum256(owner)
+	return []byte(ownerPrefix + hex.EncodeToString(hash[:]) + "/")
+}
+
+func ownerKey(owner []byte, txID string, index uint32) []byte {
+	return append(ownerIndexPrefix(owner), fmt.Sprintf("%s:%d", txID, index)...)
+}
@@ -0,0 +1,151 @@
+package coinselect
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"local-chain/internal/types"
+)
+
+const (
+	StrategyLargestFirst       = "largest-first"
+	StrategySmallestSufficient = "smallest-sufficient"
+	StrategyBranchAndBound     = "branch-and-bound"
+
+	// defaultMaxTries bounds the branch and bound search
+	defaultMaxTries = 100000
+)
+
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// Selector picks the unspent outputs a transaction spends to pay the target value.
+// The selected outputs are worth at least the target, anything above it goes to the change output.
+type Selector interface {
+	Select(utxos []*types.UnspentOutput, target uint64) ([]*types.UnspentOutput, error)
+}
+
+// New returns the selector of the strategy.
+func New(strategy string) (Selector, error) {
+	switch strategy {
+	case StrategyLargestFirst:
+		return LargestFirst{}, nil
+	case StrategySmallestSufficient:
+		return SmallestSufficient{}, nil
+	case StrategyBranchAndBound:
+		return NewBranchAndBound(0), nil
+	default:
+		return nil, fmt.Errorf("unknown coin selection strategy %q", strategy)
+	}
+}
+
+// LargestFirst spends the largest outputs until the target is reached, it uses the fewest inputs.
+type LargestFirst struct{}
+
+func (LargestFirst) Select(utxos []*types.UnspentOutput, target uint64) ([]*types.UnspentOutput, error) {
+	return accumulate(sorted(utxos, func(a, b uint64) bool { return a > b }), target)
+}
+
+// SmallestSufficient spends the smallest single output covering the target.
+// If no output covers it alone, the largest outputs are combined.
+type SmallestSufficient struct{}
+
+func (SmallestSufficient) Select(utxos []*types.UnspentOutput, target uint64) ([]*types.UnspentOutput, error) {
+	for _, utxo := range sorted(utxos, func(a, b uint64) bool { return a < b }) {
+		if utxo.Output.Amount.Value >= target {
+			return []*types.UnspentOutput{utxo}, nil
+		}
+	}
+	return LargestFirst{}.Select(utxos, target)
+}
+
+// BranchAndBound searches for a set of outputs worth exactly the target, up to CostOfChange above it,
+// so the transaction needs no change output. If there is no such set, it falls back to LargestFirst.
+type BranchAndBound struct {
+	// CostOfChange is the excess over the target that is still accepted as a match
+	CostOfChange uint64
+	MaxTries     int
+}
+
+func NewBranchAndBound(costOfChange uint64) BranchAndBound {
+	return BranchAndBound{
+		CostOfChange: costOfChange,
+		MaxTries:     defaultMaxTries,
+	}
+}
+
+func (s BranchAndBound) Select(utxos []*types.UnspentOutput, target uint64) ([]*types.UnspentOutput, error) {
+	candidates := sorted(utxos, func(a, b uint64) bool { return a > b })
+	// remaining[i] is the value of the candidates from i on, to prune branches that can't reach the target
+	remaining := make([]uint64, len(candidates)+1)
+	for i := len(candidates) - 1; i >= 0; i-- {
+		remaining[i] = remaining[i+1] + candidates[i].Output.Amount.Value
+	}
+	if remaining[0] < target {
+		return nil, ErrInsufficientFunds
+	}
+
+	var (
+		tries    int
+		selected []int
+		best     []int
+		bestSum  uint64
+	)
+	var search func(i int, sum uint64) bool
+	search = func(i int, sum uint64) bool {
+		tries++
+		if sum >= target {
+			if sum <= target+s.CostOfChange && (best == nil || sum < bestSum) {
+				best = append([]int(nil), selected...)
+				bestSum = sum
+			}
+			return sum == target
+		}
+		if i == len(candidates) || sum+remaining[i] < target || tries >= s.MaxTries {
+			return false
+		}
+		// include the candidate first: larger values reach the target with fewer inputs
+		selected = append(selected, i)
+		if search(i+1, sum+candidates[i].Output.Amount.Value) {
+			return true
+		}
+		selected = selected[:len(selected)-1]
+		return search(i+1, sum)
+	}
+	search(0, 0)
+
+	if best == nil {
+		return LargestFirst{}.Select(utxos, target)
+	}
+	result := make([]*types.UnspentOutput, 0, len(best))
+	for _, i := range best {
+		result = append(result, candidates[i])
+	}
+	return result, nil
+}
+
+func sorted(utxos []*types.UnspentOutput, less func(a, b uint64) bool) []*types.UnspentOutput {
+	result := append([]*types.UnspentOutput(nil), utxos...)
+	sort.SliceStable(result, func(i, j int) bool {
+		return less(result[i].Output.Amount.Value, result[j].Output.Amount.Value)
+	})
+	return result
+}
+
+func accumulate(utxos []*types.UnspentOutput, target uint64) ([]*types.UnspentOutput, error) {
+	var (
+		sum      uint64
+		selected []*types.UnspentOutput
+	)
+	for _, utxo := range utxos {
+		if sum >= target && len(selected) > 0 {
+			break
+		}
+		selected = append(selected, utxo)
+		sum += utxo.Output.Amount.Value
+	}
+	if sum < target {
+		return nil, ErrInsufficientFunds
+	}
+	return selected, nil
+}
@@ -0,0 +1,102 @@
+package coinselect_test
+
+import (
+	"testing"
+
+	"local-chain/internal/pkg/coinselect"
+
+	"local-chain/internal/types"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelector_Select(t *testing.T) {
+	utxos := func(values ...uint64) []*types.UnspentOutput {
+		result := make([]*types.UnspentOutput, 0, len(values))
+		for _, value := range values {
+			tx := types.NewTransaction()
+			result = append(result, &types.UnspentOutput{
+				UTXO:   types.NewUTXO(tx.ID, nil, 0),
+				Output: types.NewTxOut(tx.ID, *types.NewAmount(value), nil),
+			})
+		}
+		return result
+	}
+	values := func(selected []*types.UnspentOutput) []uint64 {
+		result := make([]uint64, 0, len(selected))
+		for _, utxo := range selected {
+			result = append(result, utxo.Output.Amount.Value)
+		}
+		return result
+	}
+
+	tests := []struct {
+		name     string
+		selector coinselect.Selector
+		utxos    []uint64
+		target   uint64
+		want     []uint64
+		wantErr  error
+	}{
+		{
+			name:     "largest first takes the fewest inputs",
+			selector: coinselect.LargestFirst{},
+			utxos:    []uint64{5, 40, 10, 30},
+			target:   60,
+			want:     []uint64{40, 30},
+		},
+		{
+			name:     "smallest sufficient takes one output covering the target",
+			selector: coinselect.SmallestSufficient{},
+			utxos:    []uint64{100, 5, 40, 70},
+			target:   35,
+			want:     []uint64{40},
+		},
+		{
+			name:     "smallest sufficient combines outputs when none is enough",
+			selector: coinselect.SmallestSufficient{},
+			utxos:    []uint64{10, 20, 30},
+			target:   45,
+			want:     []uint64{30, 20},
+		},
+		{
+			name:     "branch and bound finds an exact match",
+			selector: coinselect.NewBranchAndBound(0),
+			utxos:    []uint64{50, 7, 20, 13, 1},
+			target:   40,
+			want:     []uint64{20, 13, 7},
+		},
+		{
+			name:     "branch and bound accepts excess up to the cost of change",
+			selector: coinselect.NewBranchAndBound(2),
+			utxos:    []uint64{50, 21, 21},
+			target:   41,
+			want:     []uint64{21, 21},
+		},
+		{
+			name:     "branch and bound falls back to largest first",
+			selector: coinselect.NewBranchAndBound(0),
+			utxos:    []uint64{50, 30},
+			target:   40,
+			want:     []uint64{50},
+		},
+		{
+			name:     "err insufficient funds",
+			selector: coinselect.NewBranchAndBound(0),
+			utxos:    []uint64{10, 20},
+			target:   40,
+			wantErr:  coinselect.ErrInsufficientFunds,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			selected, err := tt.selector.Select(utxos(tt.utxos...), tt.target)
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, values(selected))
+		})
+	}
+}
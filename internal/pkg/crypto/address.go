@@ -0,0 +1,55 @@
+package crypto
+
+import (
+	"crypto/sha256"
+	"fmt"
+)
+
+const (
+	// AddressPrefix is the network prefix addresses of the chain start with
+	AddressPrefix = "lc"
+	// AddressSize is the size of the public key hash an address encodes
+	AddressSize = 20
+)
+
+// PublicKeyHash is the hash of the compact public key outputs are locked to, the payload of an address,
+// see Scheme.Compact. It panics on a key of an unsupported algorithm.
+func PublicKeyHash(public PublicKey) []byte {
+	scheme, err := SchemeOf(public)
+	if err != nil {
+		panic(err)
+	}
+	hash := sha256.Sum256(scheme.Compact(public))
+	return hash[:AddressSize]
+}
+
+// Address encodes the hash of the public key as a checksummed bech32 string with the network prefix.
+func Address(public PublicKey) string {
+	return EncodeAddress(PublicKeyHash(public))
+}
+
+// EncodeAddress encodes a public key hash as an address.
+func EncodeAddress(hash []byte) string {
+	// bytes always regroup into 5-bit groups with padding
+	data, _ := convertBits(hash, 8, 5, true)
+	return bech32Encode(AddressPrefix, data)
+}
+
+// DecodeAddress checks the address checksum and network prefix and returns the public key hash it encodes.
+func DecodeAddress(address string) ([]byte, error) {
+	prefix, data, err := bech32Decode(address)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+	if prefix != AddressPrefix {
+		return nil, fmt.Errorf("address of another network: %s", prefix)
+	}
+	hash, err := convertBits(data, 5, 8, false)
+	if err != nil {
+		return nil, fmt.Errorf("invalid address: %w", err)
+	}
+	if len(hash) != AddressSize {
+		return nil, fmt.Errorf("invalid address: %d bytes instead of %d", len(hash), AddressSize)
+	}
+	return hash, nil
+}
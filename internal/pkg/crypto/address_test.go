@@ -0,0 +1,73 @@
+package crypto_test
+
+import (
+	"strings"
+	"testing"
+
+	"local-chain/internal/pkg/crypto"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeAddress(t *testing.T) {
+	key := crypto.GenerateKeyEllipticP256()
+	address := crypto.Address(&key.PublicKey)
+	// flips one character of the address data, keeping it in the bech32 alphabet
+	typo := func(address string) string {
+		i := len(address) - 10
+		c := byte('q')
+		if address[i] == 'q' {
+			c = 'p'
+		}
+		return address[:i] + string(c) + address[i+1:]
+	}
+
+	tests := []struct {
+		name    string
+		address string
+		wantErr bool
+	}{
+		{
+			name:    "ok address of the key",
+			address: address,
+			wantErr: false,
+		},
+		{
+			name:    "ok upper case address",
+			address: strings.ToUpper(address),
+			wantErr: false,
+		},
+		{
+			name:    "err mistyped character breaks the checksum",
+			address: typo(address),
+			wantErr: true,
+		},
+		{
+			name:    "err mixed case",
+			address: address[:5] + strings.ToUpper(address[5:]),
+			wantErr: true,
+		},
+		{
+			name:    "err address of another network",
+			address: "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+			wantErr: true,
+		},
+		{
+			name:    "err PEM public key",
+			address: string(crypto.PublicKeyToBytes(&key.PublicKey)),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hash, err := crypto.DecodeAddress(tt.address)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, crypto.PublicKeyHash(&key.PublicKey), hash)
+		})
+	}
+	require.True(t, strings.HasPrefix(address, crypto.AddressPrefix+"1"))
+}
@@ -7,6 +7,8 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
 )
 
 func GenerateKeyEllipticP256() *ecdsa.PrivateKey {
@@ -17,21 +19,42 @@ func GenerateKeyEllipticP256() *ecdsa.PrivateKey {
 	return key
 }
 
-func PrivateKeyToBytes(privateKey *ecdsa.PrivateKey) ([]byte, []byte) {
-	privDER, err := x509.MarshalECPrivateKey(privateKey)
-	if err != nil {
-		panic(err)
+// PrivateKeyToBytes encodes the private key and its public key as PEM: elliptic curve private keys
+// in their SEC 1 form, Ed25519 ones as PKCS #8.
+func PrivateKeyToBytes(privateKey Signer) ([]byte, []byte) {
+	var (
+		block *pem.Block
+		err   error
+	)
+	switch key := privateKey.(type) {
+	case *Secp256k1PrivateKey:
+		block = &pem.Block{Type: "EC PRIVATE KEY"}
+		block.Bytes, err = marshalSecp256k1PrivateKey(key)
+	case *ecdsa.PrivateKey:
+		block = &pem.Block{Type: "EC PRIVATE KEY"}
+		block.Bytes, err = x509.MarshalECPrivateKey(key)
+	default:
+		block = &pem.Block{Type: "PRIVATE KEY"}
+		block.Bytes, err = x509.MarshalPKCS8PrivateKey(key)
 	}
-	pubDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
 	if err != nil {
 		panic(err)
 	}
 
-	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privDER}), pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})
+	return pem.EncodeToMemory(block), PublicKeyToBytes(privateKey.Public())
 }
 
-func PublicKeyToBytes(public *ecdsa.PublicKey) []byte {
-	pubDER, err := x509.MarshalPKIXPublicKey(public)
+// PublicKeyToBytes encodes the public key as a PKIX PEM block, its algorithm identifier tags the algorithm of the key.
+func PublicKeyToBytes(public PublicKey) []byte {
+	var (
+		pubDER []byte
+		err    error
+	)
+	if key, ok := public.(*secp256k1.PublicKey); ok {
+		pubDER, err = marshalSecp256k1PublicKey(key)
+	} else {
+		pubDER, err = x509.MarshalPKIXPublicKey(public)
+	}
 	if err != nil {
 		panic(err)
 	}
@@ -39,30 +62,56 @@ func PublicKeyToBytes(public *ecdsa.PublicKey) []byte {
 	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDER})
 }
 
-func PublicKeyFromBytes(pubKey []byte) (*ecdsa.PublicKey, error) {
+// PublicKeyFromBytes parses a PKIX PEM public key of any supported algorithm.
+func PublicKeyFromBytes(pubKey []byte) (PublicKey, error) {
 	outputBlock, _ := pem.Decode(pubKey)
 	if outputBlock == nil || outputBlock.Type != "PUBLIC KEY" {
 		return nil, fmt.Errorf("invalid public key PEM, receiver: %s, publicBlock: %v", pubKey, outputBlock)
 	}
+	if outputPubKey, ok, err := parseSecp256k1PublicKey(outputBlock.Bytes); ok {
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse outputPub: %v", err)
+		}
+		return outputPubKey, nil
+	}
 	outputPub, err := x509.ParsePKIXPublicKey(outputBlock.Bytes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse outputPub: %v", err)
 	}
-	outputPubKey, ok := outputPub.(*ecdsa.PublicKey)
-	if !ok {
-		return nil, fmt.Errorf("outputPubKey key is not ECDSA")
+	if _, err = SchemeOf(outputPub); err != nil {
+		return nil, fmt.Errorf("outputPubKey key: %w", err)
 	}
-	return outputPubKey, nil
+	return outputPub, nil
 }
 
-func PrivateKeyFromBytes(privateKey []byte) (*ecdsa.PrivateKey, error) {
+// PrivateKeyFromBytes parses a PEM private key of any supported algorithm: a SEC 1 elliptic curve key
+// or a PKCS #8 key.
+func PrivateKeyFromBytes(privateKey []byte) (Signer, error) {
 	privateBlock, _ := pem.Decode(privateKey)
-	if privateBlock == nil || privateBlock.Type != "EC PRIVATE KEY" {
+	if privateBlock == nil {
+		return nil, fmt.Errorf("invalid private key PEM")
+	}
+	var (
+		key any
+		err error
+	)
+	switch privateBlock.Type {
+	case "EC PRIVATE KEY":
+		if secpKey, ok, secpErr := parseSecp256k1PrivateKey(privateBlock.Bytes); ok {
+			key, err = secpKey, secpErr
+		} else {
+			key, err = x509.ParseECPrivateKey(privateBlock.Bytes)
+		}
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(privateBlock.Bytes)
+	default:
 		return nil, fmt.Errorf("invalid private key PEM")
 	}
-	ecdsaPrivateKey, err := x509.ParseECPrivateKey(privateBlock.Bytes)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse private key: %v", err)
 	}
-	return ecdsaPrivateKey, nil
+	if _, err = SchemeOf(key); err != nil {
+		return nil, fmt.Errorf("failed to parse private key: %w", err)
+	}
+	return key.(Signer), nil
 }
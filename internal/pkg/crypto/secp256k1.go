@@ -0,0 +1,106 @@
+package crypto
+
+import (
+	gocrypto "crypto"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	secpecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+var (
+	oidPublicKeyECDSA      = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidNamedCurveSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+)
+
+// Secp256k1PrivateKey is a secp256k1 private key, a Signer like the private keys of the standard library.
+type Secp256k1PrivateKey struct {
+	*secp256k1.PrivateKey
+}
+
+// Public returns the *secp256k1.PublicKey of the key.
+func (k *Secp256k1PrivateKey) Public() gocrypto.PublicKey {
+	return k.PubKey()
+}
+
+// Sign signs the digest and returns the DER encoded signature, the random source and the options are unused:
+// the nonce is derived from the key and the digest.
+func (k *Secp256k1PrivateKey) Sign(_ io.Reader, digest []byte, _ gocrypto.SignerOpts) ([]byte, error) {
+	return secpecdsa.Sign(k.PrivateKey, digest).Serialize(), nil
+}
+
+// publicKeyInfo is the PKIX form of a public key, x509 doesn't know the secp256k1 curve
+type publicKeyInfo struct {
+	Algorithm pkix.AlgorithmIdentifier
+	PublicKey asn1.BitString
+}
+
+// ecPrivateKey is the SEC 1 form of an elliptic curve private key
+type ecPrivateKey struct {
+	Version       int
+	PrivateKey    []byte
+	NamedCurveOID asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey     asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+func marshalSecp256k1PublicKey(pub *secp256k1.PublicKey) ([]byte, error) {
+	params, err := asn1.Marshal(oidNamedCurveSecp256k1)
+	if err != nil {
+		return nil, err
+	}
+	point := pub.SerializeUncompressed()
+	return asn1.Marshal(publicKeyInfo{
+		Algorithm: pkix.AlgorithmIdentifier{Algorithm: oidPublicKeyECDSA, Parameters: asn1.RawValue{FullBytes: params}},
+		PublicKey: asn1.BitString{Bytes: point, BitLength: 8 * len(point)},
+	})
+}
+
+// parseSecp256k1PublicKey parses the PKIX form of a secp256k1 key, false for a key of another curve
+func parseSecp256k1PublicKey(der []byte) (*secp256k1.PublicKey, bool, error) {
+	var info publicKeyInfo
+	if rest, err := asn1.Unmarshal(der, &info); err != nil || len(rest) != 0 {
+		return nil, false, nil
+	}
+	var curve asn1.ObjectIdentifier
+	if !info.Algorithm.Algorithm.Equal(oidPublicKeyECDSA) {
+		return nil, false, nil
+	}
+	if _, err := asn1.Unmarshal(info.Algorithm.Parameters.FullBytes, &curve); err != nil || !curve.Equal(oidNamedCurveSecp256k1) {
+		return nil, false, nil
+	}
+	pub, err := secp256k1.ParsePubKey(info.PublicKey.RightAlign())
+	if err != nil {
+		return nil, true, err
+	}
+	return pub, true, nil
+}
+
+func marshalSecp256k1PrivateKey(key *Secp256k1PrivateKey) ([]byte, error) {
+	point := key.PubKey().SerializeUncompressed()
+	return asn1.Marshal(ecPrivateKey{
+		Version:       1,
+		PrivateKey:    key.Serialize(),
+		NamedCurveOID: oidNamedCurveSecp256k1,
+		PublicKey:     asn1.BitString{Bytes: point, BitLength: 8 * len(point)},
+	})
+}
+
+// parseSecp256k1PrivateKey parses the SEC 1 form of a secp256k1 key, false for a key of another curve
+func parseSecp256k1PrivateKey(der []byte) (*Secp256k1PrivateKey, bool, error) {
+	var parsed ecPrivateKey
+	if _, err := asn1.Unmarshal(der, &parsed); err != nil || !parsed.NamedCurveOID.Equal(oidNamedCurveSecp256k1) {
+		return nil, false, nil
+	}
+	if len(parsed.PrivateKey) != 32 {
+		return nil, true, fmt.Errorf("secp256k1 private key of %d bytes", len(parsed.PrivateKey))
+	}
+	var scalar secp256k1.ModNScalar
+	if scalar.SetByteSlice(parsed.PrivateKey) || scalar.IsZero() {
+		return nil, true, errors.New("secp256k1 private key out of the curve order")
+	}
+	return &Secp256k1PrivateKey{PrivateKey: secp256k1.NewPrivateKey(&scalar)}, true, nil
+}
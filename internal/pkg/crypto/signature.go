@@ -0,0 +1,222 @@
+package crypto
+
+import (
+	gocrypto "crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/asn1"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	secpecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// Algorithm is the signature scheme of a key.
+type Algorithm uint8
+
+const (
+	// P256 is ECDSA on the NIST P-256 curve, the scheme of the chain from the start
+	P256 Algorithm = iota
+	// Ed25519 is EdDSA on Curve25519
+	Ed25519
+	// Secp256k1 is ECDSA on the secp256k1 curve
+	Secp256k1
+)
+
+var algorithmNames = map[Algorithm]string{P256: "p256", Ed25519: "ed25519", Secp256k1: "secp256k1"}
+
+func (a Algorithm) String() string {
+	if name, ok := algorithmNames[a]; ok {
+		return name
+	}
+	return fmt.Sprintf("algorithm(%d)", uint8(a))
+}
+
+// ParseAlgorithm parses the name of an algorithm, as String prints it.
+func ParseAlgorithm(name string) (Algorithm, error) {
+	for alg, algName := range algorithmNames {
+		if strings.EqualFold(name, algName) {
+			return alg, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown signature algorithm %q", name)
+}
+
+// Signer is a private key of any supported algorithm: *ecdsa.PrivateKey on P-256, ed25519.PrivateKey
+// or *Secp256k1PrivateKey. Its public key is a PublicKey.
+type Signer = gocrypto.Signer
+
+// PublicKey is a public key of any supported algorithm: *ecdsa.PublicKey on P-256, ed25519.PublicKey
+// or *secp256k1.PublicKey.
+type PublicKey = gocrypto.PublicKey
+
+// Scheme signs with the private keys and verifies with the public keys of one algorithm.
+// A signature is the pair (r, s): the values of an ECDSA signature, the two halves of an Ed25519 one.
+type Scheme interface {
+	Algorithm() Algorithm
+	GenerateKey() (Signer, error)
+	Sign(key Signer, digest []byte) (r, s *big.Int, err error)
+	Verify(pub PublicKey, digest []byte, r, s *big.Int) bool
+	// Compact is the binary form of the public key addresses hash, tagged with the algorithm;
+	// P-256 keys stay the untagged compressed point they were before other algorithms came.
+	Compact(pub PublicKey) []byte
+}
+
+var schemes = map[Algorithm]Scheme{P256: p256Scheme{}, Ed25519: ed25519Scheme{}, Secp256k1: secp256k1Scheme{}}
+
+// SchemeOf is the scheme of a private or a public key.
+func SchemeOf(key any) (Scheme, error) {
+	switch key := key.(type) {
+	case *ecdsa.PrivateKey:
+		if key.Curve == elliptic.P256() {
+			return schemes[P256], nil
+		}
+	case *ecdsa.PublicKey:
+		if key.Curve == elliptic.P256() {
+			return schemes[P256], nil
+		}
+	case ed25519.PrivateKey, ed25519.PublicKey:
+		return schemes[Ed25519], nil
+	case *Secp256k1PrivateKey, *secp256k1.PublicKey:
+		return schemes[Secp256k1], nil
+	}
+	return nil, fmt.Errorf("unsupported key %T", key)
+}
+
+// GenerateKey generates a private key of the algorithm.
+func GenerateKey(alg Algorithm) (Signer, error) {
+	scheme, ok := schemes[alg]
+	if !ok {
+		return nil, fmt.Errorf("unsupported signature algorithm %s", alg)
+	}
+	return scheme.GenerateKey()
+}
+
+// Sign signs the digest with a private key of any supported algorithm.
+func Sign(key Signer, digest []byte) (r, s *big.Int, err error) {
+	scheme, err := SchemeOf(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	return scheme.Sign(key, digest)
+}
+
+// Verify checks the signature of the digest with a public key of any supported algorithm.
+func Verify(pub PublicKey, digest []byte, r, s *big.Int) bool {
+	scheme, err := SchemeOf(pub)
+	if err != nil || r == nil || s == nil || r.Sign() <= 0 || s.Sign() <= 0 {
+		return false
+	}
+	return scheme.Verify(pub, digest, r, s)
+}
+
+// asn1Signature is the DER form of a signature scripts carry
+type asn1Signature struct {
+	R, S *big.Int
+}
+
+// SignASN1 signs the digest like Sign and encodes the signature as an ASN.1 sequence of r and s.
+func SignASN1(key Signer, digest []byte) ([]byte, error) {
+	r, s, err := Sign(key, digest)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(asn1Signature{R: r, S: s})
+}
+
+// VerifyASN1 checks a signature SignASN1 made.
+func VerifyASN1(pub PublicKey, digest, sig []byte) bool {
+	var parsed asn1Signature
+	rest, err := asn1.Unmarshal(sig, &parsed)
+	if err != nil || len(rest) != 0 {
+		return false
+	}
+	return Verify(pub, digest, parsed.R, parsed.S)
+}
+
+type p256Scheme struct{}
+
+func (p256Scheme) Algorithm() Algorithm { return P256 }
+
+func (p256Scheme) GenerateKey() (Signer, error) {
+	return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+}
+
+func (p256Scheme) Sign(key Signer, digest []byte) (*big.Int, *big.Int, error) {
+	return ecdsa.Sign(rand.Reader, key.(*ecdsa.PrivateKey), digest)
+}
+
+func (p256Scheme) Verify(pub PublicKey, digest []byte, r, s *big.Int) bool {
+	return ecdsa.Verify(pub.(*ecdsa.PublicKey), digest, r, s)
+}
+
+func (p256Scheme) Compact(pub PublicKey) []byte {
+	key := pub.(*ecdsa.PublicKey)
+	return elliptic.MarshalCompressed(key.Curve, key.X, key.Y)
+}
+
+type ed25519Scheme struct{}
+
+func (ed25519Scheme) Algorithm() Algorithm { return Ed25519 }
+
+func (ed25519Scheme) GenerateKey() (Signer, error) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	return key, err
+}
+
+func (ed25519Scheme) Sign(key Signer, digest []byte) (*big.Int, *big.Int, error) {
+	sig := ed25519.Sign(key.(ed25519.PrivateKey), digest)
+	half := ed25519.SignatureSize / 2
+	return new(big.Int).SetBytes(sig[:half]), new(big.Int).SetBytes(sig[half:]), nil
+}
+
+func (ed25519Scheme) Verify(pub PublicKey, digest []byte, r, s *big.Int) bool {
+	half := ed25519.SignatureSize / 2
+	if r.BitLen() > 8*half || s.BitLen() > 8*half {
+		return false
+	}
+	sig := append(r.FillBytes(make([]byte, half)), s.FillBytes(make([]byte, half))...)
+	return ed25519.Verify(pub.(ed25519.PublicKey), digest, sig)
+}
+
+func (ed25519Scheme) Compact(pub PublicKey) []byte {
+	return append([]byte{byte(Ed25519)}, pub.(ed25519.PublicKey)...)
+}
+
+type secp256k1Scheme struct{}
+
+func (secp256k1Scheme) Algorithm() Algorithm { return Secp256k1 }
+
+func (secp256k1Scheme) GenerateKey() (Signer, error) {
+	key, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	return &Secp256k1PrivateKey{PrivateKey: key}, nil
+}
+
+func (secp256k1Scheme) Sign(key Signer, digest []byte) (*big.Int, *big.Int, error) {
+	sig := secpecdsa.Sign(key.(*Secp256k1PrivateKey).PrivateKey, digest)
+	r, s := sig.R(), sig.S()
+	rBytes, sBytes := r.Bytes(), s.Bytes()
+	return new(big.Int).SetBytes(rBytes[:]), new(big.Int).SetBytes(sBytes[:]), nil
+}
+
+func (secp256k1Scheme) Verify(pub PublicKey, digest []byte, r, s *big.Int) bool {
+	if r.BitLen() > 256 || s.BitLen() > 256 {
+		return false
+	}
+	var sigR, sigS secp256k1.ModNScalar
+	if sigR.SetByteSlice(r.Bytes()) || sigS.SetByteSlice(s.Bytes()) {
+		return false
+	}
+	return secpecdsa.NewSignature(&sigR, &sigS).Verify(digest, pub.(*secp256k1.PublicKey))
+}
+
+func (secp256k1Scheme) Compact(pub PublicKey) []byte {
+	return append([]byte{byte(Secp256k1)}, pub.(*secp256k1.PublicKey).SerializeCompressed()...)
+}
@@ -0,0 +1,68 @@
+package crypto_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"local-chain/internal/pkg/crypto"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	digest := sha256.Sum256([]byte("transaction"))
+	other := sha256.Sum256([]byte("another transaction"))
+
+	tests := []struct {
+		name      string
+		algorithm crypto.Algorithm
+	}{
+		{
+			name:      "ok p256",
+			algorithm: crypto.P256,
+		},
+		{
+			name:      "ok ed25519",
+			algorithm: crypto.Ed25519,
+		},
+		{
+			name:      "ok secp256k1",
+			algorithm: crypto.Secp256k1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key, err := crypto.GenerateKey(tt.algorithm)
+			require.NoError(t, err)
+
+			// the PEM forms dispatch back to a key of the same algorithm
+			privPEM, pubPEM := crypto.PrivateKeyToBytes(key)
+			parsedKey, err := crypto.PrivateKeyFromBytes(privPEM)
+			require.NoError(t, err)
+			require.Equal(t, pubPEM, crypto.PublicKeyToBytes(parsedKey.Public()))
+			pub, err := crypto.PublicKeyFromBytes(pubPEM)
+			require.NoError(t, err)
+			scheme, err := crypto.SchemeOf(pub)
+			require.NoError(t, err)
+			require.Equal(t, tt.algorithm, scheme.Algorithm())
+			require.Equal(t, crypto.PublicKeyHash(key.Public()), crypto.PublicKeyHash(pub))
+
+			r, s, err := crypto.Sign(parsedKey, digest[:])
+			require.NoError(t, err)
+			require.True(t, crypto.Verify(pub, digest[:], r, s))
+			require.False(t, crypto.Verify(pub, other[:], r, s))
+
+			sig, err := crypto.SignASN1(key, digest[:])
+			require.NoError(t, err)
+			require.True(t, crypto.VerifyASN1(pub, digest[:], sig))
+			require.False(t, crypto.VerifyASN1(pub, other[:], sig))
+
+			// a signature of another algorithm's key doesn't verify
+			stranger, err := crypto.GenerateKey((tt.algorithm + 1) % 3)
+			require.NoError(t, err)
+			r, s, err = crypto.Sign(stranger, digest[:])
+			require.NoError(t, err)
+			require.False(t, crypto.Verify(pub, digest[:], r, s))
+		})
+	}
+}
@@ -2,13 +2,10 @@ package debug
 
 import (
 	"context"
-	"crypto/ecdsa"
-	"crypto/elliptic"
-	"crypto/rand"
-	"crypto/x509"
-	"encoding/pem"
 	"fmt"
+	"os"
 
+	"local-chain/internal/pkg/crypto"
 	"local-chain/transport/gen/transport"
 
 	"github.com/spf13/cobra"
@@ -16,12 +13,20 @@ import (
 
 // addUser creates the add user command
 func addUser() *cobra.Command {
-	var name string
+	var (
+		name        string
+		algorithm   string
+		passphrase  string
+		keyFile     string
+		displayName string
+		email       string
+	)
 
 	cmd := &cobra.Command{
 		Use:   "add-user",
 		Short: "Add a new user",
-		Long:  "Add a new user with generated ECDSA key pair",
+		Long: "Add a new user with a generated key pair of the signature algorithm: p256, ed25519 or secp256k1. " +
+			"The node keeps the private key encrypted with the passphrase, unlock the user before sending from it",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			client, closeConn, err := createClient()
 			if err != nil {
@@ -38,47 +43,48 @@ func addUser() *cobra.Command {
 				return fmt.Errorf("user already exists: %s", name)
 			}
 
-			privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			alg, err := crypto.ParseAlgorithm(algorithm)
 			if err != nil {
-				return fmt.Errorf("failed to generate private key: %w", err)
+				return err
 			}
-
-			privBytes, err := x509.MarshalECPrivateKey(privateKey)
+			privateKey, err := crypto.GenerateKey(alg)
 			if err != nil {
-				return fmt.Errorf("failed to marshal private key: %w", err)
+				return fmt.Errorf("failed to generate private key: %w", err)
 			}
+			privPEM, pubPEM := crypto.PrivateKeyToBytes(privateKey)
 
-			privPEM := pem.EncodeToMemory(&pem.Block{
-				Type:  "EC PRIVATE KEY",
-				Bytes: privBytes,
-			})
-
-			// Encode public key to PEM
-			pubBytes, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
-			if err != nil {
-				return fmt.Errorf("failed to marshal public key: %w", err)
+			if keyFile != "" {
+				if err = os.WriteFile(keyFile, privPEM, 0o600); err != nil {
+					return fmt.Errorf("failed to save private key: %w", err)
+				}
 			}
 
-			pubPEM := pem.EncodeToMemory(&pem.Block{
-				Type:  "PUBLIC KEY",
-				Bytes: pubBytes,
-			})
-
 			if _, err = client.AddUser(ctx, &transport.AddUserRequest{
-				User: &transport.User{Username: name, PrivateKey: privPEM, PublicKey: pubPEM},
+				User: &transport.User{
+					Username:    name,
+					PrivateKey:  privPEM,
+					PublicKey:   pubPEM,
+					DisplayName: displayName,
+					Email:       email,
+				},
+				Passphrase: passphrase,
 			}); err != nil {
 				return fmt.Errorf("failed to add user: %w", err)
 			}
 
 			fmt.Printf("✅ User '%s' added successfully!\n", name)
+			fmt.Printf("   Address: %s\n", crypto.Address(privateKey.Public()))
 			return nil
 		},
 	}
 
 	cmd.Flags().StringVarP(&name, "name", "n", "", "Username (required)")
-	if err := cmd.MarkFlagRequired("name"); err != nil {
-		panic(err)
-	}
+	cmd.Flags().StringVar(&algorithm, "algorithm", crypto.P256.String(), "Signature algorithm of the key: p256, ed25519 or secp256k1")
+	cmd.Flags().StringVar(&passphrase, "passphrase", "", "Passphrase the node encrypts the private key with (required)")
+	cmd.Flags().StringVar(&keyFile, "key-file", "", "File to save the private key to, for signing locally")
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Display name of the user")
+	cmd.Flags().StringVar(&email, "email", "", "Email of the user")
+	markRequired(cmd, "name", "passphrase")
 
 	return cmd
 }
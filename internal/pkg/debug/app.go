@@ -32,8 +32,28 @@ func NewDebug() *Debug {
 	rootCmd.PersistentFlags().DurationVar(&timeout, "timeout", 10*time.Second, "Request timeout")
 
 	rootCmd.AddCommand(send())
+	rootCmd.AddCommand(sendBatch())
+	rootCmd.AddCommand(multisig())
+	rootCmd.AddCommand(scriptCmd())
+	rootCmd.AddCommand(swap())
+	rootCmd.AddCommand(escrow())
+	rootCmd.AddCommand(order())
+	rootCmd.AddCommand(notarize())
+	rootCmd.AddCommand(proveNotarization())
+	rootCmd.AddCommand(asset())
+	rootCmd.AddCommand(nft())
 	rootCmd.AddCommand(balance())
+	rootCmd.AddCommand(estimateFee())
 	rootCmd.AddCommand(addUser())
+	rootCmd.AddCommand(listUsers())
+	rootCmd.AddCommand(updateUser())
+	rootCmd.AddCommand(disableUser())
+	rootCmd.AddCommand(enableUser())
+	rootCmd.AddCommand(deleteUser())
+	rootCmd.AddCommand(unlock())
+	rootCmd.AddCommand(lock())
+	rootCmd.AddCommand(rotateKey())
+	rootCmd.AddCommand(wallet())
 	rootCmd.AddCommand(fullEmission())
 	rootCmd.AddCommand(addPeer())
 	rootCmd.AddCommand(addVoter())
@@ -47,7 +67,12 @@ func NewDebug() *Debug {
 
 // createClient creates a gRPC client connection
 func createClient() (transport.LocalChainClient, func(), error) {
-	conn, err := grpc.NewClient(serverAddr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	return dialClient(serverAddr)
+}
+
+// dialClient creates a gRPC client connection to the node at the address
+func dialClient(addr string) (transport.LocalChainClient, func(), error) {
+	conn, err := grpc.NewClient(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to connect to server: %w", err)
 	}
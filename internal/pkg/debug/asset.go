@@ -0,0 +1,183 @@
+package debug
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"local-chain/transport/gen/transport"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// asset creates the asset command: issuing, sending and listing user-issued assets
+func asset() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "asset",
+		Short: "Issue, send and list user-issued assets",
+	}
+	cmd.AddCommand(assetIssue())
+	cmd.AddCommand(assetSend())
+	cmd.AddCommand(assetList())
+	return cmd
+}
+
+func assetIssue() *cobra.Command {
+	var (
+		issuer   string
+		name     string
+		decimals uint32
+		supply   uint64
+		fee      uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "issue",
+		Short: "Issue a new asset",
+		Long:  "Issue a new asset minting the whole supply to the issuer, the issuer pays the fee with the native coin",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			user, err := getUser(ctx, client, issuer)
+			if err != nil {
+				return err
+			}
+			resp, err := client.IssueAsset(ctx, &transport.IssueAssetRequest{
+				Issuer:   userKey(user),
+				Name:     name,
+				Decimals: decimals,
+				Supply:   supply,
+				Fee:      fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to issue asset: %w", err)
+			}
+
+			fmt.Printf("\n✅ Asset issued!\n\n")
+			printAsset(resp.GetAsset())
+			fmt.Printf("  Transaction:  %s\n\n", resp.GetTransaction().GetId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&issuer, "issuer", "i", "", "Issuer username (required)")
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Asset name (required)")
+	cmd.Flags().Uint32VarP(&decimals, "decimals", "d", 0, "Number of decimals of the asset")
+	cmd.Flags().Uint64Var(&supply, "supply", 0, "Total supply minted to the issuer (required)")
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid to the block producer, see estimate-fee for the current rate")
+	markRequired(cmd, "issuer", "name", "supply")
+
+	return cmd
+}
+
+func assetSend() *cobra.Command {
+	var (
+		sender   string
+		receiver string
+		assetID  string
+		amount   uint64
+		fee      uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "send",
+		Short: "Send an asset from sender to receiver",
+		Long:  "Send an amount of the asset from the sender to the receiver, the sender pays the fee with the native coin",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := hex.DecodeString(assetID)
+			if err != nil || len(id) == 0 {
+				return fmt.Errorf("invalid asset ID: %q", assetID)
+			}
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			userSender, err := getUser(ctx, client, sender)
+			if err != nil {
+				return err
+			}
+			userReceiver, err := getUser(ctx, client, receiver)
+			if err != nil {
+				return err
+			}
+			resp, err := client.AddBatchTransaction(ctx, &transport.AddBatchTransactionRequest{
+				Sender: userKey(userSender),
+				Payments: []*transport.Payment{{
+					Receiver: receiverAddress(userReceiver),
+					Amount:   &transport.Amount{Value: amount},
+					AssetId:  id,
+				}},
+				Fee: fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add transaction: %w", err)
+			}
+
+			fmt.Printf("\n✅ Asset sent!\n\n")
+			fmt.Printf("  ID:       %s\n", resp.GetTransaction().GetId())
+			fmt.Printf("  Asset:    %x\n", id)
+			fmt.Printf("  Amount:   %d\n\n", amount)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&sender, "sender", "s", "", "Sender username (required)")
+	cmd.Flags().StringVarP(&receiver, "receiver", "r", "", "Receiver username (required)")
+	cmd.Flags().StringVar(&assetID, "asset", "", "Hex encoded asset ID (required)")
+	cmd.Flags().Uint64VarP(&amount, "amount", "a", 0, "Amount of the asset in its smallest unit (required)")
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid to the block producer, see estimate-fee for the current rate")
+	markRequired(cmd, "sender", "receiver", "asset", "amount")
+
+	return cmd
+}
+
+func assetList() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the issued assets",
+		Long:  "List the assets issued by confirmed transactions",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			resp, err := client.ListAssets(ctx, &emptypb.Empty{})
+			if err != nil {
+				return fmt.Errorf("failed to list assets: %w", err)
+			}
+
+			fmt.Printf("\n🪙 Assets (%d)\n\n", len(resp.GetAssets()))
+			for _, a := range resp.GetAssets() {
+				printAsset(a)
+				fmt.Println()
+			}
+			return nil
+		},
+	}
+}
+
+func printAsset(a *transport.Asset) {
+	fmt.Printf("  ID:           %x\n", a.GetId())
+	fmt.Printf("  Name:         %s\n", a.GetName())
+	fmt.Printf("  Decimals:     %d\n", a.GetDecimals())
+	fmt.Printf("  Supply:       %d\n", a.GetSupply())
+	fmt.Printf("  Issuer:       %s\n", displayAddress(a.GetIssuer()))
+}
@@ -33,13 +33,29 @@ func balance() *cobra.Command {
 			}
 
 			resp, err := client.GetBalance(ctx, &transport.GetBalanceRequest{
-				Sender: user.GetUser().PrivateKey,
+				Owner: user.GetUser().GetPublicKey(),
 			})
 			if err != nil {
 				return fmt.Errorf("failed to get balance: %w", err)
 			}
 
 			fmt.Printf("💰 Balance for user '%s': %d (unit: %d)\n", name, resp.Amount.Value, resp.Amount.Unit)
+			for _, holding := range resp.GetAssets() {
+				// the name is unknown until the issuance is confirmed
+				assetName := "unconfirmed"
+				if holding.GetAsset() != nil {
+					assetName = holding.GetAsset().GetName()
+				}
+				fmt.Printf("   🪙 %s (%x): %d\n", assetName, holding.GetAssetId(), holding.GetValue())
+			}
+			// the keys the user replaced keep their history, anything paid to them since the rotation is left there
+			for _, rotation := range user.GetUser().GetRotations() {
+				old, err := client.GetBalance(ctx, &transport.GetBalanceRequest{Owner: rotation.GetOldKey()})
+				if err != nil {
+					return fmt.Errorf("failed to get balance of a replaced key: %w", err)
+				}
+				fmt.Printf("   🔑 replaced key %s: %d\n", displayAddress(rotation.GetOldKey()), old.GetAmount().GetValue())
+			}
 			return nil
 		},
 	}
@@ -0,0 +1,313 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"local-chain/internal/types"
+	"local-chain/transport/gen/transport"
+
+	"github.com/spf13/cobra"
+)
+
+// escrow creates the escrow command: payments held until the buyer and the seller, or the arbiter, settle them
+func escrow() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "escrow",
+		Short: "Escrow payments with a designated arbiter",
+		Long: "The buyer locks an amount the buyer and the seller release together, or the arbiter settles with either\n" +
+			"of them in a dispute. Unless settled, the amount goes back to the buyer after the timeout.",
+	}
+	cmd.AddCommand(escrowCreate())
+	cmd.AddCommand(escrowRelease())
+	cmd.AddCommand(escrowDispute())
+	cmd.AddCommand(escrowRefund())
+	cmd.AddCommand(escrowList())
+	return cmd
+}
+
+func escrowCreate() *cobra.Command {
+	var (
+		buyer   string
+		seller  string
+		arbiter string
+		amount  uint64
+		unit    uint32
+		refund  time.Duration
+		fee     uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Lock the buyer's amount in an escrow",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			userBuyer, err := getUser(ctx, client, buyer)
+			if err != nil {
+				return err
+			}
+			userSeller, err := getUser(ctx, client, seller)
+			if err != nil {
+				return err
+			}
+			userArbiter, err := getUser(ctx, client, arbiter)
+			if err != nil {
+				return err
+			}
+			resp, err := client.CreateEscrow(ctx, &transport.CreateEscrowRequest{
+				Buyer:   userKey(userBuyer),
+				Seller:  userSeller.GetPublicKey(),
+				Arbiter: userArbiter.GetPublicKey(),
+				Amount:  &transport.Amount{Value: amount, Unit: unit},
+				Timeout: uint32(time.Now().Add(refund).Unix()),
+				Fee:     fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create escrow: %w", err)
+			}
+
+			fmt.Printf("\n✅ Escrow created!\n\n")
+			fmt.Printf("  Transaction:  %s\n", resp.GetTransaction().GetId())
+			fmt.Printf("  Hash:         %x\n", resp.GetTransaction().GetHash())
+			fmt.Printf("  Index:        0\n")
+			fmt.Printf("  Refund:       %s, after %s\n\n", resp.GetRefund().GetId(), refund)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&buyer, "buyer", "b", "", "Buyer username (required)")
+	cmd.Flags().StringVarP(&seller, "seller", "s", "", "Seller username (required)")
+	cmd.Flags().StringVar(&arbiter, "arbiter", "", "Arbiter username (required)")
+	cmd.Flags().Uint64VarP(&amount, "amount", "a", 0, "Amount to lock (required)")
+	cmd.Flags().Uint32VarP(&unit, "unit", "u", 0, "Unit of the amount")
+	cmd.Flags().DurationVar(&refund, "refund-after", 24*time.Hour, "Time after which the buyer is refunded")
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid by the escrow and again, from the amount, by the refund")
+	markRequired(cmd, "buyer", "seller", "arbiter", "amount")
+
+	return cmd
+}
+
+func escrowRelease() *cobra.Command {
+	var (
+		buyer  string
+		seller string
+		txID   string
+		txHash string
+		index  uint32
+		fee    uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "release",
+		Short: "Release an escrow to the seller, signed by the buyer and the seller",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outpoint, err := parseOutpoint(txID, txHash, index)
+			if err != nil {
+				return err
+			}
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			userBuyer, err := getUser(ctx, client, buyer)
+			if err != nil {
+				return err
+			}
+			userSeller, err := getUser(ctx, client, seller)
+			if err != nil {
+				return err
+			}
+			resp, err := client.ReleaseEscrow(ctx, &transport.ReleaseEscrowRequest{
+				Buyer:  userKey(userBuyer),
+				Seller: userKey(userSeller),
+				Escrow: outpoint,
+				Fee:    fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to release escrow: %w", err)
+			}
+
+			fmt.Printf("\n✅ Escrow released to %s in %s\n\n", seller, resp.GetTransaction().GetId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&buyer, "buyer", "b", "", "Buyer username (required)")
+	cmd.Flags().StringVarP(&seller, "seller", "s", "", "Seller username (required)")
+	htlcFlags(cmd, &txID, &txHash, &index)
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid from the escrow amount")
+	markRequired(cmd, "buyer", "seller")
+
+	return cmd
+}
+
+func escrowDispute() *cobra.Command {
+	var (
+		arbiter string
+		party   string
+		txID    string
+		txHash  string
+		index   uint32
+		fee     uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "dispute",
+		Short: "Settle a dispute: the arbiter pays the escrow to the party it rules for",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outpoint, err := parseOutpoint(txID, txHash, index)
+			if err != nil {
+				return err
+			}
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			userArbiter, err := getUser(ctx, client, arbiter)
+			if err != nil {
+				return err
+			}
+			userParty, err := getUser(ctx, client, party)
+			if err != nil {
+				return err
+			}
+			resp, err := client.DisputeEscrow(ctx, &transport.DisputeEscrowRequest{
+				Arbiter: userKey(userArbiter),
+				Party:   userKey(userParty),
+				Escrow:  outpoint,
+				Fee:     fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to settle dispute: %w", err)
+			}
+
+			fmt.Printf("\n✅ Dispute settled for %s in %s\n\n", party, resp.GetTransaction().GetId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&arbiter, "arbiter", "", "Arbiter username (required)")
+	cmd.Flags().StringVarP(&party, "party", "p", "", "Username of the buyer or the seller the arbiter rules for (required)")
+	htlcFlags(cmd, &txID, &txHash, &index)
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid from the escrow amount")
+	markRequired(cmd, "arbiter", "party")
+
+	return cmd
+}
+
+func escrowRefund() *cobra.Command {
+	var (
+		buyer  string
+		txID   string
+		txHash string
+		index  uint32
+		fee    uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "refund",
+		Short: "Take an escrow back after its timeout",
+		Long:  "Needed only when the refund submitted with the escrow is gone from the pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outpoint, err := parseOutpoint(txID, txHash, index)
+			if err != nil {
+				return err
+			}
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			user, err := getUser(ctx, client, buyer)
+			if err != nil {
+				return err
+			}
+			resp, err := client.RefundEscrow(ctx, &transport.RefundEscrowRequest{
+				Buyer:  userKey(user),
+				Escrow: outpoint,
+				Fee:    fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to refund escrow: %w", err)
+			}
+
+			fmt.Printf("\n✅ Escrow refunded in %s\n\n", resp.GetTransaction().GetId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&buyer, "buyer", "b", "", "Buyer username (required)")
+	htlcFlags(cmd, &txID, &txHash, &index)
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid from the escrow amount")
+	markRequired(cmd, "buyer")
+
+	return cmd
+}
+
+func escrowList() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <username>",
+		Short: "List the open escrows of a user",
+		Long:  "List the confirmed escrows the user is the buyer, the seller or the arbiter of that are not settled yet",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			user, err := getUser(ctx, client, args[0])
+			if err != nil {
+				return err
+			}
+			resp, err := client.ListEscrows(ctx, &transport.ListEscrowsRequest{Participant: user.GetPublicKey()})
+			if err != nil {
+				return fmt.Errorf("failed to list escrows: %w", err)
+			}
+
+			fmt.Printf("\n🤝 Open escrows of %s (%d)\n\n", args[0], len(resp.GetEscrows()))
+			for _, e := range resp.GetEscrows() {
+				fmt.Printf("  Transaction:  %s\n", e.GetOutpoint().GetTxId())
+				fmt.Printf("  Hash:         %x\n", e.GetOutpoint().GetTxHash())
+				fmt.Printf("  Index:        %d\n", e.GetOutpoint().GetIndex())
+				fmt.Printf("  Amount:       %d\n", e.GetAmount().GetValue())
+				fmt.Printf("  Buyer:        %s\n", displayAddress(e.GetBuyer()))
+				fmt.Printf("  Seller:       %s\n", displayAddress(e.GetSeller()))
+				fmt.Printf("  Arbiter:      %s\n", displayAddress(e.GetArbiter()))
+				if e.GetTimeout() < types.LockTimeThreshold {
+					fmt.Printf("  Refund at:    block %d\n\n", e.GetTimeout())
+					continue
+				}
+				fmt.Printf("  Refund at:    %s\n\n", time.Unix(int64(e.GetTimeout()), 0).Format(time.RFC3339))
+			}
+			return nil
+		},
+	}
+}
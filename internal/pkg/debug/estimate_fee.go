@@ -0,0 +1,43 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+
+	"local-chain/transport/gen/transport"
+
+	"github.com/spf13/cobra"
+)
+
+// estimateFee creates the estimate-fee command
+func estimateFee() *cobra.Command {
+	var blocks uint32
+
+	cmd := &cobra.Command{
+		Use:   "estimate-fee",
+		Short: "Estimate the fee rate",
+		Long:  "Estimate the fee per kilobyte of transaction from the fees paid in recent blocks",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			resp, err := client.EstimateFee(ctx, &transport.EstimateFeeRequest{Blocks: blocks})
+			if err != nil {
+				return fmt.Errorf("failed to estimate fee: %w", err)
+			}
+
+			fmt.Printf("⛽ Fee rate: %d per kilobyte\n", resp.GetFeeRate())
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint32VarP(&blocks, "blocks", "b", 10, "Number of recent blocks to look at")
+
+	return cmd
+}
@@ -8,7 +8,6 @@ import (
 	"local-chain/transport/gen/transport"
 
 	"github.com/spf13/cobra"
-	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 // fullEmission creates the full emission command
@@ -25,8 +24,8 @@ func fullEmission() *cobra.Command {
 			defer closeConn()
 			ctx, cancel := context.WithTimeout(context.Background(), timeout)
 			defer cancel()
-			// Get all users
-			usersResp, err := client.ListUsers(ctx, &emptypb.Empty{})
+			// Get all users, deleted users keep the outputs of their keys
+			usersResp, err := client.ListUsers(ctx, &transport.ListUsersRequest{IncludeDeleted: true})
 			if err != nil {
 				return fmt.Errorf("failed to list users: %w", err)
 			}
@@ -40,7 +39,7 @@ func fullEmission() *cobra.Command {
 			for _, user := range usersResp.Users {
 				ctx, cancel = context.WithTimeout(context.Background(), timeout)
 				resp, err := client.GetBalance(ctx, &transport.GetBalanceRequest{
-					Sender: user.PrivateKey,
+					Owner: user.GetPublicKey(),
 				})
 				cancel()
 				if err != nil {
@@ -0,0 +1,288 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/types"
+	"local-chain/transport/gen/transport"
+
+	"github.com/spf13/cobra"
+)
+
+// multisig creates the multisig command: funding an M-of-N output and collecting the signatures spending it
+func multisig() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multisig",
+		Short: "Fund and spend M-of-N multisignature outputs",
+		Long: "Fund an output spendable by a threshold of signers, create a partially signed transaction spending it,\n" +
+			"pass the blob between the signers to sign it and submit it once enough signatures are collected.",
+	}
+	cmd.AddCommand(multisigFund())
+	cmd.AddCommand(multisigCreate())
+	cmd.AddCommand(multisigSign())
+	cmd.AddCommand(multisigCombine())
+	cmd.AddCommand(multisigSubmit())
+	return cmd
+}
+
+func multisigFund() *cobra.Command {
+	var (
+		sender    string
+		signers   []string
+		threshold uint32
+		amount    uint64
+		unit      uint32
+		fee       uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "fund",
+		Short: "Pay from the sender to an output of the signers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			userSender, err := client.GetUser(ctx, &transport.GetUserRequest{Username: sender})
+			if err != nil {
+				return fmt.Errorf("failed to get user: %v", err)
+			}
+			pubKeys, err := signerKeys(ctx, client, signers)
+			if err != nil {
+				return err
+			}
+			resp, err := client.AddBatchTransaction(ctx, &transport.AddBatchTransactionRequest{
+				Sender: userKey(userSender.GetUser()),
+				Payments: []*transport.Payment{{
+					Amount:    &transport.Amount{Value: amount, Unit: unit},
+					Threshold: threshold,
+					Receivers: pubKeys,
+				}},
+				Fee: fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add transaction: %w", err)
+			}
+
+			fmt.Printf("\n✅ Multisig output funded!\n\n")
+			fmt.Printf("  ID:       %s\n", resp.GetTransaction().GetId())
+			fmt.Printf("  Signers:  %d of %v\n", threshold, signers)
+			fmt.Printf("  Amount:   %d (unit: %d)\n\n", amount, unit)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&sender, "sender", "s", "", "Sender username (required)")
+	cmd.Flags().StringSliceVar(&signers, "signers", nil, "Usernames of the signers (required)")
+	cmd.Flags().Uint32VarP(&threshold, "threshold", "m", 0, "Number of signatures spending the output (required)")
+	cmd.Flags().Uint64VarP(&amount, "amount", "a", 0, "Amount to transfer (required)")
+	cmd.Flags().Uint32VarP(&unit, "unit", "u", 100, "Unit/precision for the amount")
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid to the block producer, see estimate-fee for the current rate")
+	markRequired(cmd, "sender", "signers", "threshold", "amount")
+
+	return cmd
+}
+
+func multisigCreate() *cobra.Command {
+	var (
+		signers   []string
+		threshold uint32
+		receiver  string
+		amount    uint64
+		unit      uint32
+		fee       uint64
+		lockTime  uint32
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a partially signed transaction paying from the signers' outputs to the receiver",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			pubKeys, err := signerKeys(ctx, client, signers)
+			if err != nil {
+				return err
+			}
+			userReceiver, err := client.GetUser(ctx, &transport.GetUserRequest{Username: receiver})
+			if err != nil {
+				return fmt.Errorf("failed to get user: %v", err)
+			}
+			resp, err := client.CreateMultisigTransaction(ctx, &transport.CreateMultisigTransactionRequest{
+				Threshold: threshold,
+				PubKeys:   pubKeys,
+				Payments: []*transport.Payment{{
+					Receiver: receiverAddress(userReceiver.GetUser()),
+					Amount:   &transport.Amount{Value: amount, Unit: unit},
+				}},
+				Fee:      fee,
+				LockTime: lockTime,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create multisig transaction: %w", err)
+			}
+
+			fmt.Printf("\n✅ Transaction %s created, %d of %v signatures needed\n\n", resp.GetTransaction().GetId(), threshold, signers)
+			fmt.Println(resp.GetPsbt())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&signers, "signers", nil, "Usernames of the signers (required)")
+	cmd.Flags().Uint32VarP(&threshold, "threshold", "m", 0, "Number of signatures spending the output (required)")
+	cmd.Flags().StringVarP(&receiver, "receiver", "r", "", "Receiver username (required)")
+	cmd.Flags().Uint64VarP(&amount, "amount", "a", 0, "Amount to transfer (required)")
+	cmd.Flags().Uint32VarP(&unit, "unit", "u", 100, "Unit/precision for the amount")
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid to the block producer, see estimate-fee for the current rate")
+	cmd.Flags().Uint32Var(&lockTime, "lock-time", 0, "Block height, or unix time in seconds from 500000000, the transaction can't be mined before")
+	markRequired(cmd, "signers", "threshold", "receiver", "amount")
+
+	return cmd
+}
+
+func multisigSign() *cobra.Command {
+	var (
+		psbt    string
+		keyFile string
+		chainID string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "sign",
+		Short: "Add the signer's signatures to a partially signed transaction",
+		Long:  "Sign a partially signed transaction locally with the signer's key, as add-user --key-file saved it",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tx, err := types.DecodePartiallySignedTx(psbt)
+			if err != nil {
+				return err
+			}
+			privPEM, err := os.ReadFile(keyFile)
+			if err != nil {
+				return fmt.Errorf("failed to read private key: %w", err)
+			}
+			key, err := crypto.PrivateKeyFromBytes(privPEM)
+			if err != nil {
+				return fmt.Errorf("failed to parse private key: %v", err)
+			}
+			signed, err := tx.Sign(key, chainID)
+			if err != nil {
+				return err
+			}
+			return printPartiallySigned(tx, fmt.Sprintf("%d input(s) signed by %s", signed, crypto.Address(key.Public())))
+		},
+	}
+
+	cmd.Flags().StringVar(&psbt, "psbt", "", "Partially signed transaction blob (required)")
+	cmd.Flags().StringVar(&keyFile, "key-file", "", "File of the signer's private key (required)")
+	cmd.Flags().StringVar(&chainID, "chain-id", types.DefaultChainID, "Chain ID of the network the transaction is for")
+	markRequired(cmd, "psbt", "key-file")
+
+	return cmd
+}
+
+func multisigCombine() *cobra.Command {
+	return &cobra.Command{
+		Use:   "combine <psbt> <psbt>...",
+		Short: "Merge signatures collected on copies of the same partially signed transaction",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			tx, err := types.DecodePartiallySignedTx(args[0])
+			if err != nil {
+				return err
+			}
+			for _, blob := range args[1:] {
+				other, err := types.DecodePartiallySignedTx(blob)
+				if err != nil {
+					return err
+				}
+				if err = tx.Combine(other); err != nil {
+					return err
+				}
+			}
+			return printPartiallySigned(tx, fmt.Sprintf("%d copies combined", len(args)))
+		},
+	}
+}
+
+func multisigSubmit() *cobra.Command {
+	var psbt string
+
+	cmd := &cobra.Command{
+		Use:   "submit",
+		Short: "Submit a partially signed transaction with all its signatures",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			resp, err := client.SubmitPartiallySignedTransaction(ctx, &transport.SubmitPartiallySignedTransactionRequest{Psbt: psbt})
+			if err != nil {
+				return fmt.Errorf("failed to submit transaction: %w", err)
+			}
+
+			fmt.Printf("\n✅ Transaction %s submitted\n\n", resp.GetTransaction().GetId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&psbt, "psbt", "", "Partially signed transaction blob (required)")
+	markRequired(cmd, "psbt")
+
+	return cmd
+}
+
+func printPartiallySigned(tx *types.PartiallySignedTx, summary string) error {
+	blob, err := tx.Encode()
+	if err != nil {
+		return fmt.Errorf("failed to encode partially signed transaction: %w", err)
+	}
+	fmt.Printf("\n✅ %s\n", summary)
+	if err = tx.Complete(); err != nil {
+		fmt.Printf("  Not ready yet: %v\n\n", err)
+	} else {
+		fmt.Printf("  All signatures collected, submit it with `multisig submit`\n\n")
+	}
+	fmt.Println(blob)
+	return nil
+}
+
+// signerKeys fetches the public keys of the users
+func signerKeys(ctx context.Context, client transport.LocalChainClient, usernames []string) ([][]byte, error) {
+	pubKeys := make([][]byte, 0, len(usernames))
+	for _, username := range usernames {
+		user, err := client.GetUser(ctx, &transport.GetUserRequest{Username: username})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get user %s: %v", username, err)
+		}
+		pubKeys = append(pubKeys, user.GetUser().GetPublicKey())
+	}
+	return pubKeys, nil
+}
+
+func markRequired(cmd *cobra.Command, flags ...string) {
+	for _, flag := range flags {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package debug
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"local-chain/transport/gen/transport"
+
+	"github.com/spf13/cobra"
+)
+
+// nft creates the nft command: minting, transferring and tracing unique tokens
+func nft() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft",
+		Short: "Mint, transfer and trace unique tokens",
+	}
+	cmd.AddCommand(nftMint())
+	cmd.AddCommand(nftTransfer())
+	cmd.AddCommand(nftShow())
+	return cmd
+}
+
+func nftMint() *cobra.Command {
+	var (
+		minter  string
+		owner   string
+		file    string
+		docHash string
+		fee     uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "mint",
+		Short: "Mint a token for an item",
+		Long: "Mint a token carrying the SHA-256 hash of the item's metadata file, or the given hash, to the owner.\n" +
+			"The minter must be one of the keys authorized to mint tokens and pays the fee",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			metadataHash, err := documentHash(file, docHash)
+			if err != nil {
+				return err
+			}
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			userMinter, err := getUser(ctx, client, minter)
+			if err != nil {
+				return err
+			}
+			req := &transport.MintTokenRequest{
+				Minter:       userKey(userMinter),
+				MetadataHash: metadataHash,
+				Fee:          fee,
+			}
+			if owner != "" {
+				userOwner, err := getUser(ctx, client, owner)
+				if err != nil {
+					return err
+				}
+				req.Owner = receiverAddress(userOwner)
+			}
+			resp, err := client.MintToken(ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to mint token: %w", err)
+			}
+
+			fmt.Printf("\n✅ Token minted!\n\n")
+			fmt.Printf("  Token ID:       %x\n", resp.GetToken().GetId())
+			fmt.Printf("  Metadata Hash:  %x\n", resp.GetToken().GetMetadataHash())
+			fmt.Printf("  Transaction:    %s\n\n", resp.GetTransaction().GetId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&minter, "minter", "m", "", "Minter username (required)")
+	cmd.Flags().StringVarP(&owner, "owner", "o", "", "Username of the first owner, the minter if not set")
+	documentFlags(cmd, &file, &docHash)
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid to the block producer, see estimate-fee for the current rate")
+	markRequired(cmd, "minter")
+
+	return cmd
+}
+
+func nftTransfer() *cobra.Command {
+	var (
+		owner    string
+		receiver string
+		tokenID  string
+		fee      uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "transfer",
+		Short: "Transfer a token to a new owner",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := hex.DecodeString(tokenID)
+			if err != nil || len(id) == 0 {
+				return fmt.Errorf("invalid token ID: %q", tokenID)
+			}
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			userOwner, err := getUser(ctx, client, owner)
+			if err != nil {
+				return err
+			}
+			userReceiver, err := getUser(ctx, client, receiver)
+			if err != nil {
+				return err
+			}
+			resp, err := client.TransferToken(ctx, &transport.TransferTokenRequest{
+				Owner:    userKey(userOwner),
+				Receiver: receiverAddress(userReceiver),
+				TokenId:  id,
+				Fee:      fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to transfer token: %w", err)
+			}
+
+			fmt.Printf("\n✅ Token %x transferred to %s in transaction %s\n\n", id, receiver, resp.GetTransaction().GetId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&owner, "owner", "o", "", "Current owner username (required)")
+	cmd.Flags().StringVarP(&receiver, "receiver", "r", "", "New owner username (required)")
+	cmd.Flags().StringVar(&tokenID, "token", "", "Hex encoded token ID (required)")
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid to the block producer, see estimate-fee for the current rate")
+	markRequired(cmd, "owner", "receiver", "token")
+
+	return cmd
+}
+
+func nftShow() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show <token ID>",
+		Short: "Show a token's current owner and ownership history",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := hex.DecodeString(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid token ID: %w", err)
+			}
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			resp, err := client.GetToken(ctx, &transport.GetTokenRequest{TokenId: id})
+			if err != nil {
+				return fmt.Errorf("failed to get token: %w", err)
+			}
+
+			fmt.Printf("\n🎫 Token %x\n\n", resp.GetToken().GetId())
+			fmt.Printf("  Metadata Hash:  %x\n", resp.GetToken().GetMetadataHash())
+			fmt.Printf("  Minter:         %s\n", displayAddress(resp.GetToken().GetMinter()))
+			fmt.Printf("  Owner:          %s\n", displayAddress(resp.GetOwner()))
+			fmt.Printf("\n  HISTORY (%d):\n", len(resp.GetHistory()))
+			for i, transfer := range resp.GetHistory() {
+				fmt.Printf("    [%d] Block %d, transaction %s\n", i+1, transfer.GetBlockHeight(), transfer.GetTxId())
+				fmt.Printf("        Owner:  %s\n", displayAddress(transfer.GetOwner()))
+			}
+			fmt.Println()
+			return nil
+		},
+	}
+}
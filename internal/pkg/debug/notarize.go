@@ -0,0 +1,153 @@
+package debug
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+
+	"local-chain/internal/pkg/merkle"
+	"local-chain/internal/types"
+	"local-chain/transport/gen/transport"
+
+	"github.com/spf13/cobra"
+)
+
+// notarize creates the notarize command: anchoring a document hash in a data output
+func notarize() *cobra.Command {
+	var (
+		sender  string
+		file    string
+		docHash string
+		fee     uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "notarize",
+		Short: "Anchor a document hash on the ledger",
+		Long:  "Anchor the SHA-256 hash of the file, or the given hash, in a zero-value data output of a transaction of the sender",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := documentHash(file, docHash)
+			if err != nil {
+				return err
+			}
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			user, err := getUser(ctx, client, sender)
+			if err != nil {
+				return err
+			}
+			resp, err := client.Notarize(ctx, &transport.NotarizeRequest{
+				Sender: userKey(user),
+				Data:   data,
+				Fee:    fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to notarize: %w", err)
+			}
+
+			fmt.Printf("\n✅ Document hash %x anchored in transaction %s\n", data, resp.GetTransaction().GetId())
+			fmt.Printf("  Prove it with `prove-notarization` once the transaction is in a block\n\n")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&sender, "sender", "s", "", "Sender username paying the fee (required)")
+	documentFlags(cmd, &file, &docHash)
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid to the block producer, see estimate-fee for the current rate")
+	markRequired(cmd, "sender")
+
+	return cmd
+}
+
+// proveNotarization creates the prove-notarization command: fetching and checking the proof of an anchored hash
+func proveNotarization() *cobra.Command {
+	var (
+		file    string
+		docHash string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "prove-notarization",
+		Short: "Prove a document hash was anchored on the ledger",
+		Long:  "Fetch the transaction anchoring the hash, its block and the Merkle proof, and check the proof against the block",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := documentHash(file, docHash)
+			if err != nil {
+				return err
+			}
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			resp, err := client.ProveNotarization(ctx, &transport.ProveNotarizationRequest{Data: data})
+			if err != nil {
+				return fmt.Errorf("failed to prove notarization: %w", err)
+			}
+
+			tx, block := resp.GetTransaction(), resp.GetBlock()
+			proof := make(types.MerkleProof, 0, len(resp.GetProof()))
+			for _, step := range resp.GetProof() {
+				proof = append(proof, types.MerkleStep{Hash: step.GetHash(), Left: step.GetLeft()})
+			}
+			verdict := "❌ INVALID"
+			if merkle.VerifyProof(block.GetMerkleRoot(), tx.GetHash(), proof) {
+				verdict = "✅ VALID"
+			}
+
+			fmt.Printf("\n%s Notarization of %x\n\n", verdict, data)
+			fmt.Printf("  Transaction:   %s\n", tx.GetId())
+			fmt.Printf("  Tx Hash:       %x\n", tx.GetHash())
+			fmt.Printf("  Block Height:  %d\n", block.GetHeight())
+			fmt.Printf("  Block Time:    %d\n", block.GetTimestamp())
+			fmt.Printf("  Merkle Root:   %x\n", block.GetMerkleRoot())
+			fmt.Printf("  Proof Steps:   %d\n\n", len(proof))
+			return nil
+		},
+	}
+
+	documentFlags(cmd, &file, &docHash)
+
+	return cmd
+}
+
+func documentFlags(cmd *cobra.Command, file, docHash *string) {
+	cmd.Flags().StringVar(file, "file", "", "Document to hash with SHA-256")
+	cmd.Flags().StringVar(docHash, "hash", "", "Hex encoded document hash, instead of --file")
+}
+
+// documentHash hashes the file or decodes the hash given instead
+func documentHash(file, docHash string) ([]byte, error) {
+	switch {
+	case file != "" && docHash != "":
+		return nil, errors.New("either --file or --hash must be provided, not both")
+	case file != "":
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read document: %w", err)
+		}
+		hash := sha256.Sum256(content)
+		return hash[:], nil
+	case docHash != "":
+		hash, err := hex.DecodeString(docHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid hash: %w", err)
+		}
+		return hash, nil
+	}
+	return nil, errors.New("either --file or --hash must be provided")
+}
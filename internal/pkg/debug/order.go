@@ -0,0 +1,200 @@
+package debug
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"local-chain/transport/gen/transport"
+
+	"github.com/spf13/cobra"
+)
+
+// order creates the order command: recurring payments the block producer executes from the payer's outputs
+func order() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order",
+		Short: "Standing orders paid by the block producer",
+		Long: "The payer authorizes recurring payments of an amount to the receiver. Every payment due is executed\n" +
+			"by the block producer from the payer's outputs, or recorded as failed when the payer can't afford it.",
+	}
+	cmd.AddCommand(orderCreate())
+	cmd.AddCommand(orderList())
+	cmd.AddCommand(orderCancel())
+	return cmd
+}
+
+func orderCreate() *cobra.Command {
+	var (
+		payer       string
+		receiver    string
+		amount      uint64
+		unit        uint32
+		startIn     time.Duration
+		interval    time.Duration
+		maxPayments uint32
+		endIn       time.Duration
+		fee         uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a standing order of the payer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			userPayer, err := getUser(ctx, client, payer)
+			if err != nil {
+				return err
+			}
+			userReceiver, err := getUser(ctx, client, receiver)
+			if err != nil {
+				return err
+			}
+			now := time.Now()
+			req := &transport.CreateStandingOrderRequest{
+				Payer:       userKey(userPayer),
+				Receiver:    receiverAddress(userReceiver),
+				Amount:      &transport.Amount{Value: amount, Unit: unit},
+				Interval:    uint64(interval / time.Second),
+				MaxPayments: maxPayments,
+				Fee:         fee,
+			}
+			if startIn > 0 {
+				req.Start = uint64(now.Add(startIn).Unix())
+			}
+			if endIn > 0 {
+				req.End = uint64(now.Add(endIn).Unix())
+			}
+			resp, err := client.CreateStandingOrder(ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to create standing order: %w", err)
+			}
+
+			fmt.Printf("\n✅ Standing order created!\n\n")
+			fmt.Printf("  Order:        %x\n", resp.GetTransaction().GetStandingOrder().GetId())
+			fmt.Printf("  Transaction:  %s\n", resp.GetTransaction().GetId())
+			fmt.Printf("  Every:        %s\n\n", interval)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&payer, "payer", "p", "", "Payer username (required)")
+	cmd.Flags().StringVarP(&receiver, "receiver", "r", "", "Receiver username (required)")
+	cmd.Flags().Uint64VarP(&amount, "amount", "a", 0, "Amount of every payment (required)")
+	cmd.Flags().Uint32VarP(&unit, "unit", "u", 0, "Unit of the amount")
+	cmd.Flags().DurationVar(&startIn, "start-in", 0, "Time until the first payment, the next block if not set")
+	cmd.Flags().DurationVarP(&interval, "interval", "i", 0, "Time between two payments (required)")
+	cmd.Flags().Uint32Var(&maxPayments, "max-payments", 0, "Number of payments the order ends after, no limit if not set")
+	cmd.Flags().DurationVar(&endIn, "end-in", 0, "Time after which no payment is due, no end if not set")
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid by the payer to create the order")
+	markRequired(cmd, "payer", "receiver", "amount", "interval")
+
+	return cmd
+}
+
+func orderList() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <username>",
+		Short: "List the standing orders of a user with their payments",
+		Long:  "List the confirmed standing orders the user pays or receives, with the audit record of every payment",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			user, err := getUser(ctx, client, args[0])
+			if err != nil {
+				return err
+			}
+			resp, err := client.ListStandingOrders(ctx, &transport.ListStandingOrdersRequest{PubKey: user.GetPublicKey()})
+			if err != nil {
+				return fmt.Errorf("failed to list standing orders: %w", err)
+			}
+
+			fmt.Printf("\n🔁 Standing orders of %s (%d)\n\n", args[0], len(resp.GetOrders()))
+			for _, o := range resp.GetOrders() {
+				fmt.Printf("  Order:        %x\n", o.GetId())
+				fmt.Printf("  Payer:        %s\n", displayAddress(o.GetPayer()))
+				fmt.Printf("  Receiver:     %s\n", displayAddress(o.GetReceiver()))
+				fmt.Printf("  Amount:       %d\n", o.GetAmount().GetValue())
+				fmt.Printf("  Start:        %s\n", time.Unix(int64(o.GetStart()), 0).Format(time.RFC3339))
+				fmt.Printf("  Every:        %s\n", time.Duration(o.GetInterval())*time.Second)
+				fmt.Printf("  Cancelled:    %t\n", o.GetCancelled())
+				for _, p := range o.GetPayments() {
+					if p.GetFailure() != "" {
+						fmt.Printf("    #%d  block %d  failed: %s\n", p.GetSeq(), p.GetBlockHeight(), p.GetFailure())
+						continue
+					}
+					fmt.Printf("    #%d  block %d  paid in %s\n", p.GetSeq(), p.GetBlockHeight(), p.GetTxId())
+				}
+				fmt.Println()
+			}
+			return nil
+		},
+	}
+}
+
+func orderCancel() *cobra.Command {
+	var (
+		payer   string
+		orderID string
+		fee     uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "cancel",
+		Short: "Cancel a standing order of the payer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := hex.DecodeString(orderID)
+			if err != nil {
+				return fmt.Errorf("invalid order ID: %w", err)
+			}
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			user, err := getUser(ctx, client, payer)
+			if err != nil {
+				return err
+			}
+			resp, err := client.CancelStandingOrder(ctx, &transport.CancelStandingOrderRequest{
+				Payer:   userKey(user),
+				OrderId: id,
+				Fee:     fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to cancel standing order: %w", err)
+			}
+
+			fmt.Printf("\n✅ Standing order cancelled in %s\n\n", resp.GetTransaction().GetId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&payer, "payer", "p", "", "Payer username (required)")
+	cmd.Flags().StringVarP(&orderID, "order", "o", "", "Hex ID of the order (required)")
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid by the payer to cancel the order")
+	markRequired(cmd, "payer", "order")
+
+	return cmd
+}
@@ -0,0 +1,78 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"local-chain/internal/pkg/crypto"
+	"local-chain/transport/gen/transport"
+
+	"github.com/spf13/cobra"
+)
+
+// rotateKey creates the rotate key command
+func rotateKey() *cobra.Command {
+	var (
+		name       string
+		passphrase string
+		algorithm  string
+		keyFile    string
+		fee        uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "rotate-key",
+		Short: "Replace the key of a user",
+		Long: "Replace the key of a user with a generated key of the algorithm, or the key of the file, " +
+			"sweeping everything the old key owns to the new one",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &transport.RotateUserKeyRequest{
+				Username:   name,
+				Passphrase: passphrase,
+				Algorithm:  algorithm,
+				Fee:        fee,
+			}
+			if keyFile != "" {
+				privPEM, err := os.ReadFile(keyFile)
+				if err != nil {
+					return fmt.Errorf("failed to read private key: %w", err)
+				}
+				req.PrivateKey = privPEM
+			}
+
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			resp, err := client.RotateUserKey(ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to rotate key: %w", err)
+			}
+
+			fmt.Printf("🔑 Key of user '%s' rotated\n", name)
+			fmt.Printf("   Old address: %s\n", displayAddress(resp.GetRotation().GetOldKey()))
+			fmt.Printf("   New address: %s\n", displayAddress(resp.GetRotation().GetNewKey()))
+			if resp.GetSweep() != nil {
+				fmt.Printf("   Sweep:       %s\n", resp.GetSweep().GetId())
+			} else {
+				fmt.Printf("   Sweep:       nothing owned by the old key\n")
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Username (required)")
+	cmd.Flags().StringVar(&passphrase, "passphrase", "", "Passphrase of the user's key, the new key is sealed under it too (required)")
+	cmd.Flags().StringVar(&algorithm, "algorithm", crypto.P256.String(), "Signature algorithm of the generated key: p256, ed25519 or secp256k1")
+	cmd.Flags().StringVar(&keyFile, "key-file", "", "File of the private key replacing the user's one, instead of a generated key")
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee of the sweep transaction, see estimate-fee for the current rate")
+	markRequired(cmd, "name", "passphrase")
+
+	return cmd
+}
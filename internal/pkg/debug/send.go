@@ -16,6 +16,8 @@ func send() *cobra.Command {
 		receiver string
 		amount   uint64
 		unit     uint32
+		fee      uint64
+		lockTime uint32
 	)
 
 	cmd := &cobra.Command{
@@ -44,9 +46,11 @@ func send() *cobra.Command {
 			}
 
 			resp, err := client.AddTransaction(ctx, &transport.AddTransactionRequest{
-				Sender:   userSender.GetUser().GetPrivateKey(),
-				Receiver: userReceiver.GetUser().GetPublicKey(),
+				Sender:   userKey(userSender.GetUser()),
+				Receiver: receiverAddress(userReceiver.GetUser()),
 				Amount:   &transport.Amount{Value: amount, Unit: unit},
+				Fee:      fee,
+				LockTime: lockTime,
 			})
 			if err != nil {
 				return fmt.Errorf("failed to add transaction: %w", err)
@@ -60,20 +64,28 @@ func send() *cobra.Command {
 			fmt.Printf("  ID:               %s\n", tx.GetId())
 			fmt.Printf("  Timestamp:        %d\n", tx.GetTimestamp())
 			fmt.Printf("  Hash:             %x\n", tx.GetHash())
+			fmt.Printf("  Fee:              %d\n", tx.GetFee())
+			if tx.GetLockTime() > 0 {
+				fmt.Printf("  Lock Time:        %d\n", tx.GetLockTime())
+			}
 			if tx.GetBlockTimestamp() > 0 {
 				fmt.Printf("  Block Timestamp:  %x\n", tx.GetBlockTimestamp())
 			}
 
 			fmt.Printf("\n  INPUTS (%d):\n", len(tx.GetInputs()))
 			for i, input := range tx.GetInputs() {
-				fmt.Printf("    [%d] Public Key:  %x\n", i+1, input.GetPubKey())
+				fmt.Printf("    [%d] Address:     %s\n", i+1, displayAddress(input.GetPubKey()))
 				fmt.Printf("        SignatureS:   %x\n", input.GetSignatureS())
 				fmt.Printf("        SignatureR:   %x\n", input.GetSignatureR())
 			}
 
 			fmt.Printf("\n  OUTPUTS (%d):\n", len(tx.GetOutputs()))
 			for i, output := range tx.GetOutputs() {
-				fmt.Printf("    [%d] Public Key:  %x\n", i+1, output.GetPubKey())
+				owner := output.GetAddress()
+				if len(owner) == 0 {
+					owner = output.GetPubKey()
+				}
+				fmt.Printf("    [%d] Address:     %s\n", i+1, displayAddress(owner))
 				fmt.Printf("        Amount:      %d (unit: %d)\n", output.GetAmount().GetValue(), output.GetAmount().GetUnit())
 			}
 
@@ -86,6 +98,8 @@ func send() *cobra.Command {
 	cmd.Flags().StringVarP(&receiver, "receiver", "r", "", "Receiver username (required)")
 	cmd.Flags().Uint64VarP(&amount, "amount", "a", 0, "Amount to transfer (required)")
 	cmd.Flags().Uint32VarP(&unit, "unit", "u", 100, "Unit/precision for the amount")
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid to the block producer, see estimate-fee for the current rate")
+	cmd.Flags().Uint32Var(&lockTime, "lock-time", 0, "Block height, or unix time in seconds from 500000000, the transaction can't be mined before")
 
 	if err := cmd.MarkFlagRequired("sender"); err != nil {
 		panic(err)
@@ -0,0 +1,162 @@
+package debug
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"local-chain/transport/gen/transport"
+
+	"github.com/spf13/cobra"
+)
+
+// payout is a row of the payouts CSV file: receiver username, amount and an optional unit
+type payout struct {
+	receiver string
+	amount   uint64
+	unit     uint32
+}
+
+// sendBatch creates the send-batch command
+func sendBatch() *cobra.Command {
+	var (
+		sender   string
+		file     string
+		unit     uint32
+		fee      uint64
+		lockTime uint32
+	)
+
+	cmd := &cobra.Command{
+		Use:   "send-batch",
+		Short: "Send money from sender to many receivers in one transaction",
+		Long: "Send payouts listed in a CSV file from the sender in one transaction.\n" +
+			"Each row is \"receiver,amount[,unit]\" where receiver is a username, a header row is allowed.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			payouts, err := readPayouts(file, unit)
+			if err != nil {
+				return err
+			}
+
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			userSender, err := client.GetUser(ctx, &transport.GetUserRequest{Username: sender})
+			if err != nil {
+				return fmt.Errorf("failed to get user: %v", err)
+			}
+			payments := make([]*transport.Payment, 0, len(payouts))
+			for _, p := range payouts {
+				userReceiver, err := client.GetUser(ctx, &transport.GetUserRequest{Username: p.receiver})
+				if err != nil {
+					return fmt.Errorf("failed to get user %s: %v", p.receiver, err)
+				}
+				payments = append(payments, &transport.Payment{
+					Receiver: receiverAddress(userReceiver.GetUser()),
+					Amount:   &transport.Amount{Value: p.amount, Unit: p.unit},
+				})
+			}
+
+			resp, err := client.AddBatchTransaction(ctx, &transport.AddBatchTransactionRequest{
+				Sender:   userKey(userSender.GetUser()),
+				Payments: payments,
+				Fee:      fee,
+				LockTime: lockTime,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to add batch transaction: %w", err)
+			}
+
+			tx := resp.GetTransaction()
+			fmt.Printf("\n✅ Batch transaction added successfully!\n\n")
+			fmt.Printf("  ID:       %s\n", tx.GetId())
+			fmt.Printf("  Hash:     %x\n", tx.GetHash())
+			fmt.Printf("  Fee:      %d\n", tx.GetFee())
+			fmt.Printf("  Inputs:   %d\n", len(tx.GetInputs()))
+			fmt.Printf("\n  PAYOUTS (%d):\n", len(payouts))
+			for i, p := range payouts {
+				fmt.Printf("    [%d] %-20s %d (unit: %d)\n", i+1, p.receiver, p.amount, p.unit)
+			}
+			if outputs := tx.GetOutputs(); len(outputs) > len(payouts) {
+				change := outputs[len(outputs)-1].GetAmount()
+				fmt.Printf("\n  Change:   %d (unit: %d)\n", change.GetValue(), change.GetUnit())
+			}
+			fmt.Println()
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&sender, "sender", "s", "", "Sender username (required)")
+	cmd.Flags().StringVar(&file, "file", "", "CSV file with payouts (required)")
+	cmd.Flags().Uint32VarP(&unit, "unit", "u", 100, "Unit/precision for rows without one")
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid to the block producer, see estimate-fee for the current rate")
+	cmd.Flags().Uint32Var(&lockTime, "lock-time", 0, "Block height, or unix time in seconds from 500000000, the transaction can't be mined before")
+
+	if err := cmd.MarkFlagRequired("sender"); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagRequired("file"); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+func readPayouts(path string, defaultUnit uint32) ([]payout, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open payouts file: %w", err)
+	}
+	defer f.Close() // nolint:errcheck
+
+	reader := csv.NewReader(f)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var payouts []payout
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read payouts file: %w", err)
+		}
+		if len(record) < 2 || len(record) > 3 {
+			return nil, fmt.Errorf("row %d: expected receiver,amount[,unit]", row)
+		}
+		amount, err := strconv.ParseUint(strings.TrimSpace(record[1]), 10, 64)
+		if err != nil {
+			if row == 1 {
+				// header row
+				continue
+			}
+			return nil, fmt.Errorf("row %d: invalid amount %q", row, record[1])
+		}
+		p := payout{receiver: strings.TrimSpace(record[0]), amount: amount, unit: defaultUnit}
+		if len(record) == 3 {
+			u, err := strconv.ParseUint(strings.TrimSpace(record[2]), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: invalid unit %q", row, record[2])
+			}
+			p.unit = uint32(u)
+		}
+		payouts = append(payouts, p)
+	}
+	if len(payouts) == 0 {
+		return nil, errors.New("payouts file has no payouts")
+	}
+	return payouts, nil
+}
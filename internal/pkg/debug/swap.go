@@ -0,0 +1,323 @@
+package debug
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/pkg/script"
+	"local-chain/internal/types"
+	"local-chain/transport/gen/transport"
+
+	"github.com/spf13/cobra"
+)
+
+// swap creates the swap command: exchanging value between two clusters with hash-time-locked contracts
+func swap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "swap",
+		Short: "Atomic swaps between two clusters with hash-time-locked contracts",
+		Long: "Alice locks her amount on cluster A to Bob and Bob locks his amount on cluster B to Alice, both under\n" +
+			"the hash of Alice's secret. Alice claims on B revealing the secret, Bob claims on A with it.\n" +
+			"If either side stalls, the locked amounts go back to their senders after the deadlines.",
+	}
+	cmd.AddCommand(swapRun())
+	cmd.AddCommand(swapClaim())
+	cmd.AddCommand(swapRefund())
+	return cmd
+}
+
+func swapRun() *cobra.Command {
+	var (
+		serverA string
+		serverB string
+		alice   string
+		bob     string
+		amountA uint64
+		amountB uint64
+		unit    uint32
+		fee     uint64
+		window  time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "run",
+		Short: "Run a whole swap of Alice's amount on cluster A for Bob's amount on cluster B",
+		Long:  "Both users must be registered on both clusters: each receives on the other cluster with its key there.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientA, closeA, err := dialClient(serverA)
+			if err != nil {
+				return err
+			}
+			defer closeA()
+			clientB, closeB, err := dialClient(serverB)
+			if err != nil {
+				return err
+			}
+			defer closeB()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			aliceA, err := getUser(ctx, clientA, alice)
+			if err != nil {
+				return err
+			}
+			bobA, err := getUser(ctx, clientA, bob)
+			if err != nil {
+				return err
+			}
+			aliceB, err := getUser(ctx, clientB, alice)
+			if err != nil {
+				return err
+			}
+			bobB, err := getUser(ctx, clientB, bob)
+			if err != nil {
+				return err
+			}
+
+			secret := make([]byte, 32)
+			if _, err = rand.Read(secret); err != nil {
+				return fmt.Errorf("failed to generate secret: %w", err)
+			}
+			hash := types.HashPreimage(secret)
+			// Alice locks first, so her contract outlives Bob's: she can't claim Bob's amount after her refund
+			now := time.Now()
+			deadlineA := uint32(now.Add(2 * window).Unix())
+			deadlineB := uint32(now.Add(window).Unix())
+
+			lockA, err := clientA.CreateHTLC(ctx, &transport.CreateHTLCRequest{
+				Sender:   userKey(aliceA),
+				Receiver: bobA.GetPublicKey(),
+				Amount:   &transport.Amount{Value: amountA, Unit: unit},
+				Hash:     hash,
+				Deadline: deadlineA,
+				Fee:      fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to lock on cluster A: %w", err)
+			}
+			fmt.Printf("1. %s locked %d on A for %s until %d, contract %s:0\n", alice, amountA, bob, deadlineA, lockA.GetTransaction().GetId())
+
+			lockB, err := clientB.CreateHTLC(ctx, &transport.CreateHTLCRequest{
+				Sender:   userKey(bobB),
+				Receiver: aliceB.GetPublicKey(),
+				Amount:   &transport.Amount{Value: amountB, Unit: unit},
+				Hash:     hash,
+				Deadline: deadlineB,
+				Fee:      fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to lock on cluster B, %s can refund after %d: %w", alice, deadlineA, err)
+			}
+			fmt.Printf("2. %s locked %d on B for %s until %d, contract %s:0\n", bob, amountB, alice, deadlineB, lockB.GetTransaction().GetId())
+
+			claimB, err := clientB.ClaimHTLC(ctx, &transport.ClaimHTLCRequest{
+				Receiver: userKey(aliceB),
+				Htlc:     htlcOutpoint(lockB.GetTransaction()),
+				Preimage: secret,
+				Fee:      fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to claim on cluster B: %w", err)
+			}
+			fmt.Printf("3. %s claimed on B in %s\n", alice, claimB.GetTransaction().GetId())
+
+			// Bob learns the secret from Alice's claim
+			revealed, ok := script.HTLCPreimage(claimB.GetTransaction().GetInputs()[0].GetScript())
+			if !ok {
+				return errors.New("claim on cluster B does not reveal the secret")
+			}
+			claimA, err := clientA.ClaimHTLC(ctx, &transport.ClaimHTLCRequest{
+				Receiver: userKey(bobA),
+				Htlc:     htlcOutpoint(lockA.GetTransaction()),
+				Preimage: revealed,
+				Fee:      fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to claim on cluster A with secret %x: %w", revealed, err)
+			}
+			fmt.Printf("4. %s claimed on A in %s\n", bob, claimA.GetTransaction().GetId())
+
+			fmt.Printf("\n✅ Swap completed, secret %x\n\n", revealed)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&serverA, "server-a", "127.0.0.1:9001", "gRPC address of a node of cluster A")
+	cmd.Flags().StringVar(&serverB, "server-b", "", "gRPC address of a node of cluster B (required)")
+	cmd.Flags().StringVar(&alice, "alice", "", "Username paying on cluster A (required)")
+	cmd.Flags().StringVar(&bob, "bob", "", "Username paying on cluster B (required)")
+	cmd.Flags().Uint64Var(&amountA, "amount-a", 0, "Amount Alice pays on cluster A (required)")
+	cmd.Flags().Uint64Var(&amountB, "amount-b", 0, "Amount Bob pays on cluster B (required)")
+	cmd.Flags().Uint32VarP(&unit, "unit", "u", 100, "Unit/precision for the amounts")
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee of every transaction of the swap")
+	cmd.Flags().DurationVar(&window, "window", time.Hour, "Time Alice has to claim on B, Bob gets twice as long on A")
+	markRequired(cmd, "server-b", "alice", "bob", "amount-a", "amount-b")
+
+	return cmd
+}
+
+func swapClaim() *cobra.Command {
+	var (
+		receiver string
+		txID     string
+		txHash   string
+		index    uint32
+		secret   string
+		fee      uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "claim",
+		Short: "Claim a contract output with the secret",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			preimage, err := hex.DecodeString(secret)
+			if err != nil {
+				return fmt.Errorf("invalid secret: %w", err)
+			}
+			outpoint, err := parseOutpoint(txID, txHash, index)
+			if err != nil {
+				return err
+			}
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			user, err := getUser(ctx, client, receiver)
+			if err != nil {
+				return err
+			}
+			resp, err := client.ClaimHTLC(ctx, &transport.ClaimHTLCRequest{
+				Receiver: userKey(user),
+				Htlc:     outpoint,
+				Preimage: preimage,
+				Fee:      fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to claim: %w", err)
+			}
+
+			fmt.Printf("\n✅ Contract claimed in %s\n\n", resp.GetTransaction().GetId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&receiver, "receiver", "r", "", "Receiver username (required)")
+	htlcFlags(cmd, &txID, &txHash, &index)
+	cmd.Flags().StringVar(&secret, "secret", "", "Hex encoded secret the contract hash locks (required)")
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid from the contract amount")
+	markRequired(cmd, "receiver", "secret")
+
+	return cmd
+}
+
+func swapRefund() *cobra.Command {
+	var (
+		sender string
+		txID   string
+		txHash string
+		index  uint32
+		fee    uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "refund",
+		Short: "Take a contract output back after its deadline",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			outpoint, err := parseOutpoint(txID, txHash, index)
+			if err != nil {
+				return err
+			}
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			user, err := getUser(ctx, client, sender)
+			if err != nil {
+				return err
+			}
+			resp, err := client.RefundHTLC(ctx, &transport.RefundHTLCRequest{
+				Sender: userKey(user),
+				Htlc:   outpoint,
+				Fee:    fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to refund: %w", err)
+			}
+
+			fmt.Printf("\n✅ Contract refunded in %s\n\n", resp.GetTransaction().GetId())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&sender, "sender", "s", "", "Sender username (required)")
+	htlcFlags(cmd, &txID, &txHash, &index)
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee paid from the contract amount")
+	markRequired(cmd, "sender")
+
+	return cmd
+}
+
+func htlcFlags(cmd *cobra.Command, txID, txHash *string, index *uint32) {
+	cmd.Flags().StringVar(txID, "tx-id", "", "ID of the transaction holding the contract (required)")
+	cmd.Flags().StringVar(txHash, "tx-hash", "", "Hex encoded hash of the transaction holding the contract (required)")
+	cmd.Flags().Uint32Var(index, "index", 0, "Index of the contract output")
+	markRequired(cmd, "tx-id", "tx-hash")
+}
+
+func parseOutpoint(txID, txHash string, index uint32) (*transport.Utxo, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction hash: %w", err)
+	}
+	return &transport.Utxo{TxId: txID, TxHash: hash, Index: index}, nil
+}
+
+// htlcOutpoint is the contract output of a transaction created by CreateHTLC
+func htlcOutpoint(tx *transport.Transaction) *transport.Utxo {
+	return &transport.Utxo{TxId: tx.GetId(), TxHash: tx.GetHash(), Index: 0}
+}
+
+func getUser(ctx context.Context, client transport.LocalChainClient, username string) (*transport.User, error) {
+	resp, err := client.GetUser(ctx, &transport.GetUserRequest{Username: username})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user %s: %v", username, err)
+	}
+	return resp.GetUser(), nil
+}
+
+// displayAddress renders an owner the way users see it: keys and key hashes as addresses, anything else in hex
+func displayAddress(owner []byte) string {
+	if len(owner) == crypto.AddressSize {
+		return crypto.EncodeAddress(owner)
+	}
+	if pubKey, err := crypto.PublicKeyFromBytes(owner); err == nil {
+		return crypto.Address(pubKey)
+	}
+	return hex.EncodeToString(owner)
+}
+
+// receiverAddress is the address the user receives payments at
+func receiverAddress(user *transport.User) []byte {
+	return []byte(displayAddress(user.GetPublicKey()))
+}
+
+// userKey refers to the user's key by the username, the node signs with it while the user is unlocked
+func userKey(user *transport.User) []byte {
+	return []byte(user.GetUsername())
+}
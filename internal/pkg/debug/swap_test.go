@@ -0,0 +1,195 @@
+package debug_test
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	grpcAdapter "local-chain/internal/adapters/inbound/grpc"
+	"local-chain/internal/adapters/inbound/grpc/mapper"
+	"local-chain/internal/adapters/inbound/raft"
+	"local-chain/internal/adapters/outbound/inMem"
+	"local-chain/internal/adapters/outbound/leveldb"
+	"local-chain/internal/pkg/coinselect"
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/pkg/debug"
+	"local-chain/internal/pkg/keystore"
+	"local-chain/internal/service"
+	"local-chain/internal/types"
+	"local-chain/transport/gen/transport"
+
+	hashicorpRaft "github.com/hashicorp/raft"
+	"github.com/stretchr/testify/require"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const passphrase = "correct horse"
+
+// cluster is a single node cluster running in the process: an in-memory raft, store and keystore
+// behind the gRPC server of the node
+type cluster struct {
+	addr   string
+	store  *leveldb.Store
+	client transport.LocalChainClient
+}
+
+func newCluster(t *testing.T) *cluster {
+	store := leveldb.New(func(string) leveldb.Database {
+		db, err := goleveldb.Open(storage.NewMemStorage(), nil)
+		require.NoError(t, err)
+		return db
+	})
+	require.NoError(t, store.Blockchain().Put(types.NewBlock(0, nil, []byte("genesis"))))
+	txPool := inMem.NewTxPool()
+	fsm := raft.New(store, txPool, service.NewBlockValidator(store, types.DefaultChainID, 1<<20, nil))
+
+	config := hashicorpRaft.DefaultConfig()
+	config.LocalID = "node"
+	config.LogOutput = io.Discard
+	config.HeartbeatTimeout = 50 * time.Millisecond
+	config.ElectionTimeout = 50 * time.Millisecond
+	config.LeaderLeaseTimeout = 50 * time.Millisecond
+	config.CommitTimeout = 5 * time.Millisecond
+	raftAddr, raftTransport := hashicorpRaft.NewInmemTransport("")
+	r, err := hashicorpRaft.NewRaft(config, fsm, hashicorpRaft.NewInmemStore(), hashicorpRaft.NewInmemStore(),
+		hashicorpRaft.NewInmemSnapshotStore(), raftTransport)
+	require.NoError(t, err)
+	require.NoError(t, r.BootstrapCluster(hashicorpRaft.Configuration{
+		Servers: []hashicorpRaft.Server{{ID: config.LocalID, Address: raftAddr}},
+	}).Error())
+	require.Eventually(t, func() bool { return r.State() == hashicorpRaft.Leader }, 5*time.Second, 10*time.Millisecond)
+
+	keys, err := keystore.New(t.TempDir(), keystore.LightScryptN)
+	require.NoError(t, err)
+	transactor := service.NewTransactor(store, txPool, r, types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, keys)
+	user := service.NewUserService(store.User(), keys, transactor, transactor, r)
+	server := grpc.NewServer()
+	transport.RegisterLocalChainServer(server, grpcAdapter.NewLocalChain(
+		config.LocalID, r, mapper.NewTransactionMapper(user), transactor, user, mapper.NewUserMapper(),
+		store.Blockchain(), store.Transaction(), mapper.NewBlockMapper(),
+	))
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	go func() { _ = server.Serve(listener) }()
+	conn, err := grpc.NewClient(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+		server.Stop()
+		require.NoError(t, r.Shutdown().Error())
+	})
+	return &cluster{addr: listener.Addr().String(), store: store, client: transport.NewLocalChainClient(conn)}
+}
+
+// addUser adds an unlocked user to the cluster, the confirmed outputs of the user are worth the value
+func (c *cluster) addUser(t *testing.T, username string, value uint64) {
+	require.NoError(t, run("add-user", "--server", c.addr, "--name", username, "--passphrase", passphrase))
+	require.NoError(t, run("unlock", "--server", c.addr, "--name", username, "--passphrase", passphrase, "--duration", "0"))
+	if value == 0 {
+		return
+	}
+	user, err := c.store.User().Get(username)
+	require.NoError(t, err)
+	pubKey, err := crypto.PublicKeyFromBytes(user.PublicKey)
+	require.NoError(t, err)
+	funding := types.NewTransaction().WithOutput(types.NewAmount(value), pubKey)
+	funding.ComputeHash()
+	require.NoError(t, c.store.Transaction().Put(funding))
+	require.NoError(t, c.store.Utxo().Apply(funding))
+}
+
+func (c *cluster) user(t *testing.T, username string) *transport.User {
+	resp, err := c.client.GetUser(context.Background(), &transport.GetUserRequest{Username: username})
+	require.NoError(t, err)
+	return resp.GetUser()
+}
+
+// balance is the native coin the user owns on the cluster, pending transactions included
+func (c *cluster) balance(t *testing.T, username string) uint64 {
+	resp, err := c.client.GetBalance(context.Background(), &transport.GetBalanceRequest{Owner: c.user(t, username).GetPublicKey()})
+	require.NoError(t, err)
+	return resp.GetAmount().GetValue()
+}
+
+// lock locks the amount of the sender to the receiver on the cluster, as the swap does
+func (c *cluster) lock(t *testing.T, sender, receiver string, amount uint64, hash []byte, deadline uint32) *transport.Transaction {
+	resp, err := c.client.CreateHTLC(context.Background(), &transport.CreateHTLCRequest{
+		Sender:   []byte(sender),
+		Receiver: c.user(t, receiver).GetPublicKey(),
+		Amount:   &transport.Amount{Value: amount, Unit: types.CurrencyUnit},
+		Hash:     hash,
+		Deadline: deadline,
+		Fee:      1,
+	})
+	require.NoError(t, err)
+	return resp.GetTransaction()
+}
+
+func run(args ...string) error {
+	cmd := debug.NewDebug().CMD
+	cmd.SetArgs(args)
+	cmd.SetOut(io.Discard)
+	cmd.SetErr(io.Discard)
+	return cmd.Execute()
+}
+
+func TestSwap_Claim(t *testing.T) {
+	clusterA := newCluster(t)
+	clusterB := newCluster(t)
+	clusterA.addUser(t, "alice", 1000)
+	clusterA.addUser(t, "bob", 0)
+	clusterB.addUser(t, "alice", 0)
+	clusterB.addUser(t, "bob", 1000)
+
+	require.NoError(t, run("swap", "run", "--server-a", clusterA.addr, "--server-b", clusterB.addr,
+		"--alice", "alice", "--bob", "bob", "--amount-a", "300", "--amount-b", "200", "--fee", "1"))
+
+	// each side paid its amount and the lock fee, each claim pays its fee from the amount claimed
+	require.Equal(t, uint64(1000-300-1), clusterA.balance(t, "alice"))
+	require.Equal(t, uint64(300-1), clusterA.balance(t, "bob"))
+	require.Equal(t, uint64(1000-200-1), clusterB.balance(t, "bob"))
+	require.Equal(t, uint64(200-1), clusterB.balance(t, "alice"))
+}
+
+func TestSwap_Refund(t *testing.T) {
+	clusterA := newCluster(t)
+	clusterB := newCluster(t)
+	clusterA.addUser(t, "alice", 1000)
+	clusterA.addUser(t, "bob", 0)
+	clusterB.addUser(t, "alice", 0)
+	clusterB.addUser(t, "bob", 1000)
+
+	secret := make([]byte, 32)
+	hash := types.HashPreimage(secret)
+	deadline := uint32(time.Now().Unix()) + 1
+	lockA := clusterA.lock(t, "alice", "bob", 300, hash, deadline+1)
+	lockB := clusterB.lock(t, "bob", "alice", 200, hash, deadline)
+	refund := func(c *cluster, sender string, lock *transport.Transaction) error {
+		return run("swap", "refund", "--server", c.addr, "--sender", sender,
+			"--tx-id", lock.GetId(), "--tx-hash", hex.EncodeToString(lock.GetHash()), "--fee", "1")
+	}
+	require.Error(t, refund(clusterA, "alice", lockA), "the deadline hasn't passed yet")
+	require.Error(t, refund(clusterB, "bob", lockB), "the deadline hasn't passed yet")
+
+	// Alice stalls, neither side claims before the deadlines
+	for uint32(time.Now().Unix()) <= deadline+1 {
+		time.Sleep(100 * time.Millisecond)
+	}
+	require.Error(t, run("swap", "claim", "--server", clusterB.addr, "--receiver", "alice",
+		"--tx-id", lockB.GetId(), "--tx-hash", hex.EncodeToString(lockB.GetHash()), "--secret", hex.EncodeToString(secret)),
+		"the deadline has passed")
+	require.NoError(t, refund(clusterA, "alice", lockA))
+	require.NoError(t, refund(clusterB, "bob", lockB))
+
+	// the amounts went back to their senders, less the fees of the lock and the refund
+	require.Equal(t, uint64(1000-2), clusterA.balance(t, "alice"))
+	require.Equal(t, uint64(0), clusterA.balance(t, "bob"))
+	require.Equal(t, uint64(1000-2), clusterB.balance(t, "bob"))
+	require.Equal(t, uint64(0), clusterB.balance(t, "alice"))
+}
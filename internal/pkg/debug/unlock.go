@@ -0,0 +1,90 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"local-chain/transport/gen/transport"
+
+	"github.com/spf13/cobra"
+)
+
+// unlock creates the unlock command
+func unlock() *cobra.Command {
+	var (
+		name       string
+		passphrase string
+		duration   time.Duration
+	)
+
+	cmd := &cobra.Command{
+		Use:   "unlock",
+		Short: "Unlock the key of a user",
+		Long:  "Decrypt the user's key on the node so it signs for the user, until the duration passes or the user is locked",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			if _, err = client.UnlockUser(ctx, &transport.UnlockUserRequest{
+				Username:       name,
+				Passphrase:     passphrase,
+				TimeoutSeconds: uint32(duration.Seconds()),
+			}); err != nil {
+				return fmt.Errorf("failed to unlock user: %w", err)
+			}
+
+			if duration > 0 {
+				fmt.Printf("🔓 User '%s' unlocked for %s\n", name, duration)
+			} else {
+				fmt.Printf("🔓 User '%s' unlocked until locked\n", name)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Username (required)")
+	cmd.Flags().StringVar(&passphrase, "passphrase", "", "Passphrase of the user's key (required)")
+	cmd.Flags().DurationVar(&duration, "duration", 5*time.Minute, "How long the key stays unlocked, 0 until locked")
+	markRequired(cmd, "name", "passphrase")
+
+	return cmd
+}
+
+// lock creates the lock command
+func lock() *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   "lock",
+		Short: "Lock the key of a user",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			if _, err = client.LockUser(ctx, &transport.LockUserRequest{Username: name}); err != nil {
+				return fmt.Errorf("failed to lock user: %w", err)
+			}
+
+			fmt.Printf("🔒 User '%s' locked\n", name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Username (required)")
+	markRequired(cmd, "name")
+
+	return cmd
+}
@@ -0,0 +1,171 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+
+	"local-chain/transport/gen/transport"
+
+	"github.com/spf13/cobra"
+)
+
+// listUsers creates the list users command
+func listUsers() *cobra.Command {
+	var (
+		status  string
+		deleted bool
+		prefix  string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "list-users",
+		Short: "List users",
+		Long:  "List the users of the status and the username prefix, deleted users only on request",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			resp, err := client.ListUsers(ctx, &transport.ListUsersRequest{
+				Status:         status,
+				IncludeDeleted: deleted,
+				UsernamePrefix: prefix,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to list users: %w", err)
+			}
+
+			fmt.Printf("👥 %d user(s)\n", len(resp.GetUsers()))
+			for _, user := range resp.GetUsers() {
+				fmt.Printf("  %-15s %-8s %s", user.GetUsername(), user.GetStatus(), displayAddress(user.GetPublicKey()))
+				if user.GetDisplayName() != "" || user.GetEmail() != "" {
+					fmt.Printf("  %s <%s>", user.GetDisplayName(), user.GetEmail())
+				}
+				fmt.Println()
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&status, "status", "", "Status of the listed users: active, disabled or deleted")
+	cmd.Flags().BoolVar(&deleted, "deleted", false, "List the deleted users too")
+	cmd.Flags().StringVar(&prefix, "prefix", "", "Username prefix of the listed users")
+
+	return cmd
+}
+
+// updateUser creates the update user command
+func updateUser() *cobra.Command {
+	var (
+		name        string
+		displayName string
+		email       string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "update-user",
+		Short: "Update the display name or the email of a user",
+		Long:  "Update the display name or the email of a user, the flags left out keep their values",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			req := &transport.UpdateUserRequest{Username: name}
+			if cmd.Flags().Changed("display-name") {
+				req.DisplayName = &displayName
+			}
+			if cmd.Flags().Changed("email") {
+				req.Email = &email
+			}
+
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			resp, err := client.UpdateUser(ctx, req)
+			if err != nil {
+				return fmt.Errorf("failed to update user: %w", err)
+			}
+
+			fmt.Printf("✅ User '%s' updated: %s <%s>\n", name, resp.GetUser().GetDisplayName(), resp.GetUser().GetEmail())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Username (required)")
+	cmd.Flags().StringVar(&displayName, "display-name", "", "Display name of the user")
+	cmd.Flags().StringVar(&email, "email", "", "Email of the user, empty to remove it")
+	markRequired(cmd, "name")
+
+	return cmd
+}
+
+// disableUser creates the disable user command
+func disableUser() *cobra.Command {
+	return userAction("disable-user", "Refuse a user as a sender until it is enabled", "🚫 User '%s' disabled\n",
+		func(ctx context.Context, client transport.LocalChainClient, name string) error {
+			_, err := client.DisableUser(ctx, &transport.DisableUserRequest{Username: name})
+			return err
+		})
+}
+
+// enableUser creates the enable user command
+func enableUser() *cobra.Command {
+	return userAction("enable-user", "Let a disabled user send again", "✅ User '%s' enabled\n",
+		func(ctx context.Context, client transport.LocalChainClient, name string) error {
+			_, err := client.EnableUser(ctx, &transport.EnableUserRequest{Username: name})
+			return err
+		})
+}
+
+// deleteUser creates the delete user command
+func deleteUser() *cobra.Command {
+	return userAction("delete-user", "Drop the personal data and the key of a user, the chain history stays",
+		"🗑️ User '%s' deleted\n",
+		func(ctx context.Context, client transport.LocalChainClient, name string) error {
+			_, err := client.DeleteUser(ctx, &transport.DeleteUserRequest{Username: name})
+			return err
+		})
+}
+
+// userAction creates a command calling the action on the user of the --name flag
+func userAction(
+	use, short, done string,
+	action func(ctx context.Context, client transport.LocalChainClient, name string) error,
+) *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			if err = action(ctx, client, name); err != nil {
+				return fmt.Errorf("%s: %w", use, err)
+			}
+
+			fmt.Printf(done, name)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Username (required)")
+	markRequired(cmd, "name")
+
+	return cmd
+}
@@ -57,14 +57,18 @@ func verifyTransaction() *cobra.Command {
 
 			fmt.Printf("\n  INPUTS (%d):\n", len(tx.GetInputs()))
 			for i, input := range tx.GetInputs() {
-				fmt.Printf("    [%d] Public Key:  %x\n", i+1, input.GetPubKey())
+				fmt.Printf("    [%d] Address:     %s\n", i+1, displayAddress(input.GetPubKey()))
 				fmt.Printf("        SignatureS:   %x\n", input.GetSignatureS())
 				fmt.Printf("        SignatureR:   %x\n", input.GetSignatureR())
 			}
 
 			fmt.Printf("\n  OUTPUTS (%d):\n", len(tx.GetOutputs()))
 			for i, output := range tx.GetOutputs() {
-				fmt.Printf("    [%d] Public Key:  %x\n", i+1, output.GetPubKey())
+				owner := output.GetAddress()
+				if len(owner) == 0 {
+					owner = output.GetPubKey()
+				}
+				fmt.Printf("    [%d] Address:     %s\n", i+1, displayAddress(owner))
 				fmt.Printf("        Amount:      %d (unit: %d)\n", output.GetAmount().GetValue(), output.GetAmount().GetUnit())
 			}
 
@@ -0,0 +1,204 @@
+package debug
+
+import (
+	"context"
+	"fmt"
+
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/pkg/hdwallet"
+	"local-chain/transport/gen/transport"
+
+	"github.com/spf13/cobra"
+)
+
+// wallet creates the wallet command: users whose keys are all derived from one seed phrase
+func wallet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "wallet",
+		Short: "Hierarchical deterministic wallets",
+		Long: "A wallet derives all of its keys from a seed phrase: every address handed out belongs to a fresh key\n" +
+			"and the change of every payment goes to a fresh key too. The seed phrase restores the whole wallet.",
+	}
+	cmd.AddCommand(walletCreate())
+	cmd.AddCommand(walletRestore())
+	cmd.AddCommand(walletDerive())
+	cmd.AddCommand(walletSend())
+	return cmd
+}
+
+func walletCreate() *cobra.Command {
+	var (
+		name          string
+		passphrase    string
+		keyPassphrase string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a user with a new wallet and print its seed phrase",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mnemonic, err := hdwallet.NewMnemonic()
+			if err != nil {
+				return err
+			}
+			address, err := createWallet(name, mnemonic, passphrase, keyPassphrase)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("\n✅ Wallet of '%s' created!\n\n", name)
+			fmt.Printf("  Address:      %s\n", address)
+			fmt.Printf("  Seed phrase:  %s\n\n", mnemonic)
+			fmt.Printf("  Write the seed phrase down, it is the only way to restore the wallet.\n\n")
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Username (required)")
+	cmd.Flags().StringVar(&passphrase, "passphrase", "", "Passphrase protecting the seed phrase")
+	cmd.Flags().StringVar(&keyPassphrase, "key-passphrase", "", "Passphrase the node encrypts the seed with (required)")
+	markRequired(cmd, "name", "key-passphrase")
+	return cmd
+}
+
+func walletRestore() *cobra.Command {
+	var (
+		name          string
+		mnemonic      string
+		passphrase    string
+		keyPassphrase string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore a wallet from its seed phrase, the keys owning outputs are found on the chain",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, err := createWallet(name, mnemonic, passphrase, keyPassphrase)
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("\n✅ Wallet of '%s' restored, address %s\n\n", name, address)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Username (required)")
+	cmd.Flags().StringVarP(&mnemonic, "mnemonic", "m", "", "Seed phrase of the wallet (required)")
+	cmd.Flags().StringVar(&passphrase, "passphrase", "", "Passphrase protecting the seed phrase")
+	cmd.Flags().StringVar(&keyPassphrase, "key-passphrase", "", "Passphrase the node encrypts the seed with (required)")
+	markRequired(cmd, "name", "mnemonic", "key-passphrase")
+	return cmd
+}
+
+func createWallet(name, mnemonic, passphrase, keyPassphrase string) (string, error) {
+	client, closeConn, err := createClient()
+	if err != nil {
+		return "", err
+	}
+	defer closeConn()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	resp, err := client.CreateWallet(ctx, &transport.CreateWalletRequest{
+		Username:      name,
+		Mnemonic:      mnemonic,
+		Passphrase:    passphrase,
+		KeyPassphrase: keyPassphrase,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create wallet: %w", err)
+	}
+	return resp.GetAddress(), nil
+}
+
+func walletDerive() *cobra.Command {
+	var name string
+
+	cmd := &cobra.Command{
+		Use:   "derive",
+		Short: "Hand out a fresh receive address of the wallet",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			resp, err := client.DeriveAddress(ctx, &transport.DeriveAddressRequest{Username: name})
+			if err != nil {
+				return fmt.Errorf("failed to derive address: %w", err)
+			}
+
+			fmt.Printf("\n📬 %s\n\n", resp.GetAddress())
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Username (required)")
+	markRequired(cmd, "name")
+	return cmd
+}
+
+func walletSend() *cobra.Command {
+	var (
+		name     string
+		receiver string
+		amount   uint64
+		unit     uint32
+		fee      uint64
+	)
+
+	cmd := &cobra.Command{
+		Use:   "send",
+		Short: "Pay from all keys of the wallet, the change goes to a fresh key",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			client, closeConn, err := createClient()
+			if err != nil {
+				return err
+			}
+			defer closeConn()
+
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			// the receiver is an address or the username of a registered user
+			to := []byte(receiver)
+			if _, err = crypto.DecodeAddress(receiver); err != nil {
+				userReceiver, err := getUser(ctx, client, receiver)
+				if err != nil {
+					return err
+				}
+				to = receiverAddress(userReceiver)
+			}
+			resp, err := client.SendFromWallet(ctx, &transport.SendFromWalletRequest{
+				Username: name,
+				Payments: []*transport.Payment{{Receiver: to, Amount: &transport.Amount{Value: amount, Unit: unit}}},
+				Fee:      fee,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to send from wallet: %w", err)
+			}
+
+			tx := resp.GetTransaction()
+			fmt.Printf("\n✅ Transaction %s added, %d inputs\n\n", tx.GetId(), len(tx.GetInputs()))
+			for i, output := range tx.GetOutputs() {
+				fmt.Printf("    [%d] %s  %d\n", i+1, displayAddress(output.GetAddress()), output.GetAmount().GetValue())
+			}
+			fmt.Println()
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&name, "name", "n", "", "Username of the wallet owner (required)")
+	cmd.Flags().StringVarP(&receiver, "receiver", "r", "", "Receiver username or address (required)")
+	cmd.Flags().Uint64VarP(&amount, "amount", "a", 0, "Amount (required)")
+	cmd.Flags().Uint32VarP(&unit, "unit", "u", 0, "Unit of the amount")
+	cmd.Flags().Uint64VarP(&fee, "fee", "f", 0, "Fee")
+	markRequired(cmd, "name", "receiver", "amount")
+	return cmd
+}
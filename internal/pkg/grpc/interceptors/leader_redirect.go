@@ -21,16 +21,49 @@ const (
 	skipLeaderRedirectKey contextKey = "skipLeaderRedirect"
 	leaderPort            string     = "9001"
 
-	grpcSrvPrefix               string = "/LocalChain/"
-	grpcMethodAddPeer                  = grpcSrvPrefix + "AddPeer"
-	grpcMethodRemovePeer               = grpcSrvPrefix + "RemovePeer"
-	grpcMethodAddVoter                 = grpcSrvPrefix + "AddVoter"
-	grpcMethodAddTransaction           = grpcSrvPrefix + "AddTransaction"
-	grpcMethodGetBalance               = grpcSrvPrefix + "GetBalance"
-	grpcMethodAddUser                  = grpcSrvPrefix + "AddUser"
-	grpcMethodGetUser                  = grpcSrvPrefix + "GetUser"
-	grpcMethodListUsers                = grpcSrvPrefix + "ListUsers"
-	grpcMethodVerifyTransaction        = grpcSrvPrefix + "VerifyTransaction"
+	grpcSrvPrefix                              string = "/LocalChain/"
+	grpcMethodAddPeer                                 = grpcSrvPrefix + "AddPeer"
+	grpcMethodRemovePeer                              = grpcSrvPrefix + "RemovePeer"
+	grpcMethodAddVoter                                = grpcSrvPrefix + "AddVoter"
+	grpcMethodAddTransaction                          = grpcSrvPrefix + "AddTransaction"
+	grpcMethodAddBatchTransaction                     = grpcSrvPrefix + "AddBatchTransaction"
+	grpcMethodSubmitSignedTransaction                 = grpcSrvPrefix + "SubmitSignedTransaction"
+	grpcMethodCreateMultisigTransaction               = grpcSrvPrefix + "CreateMultisigTransaction"
+	grpcMethodSubmitPartiallySignedTransaction        = grpcSrvPrefix + "SubmitPartiallySignedTransaction"
+	grpcMethodCreateHTLC                              = grpcSrvPrefix + "CreateHTLC"
+	grpcMethodClaimHTLC                               = grpcSrvPrefix + "ClaimHTLC"
+	grpcMethodRefundHTLC                              = grpcSrvPrefix + "RefundHTLC"
+	grpcMethodNotarize                                = grpcSrvPrefix + "Notarize"
+	grpcMethodProveNotarization                       = grpcSrvPrefix + "ProveNotarization"
+	grpcMethodIssueAsset                              = grpcSrvPrefix + "IssueAsset"
+	grpcMethodListAssets                              = grpcSrvPrefix + "ListAssets"
+	grpcMethodMintToken                               = grpcSrvPrefix + "MintToken"
+	grpcMethodTransferToken                           = grpcSrvPrefix + "TransferToken"
+	grpcMethodGetToken                                = grpcSrvPrefix + "GetToken"
+	grpcMethodCreateEscrow                            = grpcSrvPrefix + "CreateEscrow"
+	grpcMethodReleaseEscrow                           = grpcSrvPrefix + "ReleaseEscrow"
+	grpcMethodDisputeEscrow                           = grpcSrvPrefix + "DisputeEscrow"
+	grpcMethodRefundEscrow                            = grpcSrvPrefix + "RefundEscrow"
+	grpcMethodListEscrows                             = grpcSrvPrefix + "ListEscrows"
+	grpcMethodCreateStandingOrder                     = grpcSrvPrefix + "CreateStandingOrder"
+	grpcMethodListStandingOrders                      = grpcSrvPrefix + "ListStandingOrders"
+	grpcMethodCancelStandingOrder                     = grpcSrvPrefix + "CancelStandingOrder"
+	grpcMethodGetBalance                              = grpcSrvPrefix + "GetBalance"
+	grpcMethodEstimateFee                             = grpcSrvPrefix + "EstimateFee"
+	grpcMethodAddUser                                 = grpcSrvPrefix + "AddUser"
+	grpcMethodGetUser                                 = grpcSrvPrefix + "GetUser"
+	grpcMethodListUsers                               = grpcSrvPrefix + "ListUsers"
+	grpcMethodUpdateUser                              = grpcSrvPrefix + "UpdateUser"
+	grpcMethodDisableUser                             = grpcSrvPrefix + "DisableUser"
+	grpcMethodEnableUser                              = grpcSrvPrefix + "EnableUser"
+	grpcMethodDeleteUser                              = grpcSrvPrefix + "DeleteUser"
+	grpcMethodUnlockUser                              = grpcSrvPrefix + "UnlockUser"
+	grpcMethodLockUser                                = grpcSrvPrefix + "LockUser"
+	grpcMethodRotateUserKey                           = grpcSrvPrefix + "RotateUserKey"
+	grpcMethodCreateWallet                            = grpcSrvPrefix + "CreateWallet"
+	grpcMethodDeriveAddress                           = grpcSrvPrefix + "DeriveAddress"
+	grpcMethodSendFromWallet                          = grpcSrvPrefix + "SendFromWallet"
+	grpcMethodVerifyTransaction                       = grpcSrvPrefix + "VerifyTransaction"
 )
 
 // LeaderRedirectInterceptor redirects requests to the leader node if the current node is not the leader.
@@ -106,14 +139,80 @@ func (i *LeaderRedirectInterceptor) forwardToLeader(
 		return client.AddVoter(ctx, req.(*grpcPkg.AddVoterRequest))
 	case grpcMethodAddTransaction:
 		return client.AddTransaction(ctx, req.(*grpcPkg.AddTransactionRequest))
+	case grpcMethodAddBatchTransaction:
+		return client.AddBatchTransaction(ctx, req.(*grpcPkg.AddBatchTransactionRequest))
+	case grpcMethodSubmitSignedTransaction:
+		return client.SubmitSignedTransaction(ctx, req.(*grpcPkg.SubmitSignedTransactionRequest))
+	case grpcMethodCreateMultisigTransaction:
+		return client.CreateMultisigTransaction(ctx, req.(*grpcPkg.CreateMultisigTransactionRequest))
+	case grpcMethodSubmitPartiallySignedTransaction:
+		return client.SubmitPartiallySignedTransaction(ctx, req.(*grpcPkg.SubmitPartiallySignedTransactionRequest))
+	case grpcMethodCreateHTLC:
+		return client.CreateHTLC(ctx, req.(*grpcPkg.CreateHTLCRequest))
+	case grpcMethodClaimHTLC:
+		return client.ClaimHTLC(ctx, req.(*grpcPkg.ClaimHTLCRequest))
+	case grpcMethodRefundHTLC:
+		return client.RefundHTLC(ctx, req.(*grpcPkg.RefundHTLCRequest))
+	case grpcMethodNotarize:
+		return client.Notarize(ctx, req.(*grpcPkg.NotarizeRequest))
+	case grpcMethodProveNotarization:
+		return client.ProveNotarization(ctx, req.(*grpcPkg.ProveNotarizationRequest))
+	case grpcMethodIssueAsset:
+		return client.IssueAsset(ctx, req.(*grpcPkg.IssueAssetRequest))
+	case grpcMethodListAssets:
+		return client.ListAssets(ctx, req.(*emptypb.Empty))
+	case grpcMethodMintToken:
+		return client.MintToken(ctx, req.(*grpcPkg.MintTokenRequest))
+	case grpcMethodTransferToken:
+		return client.TransferToken(ctx, req.(*grpcPkg.TransferTokenRequest))
+	case grpcMethodGetToken:
+		return client.GetToken(ctx, req.(*grpcPkg.GetTokenRequest))
+	case grpcMethodCreateEscrow:
+		return client.CreateEscrow(ctx, req.(*grpcPkg.CreateEscrowRequest))
+	case grpcMethodReleaseEscrow:
+		return client.ReleaseEscrow(ctx, req.(*grpcPkg.ReleaseEscrowRequest))
+	case grpcMethodDisputeEscrow:
+		return client.DisputeEscrow(ctx, req.(*grpcPkg.DisputeEscrowRequest))
+	case grpcMethodRefundEscrow:
+		return client.RefundEscrow(ctx, req.(*grpcPkg.RefundEscrowRequest))
+	case grpcMethodListEscrows:
+		return client.ListEscrows(ctx, req.(*grpcPkg.ListEscrowsRequest))
+	case grpcMethodCreateStandingOrder:
+		return client.CreateStandingOrder(ctx, req.(*grpcPkg.CreateStandingOrderRequest))
+	case grpcMethodListStandingOrders:
+		return client.ListStandingOrders(ctx, req.(*grpcPkg.ListStandingOrdersRequest))
+	case grpcMethodCancelStandingOrder:
+		return client.CancelStandingOrder(ctx, req.(*grpcPkg.CancelStandingOrderRequest))
 	case grpcMethodGetBalance:
 		return client.GetBalance(ctx, req.(*grpcPkg.GetBalanceRequest))
+	case grpcMethodEstimateFee:
+		return client.EstimateFee(ctx, req.(*grpcPkg.EstimateFeeRequest))
 	case grpcMethodAddUser:
 		return client.AddUser(ctx, req.(*grpcPkg.AddUserRequest))
 	case grpcMethodGetUser:
 		return client.GetUser(ctx, req.(*grpcPkg.GetUserRequest))
 	case grpcMethodListUsers:
-		return client.ListUsers(ctx, req.(*emptypb.Empty))
+		return client.ListUsers(ctx, req.(*grpcPkg.ListUsersRequest))
+	case grpcMethodUpdateUser:
+		return client.UpdateUser(ctx, req.(*grpcPkg.UpdateUserRequest))
+	case grpcMethodDisableUser:
+		return client.DisableUser(ctx, req.(*grpcPkg.DisableUserRequest))
+	case grpcMethodEnableUser:
+		return client.EnableUser(ctx, req.(*grpcPkg.EnableUserRequest))
+	case grpcMethodDeleteUser:
+		return client.DeleteUser(ctx, req.(*grpcPkg.DeleteUserRequest))
+	case grpcMethodUnlockUser:
+		return client.UnlockUser(ctx, req.(*grpcPkg.UnlockUserRequest))
+	case grpcMethodLockUser:
+		return client.LockUser(ctx, req.(*grpcPkg.LockUserRequest))
+	case grpcMethodRotateUserKey:
+		return client.RotateUserKey(ctx, req.(*grpcPkg.RotateUserKeyRequest))
+	case grpcMethodCreateWallet:
+		return client.CreateWallet(ctx, req.(*grpcPkg.CreateWalletRequest))
+	case grpcMethodDeriveAddress:
+		return client.DeriveAddress(ctx, req.(*grpcPkg.DeriveAddressRequest))
+	case grpcMethodSendFromWallet:
+		return client.SendFromWallet(ctx, req.(*grpcPkg.SendFromWalletRequest))
 	case grpcMethodVerifyTransaction:
 		return client.VerifyTransaction(ctx, req.(*grpcPkg.VerifyTransactionRequest))
 	default:
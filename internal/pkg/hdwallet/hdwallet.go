@@ -0,0 +1,226 @@
+// Package hdwallet derives a tree of P-256 keys from a single seed, following BIP32 as SLIP-10 adapts it
+// to the NIST P-256 curve, so a whole wallet is backed up by its seed phrase.
+package hdwallet
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	// HardenedOffset is added to the index of hardened children: they can't be derived from the parent public key
+	HardenedOffset uint32 = 0x80000000
+	// mnemonicEntropy is the entropy of new seed phrases in bits, it makes a phrase of 12 words
+	mnemonicEntropy = 128
+	// extendedPublicKeyLen is the length of an encoded extended public key: the compressed key and the chain code
+	extendedPublicKeyLen = 33 + 32
+)
+
+// masterSecret is the HMAC key SLIP-10 derives master keys of the P-256 curve with
+var masterSecret = []byte("Nist256p1 seed")
+
+// NewMnemonic generates a new seed phrase.
+func NewMnemonic() (string, error) {
+	entropy, err := bip39.NewEntropy(mnemonicEntropy)
+	if err != nil {
+		return "", fmt.Errorf("failed to generate entropy: %w", err)
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// Seed checks the words and the checksum of the seed phrase and turns it into the seed of the master key,
+// the passphrase is optional.
+func Seed(mnemonic, passphrase string) ([]byte, error) {
+	seed, err := bip39.NewSeedWithErrorChecking(strings.Join(strings.Fields(mnemonic), " "), passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid seed phrase: %w", err)
+	}
+	return seed, nil
+}
+
+// ExtendedKey is a private key together with the chain code its children are derived with.
+type ExtendedKey struct {
+	Key       *ecdsa.PrivateKey
+	ChainCode []byte
+}
+
+// NewMasterKey derives the root of the key tree from the seed.
+func NewMasterKey(seed []byte) (*ExtendedKey, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, fmt.Errorf("seed of %d bytes, it must be 16 to 64 bytes", len(seed))
+	}
+	mac := hmac.New(sha512.New, masterSecret)
+	mac.Write(seed)
+	sum := mac.Sum(nil)
+	for {
+		// an invalid key is next to impossible, SLIP-10 hashes again then
+		if key, ok := privateKey(new(big.Int).SetBytes(sum[:32])); ok {
+			return &ExtendedKey{Key: key, ChainCode: sum[32:]}, nil
+		}
+		mac = hmac.New(sha512.New, masterSecret)
+		mac.Write(sum)
+		sum = mac.Sum(nil)
+	}
+}
+
+// Child derives the child key at the index, a hardened one from HardenedOffset on.
+func (k *ExtendedKey) Child(index uint32) (*ExtendedKey, error) {
+	if len(k.ChainCode) != 32 {
+		return nil, errors.New("extended key without a chain code")
+	}
+	curve := k.Key.Curve.Params()
+	data := make([]byte, 0, 37)
+	if index >= HardenedOffset {
+		data = append(data, 0)
+		data = append(data, k.Key.D.FillBytes(make([]byte, 32))...)
+	} else {
+		data = append(data, elliptic.MarshalCompressed(k.Key.Curve, k.Key.X, k.Key.Y)...)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+	for {
+		mac := hmac.New(sha512.New, k.ChainCode)
+		mac.Write(data)
+		sum := mac.Sum(nil)
+		tweak := new(big.Int).SetBytes(sum[:32])
+		if tweak.Cmp(curve.N) < 0 {
+			d := tweak.Add(tweak, k.Key.D)
+			if key, ok := privateKey(d.Mod(d, curve.N)); ok {
+				return &ExtendedKey{Key: key, ChainCode: sum[32:]}, nil
+			}
+		}
+		// SLIP-10 derives again from the right half for an invalid key
+		data = append(append([]byte{1}, sum[32:]...), data[len(data)-4:]...)
+	}
+}
+
+// Derive derives the descendant key down the path of child indices.
+func (k *ExtendedKey) Derive(path []uint32) (*ExtendedKey, error) {
+	key := k
+	for _, index := range path {
+		var err error
+		if key, err = key.Child(index); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// Public makes the extended public key of the key, it derives the public keys of the normal descendants.
+func (k *ExtendedKey) Public() *ExtendedPublicKey {
+	return &ExtendedPublicKey{Key: &k.Key.PublicKey, ChainCode: k.ChainCode}
+}
+
+// ExtendedPublicKey is a public key together with the chain code its normal children are derived with,
+// so the public keys of a wallet are derived without its seed.
+type ExtendedPublicKey struct {
+	Key       *ecdsa.PublicKey
+	ChainCode []byte
+}
+
+// ParseExtendedPublicKey parses the extended public key Bytes encodes.
+func ParseExtendedPublicKey(data []byte) (*ExtendedPublicKey, error) {
+	if len(data) != extendedPublicKeyLen {
+		return nil, fmt.Errorf("extended public key of %d bytes, it must be %d bytes", len(data), extendedPublicKeyLen)
+	}
+	curve := elliptic.P256()
+	x, y := elliptic.UnmarshalCompressed(curve, data[:33])
+	if x == nil {
+		return nil, errors.New("extended public key of an invalid point")
+	}
+	return &ExtendedPublicKey{
+		Key:       &ecdsa.PublicKey{Curve: curve, X: x, Y: y},
+		ChainCode: bytes.Clone(data[33:]),
+	}, nil
+}
+
+// Bytes encodes the compressed public key followed by the chain code.
+func (k *ExtendedPublicKey) Bytes() []byte {
+	return append(elliptic.MarshalCompressed(k.Key.Curve, k.Key.X, k.Key.Y), k.ChainCode...)
+}
+
+// Child derives the public key of the normal child at the index, a hardened child needs the private key.
+func (k *ExtendedPublicKey) Child(index uint32) (*ExtendedPublicKey, error) {
+	if index >= HardenedOffset {
+		return nil, fmt.Errorf("hardened child %d of a public key", index-HardenedOffset)
+	}
+	if len(k.ChainCode) != 32 {
+		return nil, errors.New("extended key without a chain code")
+	}
+	curve := k.Key.Curve
+	data := binary.BigEndian.AppendUint32(elliptic.MarshalCompressed(curve, k.Key.X, k.Key.Y), index)
+	for {
+		mac := hmac.New(sha512.New, k.ChainCode)
+		mac.Write(data)
+		sum := mac.Sum(nil)
+		tweak := new(big.Int).SetBytes(sum[:32])
+		if tweak.Cmp(curve.Params().N) < 0 {
+			x, y := curve.ScalarBaseMult(sum[:32])
+			x, y = curve.Add(x, y, k.Key.X, k.Key.Y)
+			// the point at infinity is as invalid as a zero private key
+			if x.Sign() != 0 || y.Sign() != 0 {
+				return &ExtendedPublicKey{Key: &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, ChainCode: sum[32:]}, nil
+			}
+		}
+		// SLIP-10 derives again from the right half for an invalid key
+		data = append(append([]byte{1}, sum[32:]...), data[len(data)-4:]...)
+	}
+}
+
+// Derive derives the descendant public key down the path of normal child indices.
+func (k *ExtendedPublicKey) Derive(path []uint32) (*ExtendedPublicKey, error) {
+	key := k
+	for _, index := range path {
+		var err error
+		if key, err = key.Child(index); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// ParsePath parses a derivation path such as m/44'/1'/0'/0/7, an apostrophe or h marks a hardened index.
+func ParsePath(path string) ([]uint32, error) {
+	parts := strings.Split(strings.TrimSpace(path), "/")
+	if parts[0] != "m" {
+		return nil, fmt.Errorf("path %q does not start at the master key m", path)
+	}
+	indices := make([]uint32, 0, len(parts)-1)
+	for _, part := range parts[1:] {
+		hardened := strings.HasSuffix(part, "'") || strings.HasSuffix(part, "h")
+		if hardened {
+			part = part[:len(part)-1]
+		}
+		index, err := strconv.ParseUint(part, 10, 32)
+		if err != nil || uint32(index) >= HardenedOffset {
+			return nil, fmt.Errorf("invalid index %q of path %q", part, path)
+		}
+		if hardened {
+			index += uint64(HardenedOffset)
+		}
+		indices = append(indices, uint32(index))
+	}
+	return indices, nil
+}
+
+// privateKey makes the P-256 private key of the scalar, false for a scalar out of the curve order
+func privateKey(d *big.Int) (*ecdsa.PrivateKey, bool) {
+	curve := elliptic.P256()
+	if d.Sign() == 0 || d.Cmp(curve.Params().N) >= 0 {
+		return nil, false
+	}
+	key := &ecdsa.PrivateKey{D: d}
+	key.Curve = curve
+	key.X, key.Y = curve.ScalarBaseMult(d.FillBytes(make([]byte, 32)))
+	return key, true
+}
@@ -0,0 +1,129 @@
+package hdwallet_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"local-chain/internal/pkg/hdwallet"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtendedKey_Derive(t *testing.T) {
+	// test vector 1 of SLIP-10 for the nist256p1 curve
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+	master, err := hdwallet.NewMasterKey(seed)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		path      string
+		chainCode string
+		key       string
+	}{
+		{
+			name:      "ok master key",
+			path:      "m",
+			chainCode: "beeb672fe4621673f722f38529c07392fecaa61015c80c34f29ce8b41b3cb6ea",
+			key:       "612091aaa12e22dd2abef664f8a01a82cae99ad7441b7ef8110424915c268bc2",
+		},
+		{
+			name:      "ok hardened child",
+			path:      "m/0'",
+			chainCode: "3460cea53e6a6bb5fb391eeef3237ffd8724bf0a40e94943c98b83825342ee11",
+			key:       "6939694369114c67917a182c59ddb8cafc3004e63ca5d3b84403ba8613debc0c",
+		},
+		{
+			name:      "ok normal child of a hardened one",
+			path:      "m/0'/1",
+			chainCode: "4187afff1aafa8445010097fb99d23aee9f599450c7bd140b6826ac22ba21d0c",
+			key:       "284e9d38d07d21e4e281b645089a94f4cf5a5a81369acf151a1c3a57f18b2129",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path, err := hdwallet.ParsePath(tt.path)
+			require.NoError(t, err)
+			key, err := master.Derive(path)
+			require.NoError(t, err)
+			require.Equal(t, tt.chainCode, hex.EncodeToString(key.ChainCode))
+			require.Equal(t, tt.key, hex.EncodeToString(key.Key.D.FillBytes(make([]byte, 32))))
+		})
+	}
+}
+
+func TestExtendedPublicKey_Derive(t *testing.T) {
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+	master, err := hdwallet.NewMasterKey(seed)
+	require.NoError(t, err)
+	account, err := master.Child(hdwallet.HardenedOffset)
+	require.NoError(t, err)
+	// the extended public key goes through its encoding, as a wallet stores it
+	public, err := hdwallet.ParseExtendedPublicKey(account.Public().Bytes())
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		path    []uint32
+		wantErr bool
+	}{
+		{
+			name: "ok normal child",
+			path: []uint32{1},
+		},
+		{
+			name: "ok normal grandchild",
+			path: []uint32{0, 7},
+		},
+		{
+			name:    "err hardened child",
+			path:    []uint32{hdwallet.HardenedOffset},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			derived, err := public.Derive(tt.path)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			// the public key of the private child, of the same chain code
+			child, err := account.Derive(tt.path)
+			require.NoError(t, err)
+			require.True(t, child.Key.PublicKey.Equal(derived.Key))
+			require.Equal(t, child.ChainCode, derived.ChainCode)
+		})
+	}
+
+	_, err = hdwallet.ParseExtendedPublicKey(seed)
+	require.Error(t, err)
+}
+
+func TestSeed(t *testing.T) {
+	mnemonic, err := hdwallet.NewMnemonic()
+	require.NoError(t, err)
+	words := strings.Fields(mnemonic)
+	require.Len(t, words, 12)
+
+	seed, err := hdwallet.Seed(mnemonic, "")
+	require.NoError(t, err)
+	// restoring from the same phrase gives the same seed, another passphrase another one
+	restored, err := hdwallet.Seed(strings.Join(words, "  "), "")
+	require.NoError(t, err)
+	require.Equal(t, seed, restored)
+	protected, err := hdwallet.Seed(mnemonic, "passphrase")
+	require.NoError(t, err)
+	require.NotEqual(t, seed, protected)
+
+	// the last word of the phrase carries its checksum
+	valid := strings.Repeat("abandon ", 11) + "about"
+	_, err = hdwallet.Seed(valid, "")
+	require.NoError(t, err)
+	_, err = hdwallet.Seed(strings.Repeat("abandon ", 12), "")
+	require.Error(t, err)
+}
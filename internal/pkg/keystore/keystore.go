@@ -0,0 +1,321 @@
+// Package keystore keeps private keys encrypted at rest: every key is a versioned JSON file sealed with AES-256-GCM
+// under a key derived from a passphrase by scrypt. A key is usable while it is unlocked, for a limited time.
+package keystore
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// Version is the version of the key files the keystore writes and reads
+	Version = 1
+	// StandardScryptN is the scrypt cost of key files, LightScryptN a cheap one for tests
+	StandardScryptN = 1 << 18
+	LightScryptN    = 1 << 12
+
+	scryptR   = 8
+	scryptP   = 1
+	keySize   = 32
+	saltSize  = 32
+	cipherAES = "aes-256-gcm"
+	kdfScrypt = "scrypt"
+)
+
+var (
+	ErrNotFound   = errors.New("key not found")
+	ErrExists     = errors.New("key already exists")
+	ErrLocked     = errors.New("key is locked")
+	ErrPassphrase = errors.New("wrong passphrase")
+)
+
+// keyFile is the JSON form of a sealed key, the name of the key authenticates the ciphertext too
+type keyFile struct {
+	Version int          `json:"version"`
+	Name    string       `json:"name"`
+	Crypto  cryptoParams `json:"crypto"`
+}
+
+type cryptoParams struct {
+	Cipher     string       `json:"cipher"`
+	CipherText string       `json:"ciphertext"`
+	Nonce      string       `json:"nonce"`
+	KDF        string       `json:"kdf"`
+	KDFParams  scryptParams `json:"kdfparams"`
+}
+
+type scryptParams struct {
+	N      int    `json:"n"`
+	R      int    `json:"r"`
+	P      int    `json:"p"`
+	KeyLen int    `json:"dklen"`
+	Salt   string `json:"salt"`
+}
+
+// unlockedKey is a decrypted key, the timer locks it again
+type unlockedKey struct {
+	key   []byte
+	timer *time.Timer
+}
+
+// Keystore keeps the key files in a directory and the unlocked keys in memory.
+type Keystore struct {
+	dir     string
+	scryptN int
+
+	mu       sync.Mutex
+	unlocked map[string]*unlockedKey
+}
+
+// New opens the keystore of the directory, creating it if needed. scryptN is the cost of the key files it writes.
+func New(dir string, scryptN int) (*Keystore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create keystore dir: %w", err)
+	}
+	return &Keystore{
+		dir:      dir,
+		scryptN:  scryptN,
+		unlocked: make(map[string]*unlockedKey),
+	}, nil
+}
+
+// Store seals the key under the passphrase as a new key file of the name, the key stays locked.
+func (ks *Keystore) Store(name string, key []byte, passphrase string) error {
+	if name == "" || passphrase == "" {
+		return errors.New("name and passphrase must be provided")
+	}
+	sealed, err := ks.seal(name, key, passphrase)
+	if err != nil {
+		return err
+	}
+	encoded, err := json.MarshalIndent(sealed, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode key file: %w", err)
+	}
+	file, err := os.OpenFile(ks.path(name), os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if errors.Is(err, os.ErrExist) {
+		return ErrExists
+	}
+	if err != nil {
+		return fmt.Errorf("failed to create key file: %w", err)
+	}
+	if _, err = file.Write(encoded); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	return file.Close()
+}
+
+// Replace seals the key under the passphrase in place of the key file of the name, the key is locked.
+// The new key file is written aside and renamed over the old one, so the name keeps one of the two keys.
+func (ks *Keystore) Replace(name string, key []byte, passphrase string) error {
+	if name == "" || passphrase == "" {
+		return errors.New("name and passphrase must be provided")
+	}
+	if _, err := os.Stat(ks.path(name)); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return ErrNotFound
+		}
+		return fmt.Errorf("failed to read key file: %w", err)
+	}
+	sealed, err := ks.seal(name, key, passphrase)
+	if err != nil {
+		return err
+	}
+	encoded, err := json.MarshalIndent(sealed, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode key file: %w", err)
+	}
+	file, err := os.CreateTemp(ks.dir, ".replace-*")
+	if err != nil {
+		return fmt.Errorf("failed to create key file: %w", err)
+	}
+	defer func() {
+		_ = os.Remove(file.Name())
+	}()
+	if _, err = file.Write(encoded); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("failed to write key file: %w", err)
+	}
+	ks.Lock(name)
+	if err = os.Rename(file.Name(), ks.path(name)); err != nil {
+		return fmt.Errorf("failed to replace key file: %w", err)
+	}
+	return nil
+}
+
+// Delete locks the key and removes its key file.
+func (ks *Keystore) Delete(name string) error {
+	ks.Lock(name)
+	if err := os.Remove(ks.path(name)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove key file: %w", err)
+	}
+	return nil
+}
+
+// Unlock decrypts the key with the passphrase and keeps it usable for the timeout, with a zero timeout until Lock.
+// Unlocking an unlocked key restarts its timeout.
+func (ks *Keystore) Unlock(name, passphrase string, timeout time.Duration) error {
+	raw, err := os.ReadFile(ks.path(name))
+	if errors.Is(err, os.ErrNotExist) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read key file: %w", err)
+	}
+	var sealed keyFile
+	if err = json.Unmarshal(raw, &sealed); err != nil {
+		return fmt.Errorf("failed to decode key file: %w", err)
+	}
+	key, err := open(&sealed, name, passphrase)
+	if err != nil {
+		return err
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.lock(name)
+	unlocked := &unlockedKey{key: key}
+	if timeout > 0 {
+		unlocked.timer = time.AfterFunc(timeout, func() {
+			ks.mu.Lock()
+			defer ks.mu.Unlock()
+			// the key may have been unlocked again since
+			if ks.unlocked[name] == unlocked {
+				ks.lock(name)
+			}
+		})
+	}
+	ks.unlocked[name] = unlocked
+	return nil
+}
+
+// Lock forgets the decrypted key.
+func (ks *Keystore) Lock(name string) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.lock(name)
+}
+
+// Key returns a copy of the unlocked key. A name of no key file has no key to unlock.
+func (ks *Keystore) Key(name string) ([]byte, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	unlocked, ok := ks.unlocked[name]
+	if !ok {
+		if _, err := os.Stat(ks.path(name)); errors.Is(err, os.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, ErrLocked
+	}
+	return append([]byte(nil), unlocked.key...), nil
+}
+
+// lock forgets the decrypted key, ks.mu is held
+func (ks *Keystore) lock(name string) {
+	unlocked, ok := ks.unlocked[name]
+	if !ok {
+		return
+	}
+	if unlocked.timer != nil {
+		unlocked.timer.Stop()
+	}
+	clear(unlocked.key)
+	delete(ks.unlocked, name)
+}
+
+// path is the key file of the name, the name is escaped so it stays inside the directory
+func (ks *Keystore) path(name string) string {
+	return filepath.Join(ks.dir, url.PathEscape(name)+".json")
+}
+
+func (ks *Keystore) seal(name string, key []byte, passphrase string) (*keyFile, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+	params := scryptParams{N: ks.scryptN, R: scryptR, P: scryptP, KeyLen: keySize, Salt: hex.EncodeToString(salt)}
+	aead, err := newAEAD(params, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return &keyFile{
+		Version: Version,
+		Name:    name,
+		Crypto: cryptoParams{
+			Cipher:     cipherAES,
+			CipherText: hex.EncodeToString(aead.Seal(nil, nonce, key, []byte(name))),
+			Nonce:      hex.EncodeToString(nonce),
+			KDF:        kdfScrypt,
+			KDFParams:  params,
+		},
+	}, nil
+}
+
+func open(sealed *keyFile, name, passphrase string) ([]byte, error) {
+	if sealed.Version != Version {
+		return nil, fmt.Errorf("unsupported key file version %d", sealed.Version)
+	}
+	if sealed.Crypto.Cipher != cipherAES || sealed.Crypto.KDF != kdfScrypt {
+		return nil, fmt.Errorf("unsupported key file cipher %s with %s", sealed.Crypto.Cipher, sealed.Crypto.KDF)
+	}
+	if sealed.Name != name {
+		return nil, fmt.Errorf("key file of %q instead of %q", sealed.Name, name)
+	}
+	aead, err := newAEAD(sealed.Crypto.KDFParams, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hex.DecodeString(sealed.Crypto.Nonce)
+	if err != nil || len(nonce) != aead.NonceSize() {
+		return nil, errors.New("invalid key file nonce")
+	}
+	cipherText, err := hex.DecodeString(sealed.Crypto.CipherText)
+	if err != nil {
+		return nil, errors.New("invalid key file ciphertext")
+	}
+	key, err := aead.Open(nil, nonce, cipherText, []byte(name))
+	if err != nil {
+		return nil, ErrPassphrase
+	}
+	return key, nil
+}
+
+// newAEAD derives the key of the passphrase and makes the cipher of it
+func newAEAD(params scryptParams, passphrase string) (cipher.AEAD, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, errors.New("invalid key file salt")
+	}
+	if params.KeyLen != keySize {
+		return nil, fmt.Errorf("derived key of %d bytes instead of %d", params.KeyLen, keySize)
+	}
+	derived, err := scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, params.KeyLen)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key: %w", err)
+	}
+	block, err := aes.NewCipher(derived)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -1,8 +1,10 @@
 package merkle
 
 import (
+	"bytes"
 	"crypto/sha512"
 	"errors"
+	"slices"
 
 	"local-chain/internal/types"
 )
@@ -92,47 +94,42 @@ func buildTree(leaves []*Node) (*Node, error) {
 
 // VerifyTransaction verifies if a transaction is in the Merkle Tree.
 func (m *MerkleTree) VerifyTransaction(tx *types.Transaction) (bool, error) {
+	proof, err := m.Proof(tx)
+	if err != nil {
+		return false, err
+	}
+	return VerifyProof(m.Root.Hash, tx.Hash, proof), nil
+}
+
+// Proof returns the Merkle path of the transaction: the sibling hashes from its leaf up to the root.
+func (m *MerkleTree) Proof(tx *types.Transaction) (types.MerkleProof, error) {
 	// looking tx index in leafs
-	var leafIndex int
-	var found bool
 	for i, leaf := range m.Leaves {
 		if leaf.tx.ID == tx.ID {
-			leafIndex = i
-			found = true
-			break
+			return m.getMerklePath(i)
 		}
 	}
-	if !found {
-		return false, errors.New("transaction not found in a tree")
-	}
-
-	// build Merkle Path
-	path, err := m.getMerklePath(leafIndex)
-	if err != nil {
-		return false, err
-	}
-
-	return m.verifyPath(tx.Hash[:], leafIndex, path), nil
+	return nil, errors.New("transaction not found in a tree")
 }
 
 // getMerklePath returns the Merkle Path for a leaf at the given index.
-func (m *MerkleTree) getMerklePath(index int) ([][]byte, error) {
+func (m *MerkleTree) getMerklePath(index int) (types.MerkleProof, error) {
 	if index < 0 || index >= len(m.Leaves) {
 		return nil, errors.New("invalid leaf index")
 	}
 
-	var path [][]byte
+	var path types.MerkleProof
 	current := m.Leaves[index]
 
-	// walk up the tree
+	// walk up the tree, an unpaired node has no sibling: its parent takes its hash
 	for current.parent != nil {
 		parent := current.parent
 		if parent.left == current && parent.right != nil {
 			// add hash right node in the path
-			path = append(path, parent.right.Hash)
+			path = append(path, types.MerkleStep{Hash: parent.right.Hash})
 		} else if parent.right == current {
 			// add hash left node in the path
-			path = append(path, parent.left.Hash)
+			path = append(path, types.MerkleStep{Hash: parent.left.Hash, Left: true})
 		}
 		current = parent
 	}
@@ -140,22 +137,20 @@ func (m *MerkleTree) getMerklePath(index int) ([][]byte, error) {
 	return path, nil
 }
 
-// verifyPath verifies the Merkle Path for a transaction hash.
-func (m *MerkleTree) verifyPath(txHash []byte, index int, path [][]byte) bool {
+// VerifyProof verifies the Merkle path links the transaction hash to the root, it needs no tree:
+// a client holding only the block header can check it.
+func VerifyProof(root, txHash []byte, proof types.MerkleProof) bool {
 	currentHash := txHash
-	for _, siblingHash := range path {
+	for _, step := range proof {
 		hash := sha512.New()
-		if index%2 == 0 {
-			// current node left - add right hash
-			hash.Write(append(currentHash, siblingHash...))
+		if step.Left {
+			hash.Write(append(slices.Clone(step.Hash), currentHash...))
 		} else {
-			// current node right - add left hash
-			hash.Write(append(siblingHash, currentHash...))
+			hash.Write(append(slices.Clone(currentHash), step.Hash...))
 		}
 		currentHash = hash.Sum(nil)
-		index /= 2
 	}
 
 	// compare computed hash with the root
-	return string(currentHash) == string(m.Root.Hash)
+	return bytes.Equal(currentHash, root)
 }
@@ -33,3 +33,21 @@ func TestMerkleTree_VerifyTransaction(t *testing.T) {
 	}
 	require.False(t, valid, "Transaction should not be valid as it is a fake transaction")
 }
+
+func TestMerkleTree_Proof(t *testing.T) {
+	for size := 1; size <= 7; size++ {
+		txs := make([]*types.Transaction, size)
+		for i := range txs {
+			txs[i] = types.NewTransaction()
+			txs[i].ComputeHash()
+		}
+		tree, err := NewMerkleTree(txs...)
+		require.NoError(t, err)
+		for i, tx := range txs {
+			proof, err := tree.Proof(tx)
+			require.NoError(t, err)
+			require.True(t, VerifyProof(tree.Root.Hash, tx.Hash, proof), "leaf %d of %d", i, size)
+			require.False(t, VerifyProof(tree.Root.Hash, []byte("forged"), proof), "leaf %d of %d", i, size)
+		}
+	}
+}
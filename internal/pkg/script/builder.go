@@ -0,0 +1,183 @@
+package script
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Builder assembles a script with minimal pushes.
+type Builder struct {
+	script []byte
+	err    error
+}
+
+func NewBuilder() *Builder {
+	return &Builder{}
+}
+
+func (b *Builder) AddOp(op byte) *Builder {
+	b.script = append(b.script, op)
+	return b
+}
+
+// AddData pushes the data with the shortest push instruction.
+func (b *Builder) AddData(data []byte) *Builder {
+	switch {
+	case len(data) > MaxElementSize:
+		if b.err == nil {
+			b.err = fmt.Errorf("push of %d bytes exceeds the maximum element size %d", len(data), MaxElementSize)
+		}
+		return b
+	case len(data) == 0:
+		b.script = append(b.script, OP_0)
+	case len(data) < int(OP_PUSHDATA1):
+		b.script = append(b.script, byte(len(data)))
+	case len(data) <= 0xff:
+		b.script = append(b.script, OP_PUSHDATA1, byte(len(data)))
+	default:
+		b.script = binary.LittleEndian.AppendUint16(append(b.script, OP_PUSHDATA2), uint16(len(data)))
+	}
+	b.script = append(b.script, data...)
+	return b
+}
+
+// AddInt pushes the number, small ones with OP_1NEGATE and OP_1 to OP_16.
+func (b *Builder) AddInt(n int64) *Builder {
+	switch {
+	case n == -1:
+		return b.AddOp(OP_1NEGATE)
+	case n >= 1 && n <= 16:
+		return b.AddOp(OP_1 + byte(n-1))
+	}
+	return b.AddData(encodeNum(n))
+}
+
+// Script returns the assembled script or the first error met while assembling it.
+func (b *Builder) Script() ([]byte, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	if len(b.script) > MaxScriptSize {
+		return nil, fmt.Errorf("script size %d exceeds the maximum %d", len(b.script), MaxScriptSize)
+	}
+	return b.script, nil
+}
+
+// PayToPubKey is spent by a signature of the key: <pubKey> OP_CHECKSIG.
+func PayToPubKey(pubKey []byte) ([]byte, error) {
+	return NewBuilder().AddData(pubKey).AddOp(OP_CHECKSIG).Script()
+}
+
+// Multisig is spent by signatures of threshold of the keys, in the keys order: m <pubKey>... n OP_CHECKMULTISIG.
+func Multisig(threshold int, pubKeys ...[]byte) ([]byte, error) {
+	if threshold < 1 || threshold > len(pubKeys) || len(pubKeys) > MaxMultisigKeys {
+		return nil, fmt.Errorf("invalid multisig: %d of %d keys", threshold, len(pubKeys))
+	}
+	b := NewBuilder().AddInt(int64(threshold))
+	for _, pubKey := range pubKeys {
+		b.AddData(pubKey)
+	}
+	return b.AddInt(int64(len(pubKeys))).AddOp(OP_CHECKMULTISIG).Script()
+}
+
+// HashLock is spent by the key holder revealing the preimage of the SHA-256 hash:
+// OP_SHA256 <hash> OP_EQUALVERIFY <pubKey> OP_CHECKSIG, unlocked by <sig> <preimage>.
+func HashLock(hash, pubKey []byte) ([]byte, error) {
+	return NewBuilder().
+		AddOp(OP_SHA256).AddData(hash).AddOp(OP_EQUALVERIFY).
+		AddData(pubKey).AddOp(OP_CHECKSIG).
+		Script()
+}
+
+// TimeLock is spent by the key holder once the transaction's lock time reaches the lock time:
+// <lockTime> OP_CHECKLOCKTIMEVERIFY OP_DROP <pubKey> OP_CHECKSIG.
+func TimeLock(lockTime uint32, pubKey []byte) ([]byte, error) {
+	return NewBuilder().
+		AddInt(int64(lockTime)).AddOp(OP_CHECKLOCKTIMEVERIFY).AddOp(OP_DROP).
+		AddData(pubKey).AddOp(OP_CHECKSIG).
+		Script()
+}
+
+// Unlock builds an unlocking script pushing the elements in order.
+func Unlock(elements ...[]byte) ([]byte, error) {
+	b := NewBuilder()
+	for _, element := range elements {
+		b.AddData(element)
+	}
+	return b.Script()
+}
+
+// Disassemble renders the script as text: opcode names and data pushes as 0x-prefixed hex.
+func Disassemble(script []byte) (string, error) {
+	instructions, err := Parse(script)
+	if err != nil {
+		return "", err
+	}
+	tokens := make([]string, 0, len(instructions))
+	for _, ins := range instructions {
+		if ins.Op > OP_0 && ins.Op <= OP_PUSHDATA2 {
+			tokens = append(tokens, "0x"+hex.EncodeToString(ins.Data))
+			continue
+		}
+		tokens = append(tokens, opcodeName(ins.Op))
+	}
+	return strings.Join(tokens, " "), nil
+}
+
+// Assemble parses the text Disassemble renders. Decimal numbers are pushed as numbers.
+func Assemble(text string) ([]byte, error) {
+	b := NewBuilder()
+	for _, token := range strings.Fields(text) {
+		if data, ok := strings.CutPrefix(token, "0x"); ok {
+			v, err := hex.DecodeString(data)
+			if err != nil {
+				return nil, fmt.Errorf("invalid data %q: %w", token, err)
+			}
+			b.AddData(v)
+			continue
+		}
+		if n, err := strconv.ParseInt(token, 10, 64); err == nil {
+			b.AddInt(n)
+			continue
+		}
+		op, ok := opcodeByName(token)
+		if !ok {
+			return nil, fmt.Errorf("unknown opcode %q", token)
+		}
+		b.AddOp(op)
+	}
+	return b.Script()
+}
+
+func opcodeByName(name string) (byte, bool) {
+	name = strings.ToUpper(name)
+	if !strings.HasPrefix(name, "OP_") {
+		name = "OP_" + name
+	}
+	for op, opName := range opcodeNames {
+		if opName == name && op != OP_PUSHDATA1 && op != OP_PUSHDATA2 {
+			return op, true
+		}
+	}
+	return 0, false
+}
+
+// NullData carries the data in an unspendable output: OP_RETURN <data>.
+func NullData(data []byte) ([]byte, error) {
+	return NewBuilder().AddOp(OP_RETURN).AddData(data).Script()
+}
+
+// NullDataPayload returns the data of a script built by NullData.
+func NullDataPayload(script []byte) ([]byte, bool) {
+	if len(script) == 0 || script[0] != OP_RETURN {
+		return nil, false
+	}
+	instructions, err := Parse(script)
+	if err != nil || len(instructions) != 2 || !isPush(instructions[1].Op) {
+		return nil, false
+	}
+	return instructions[1].Data, true
+}
@@ -0,0 +1,67 @@
+package script
+
+import (
+	"bytes"
+	"errors"
+)
+
+// EscrowTerms are the terms of an escrow: the buyer and the seller release the output together, the arbiter
+// settles a dispute together with either of them, the buyer takes it back once the transaction lock time
+// reaches Timeout.
+type EscrowTerms struct {
+	Buyer   []byte
+	Seller  []byte
+	Arbiter []byte
+	Timeout uint32
+}
+
+// Escrow locks an output to the terms:
+// OP_IF 2 <buyer> <seller> <arbiter> 3 OP_CHECKMULTISIG OP_ELSE <timeout> OP_CHECKLOCKTIMEVERIFY OP_DROP <buyer>
+// OP_CHECKSIG OP_ENDIF. Two of the parties unlock it by <sig> <sig> 1 in the keys order, the buyer by <sig> 0.
+func Escrow(terms EscrowTerms) ([]byte, error) {
+	return NewBuilder().
+		AddOp(OP_IF).
+		AddInt(2).AddData(terms.Buyer).AddData(terms.Seller).AddData(terms.Arbiter).AddInt(3).AddOp(OP_CHECKMULTISIG).
+		AddOp(OP_ELSE).
+		AddInt(int64(terms.Timeout)).AddOp(OP_CHECKLOCKTIMEVERIFY).AddOp(OP_DROP).AddData(terms.Buyer).AddOp(OP_CHECKSIG).
+		AddOp(OP_ENDIF).
+		Script()
+}
+
+// ParseEscrow recovers the terms of a script built by Escrow.
+func ParseEscrow(script []byte) (*EscrowTerms, error) {
+	errNotEscrow := errors.New("script is not an escrow")
+	instructions, err := Parse(script)
+	if err != nil {
+		return nil, err
+	}
+	if len(instructions) != 14 {
+		return nil, errNotEscrow
+	}
+	timeout, err := instructionNum(instructions[8])
+	if err != nil || timeout < 0 || timeout > int64(^uint32(0)) {
+		return nil, errNotEscrow
+	}
+	terms := &EscrowTerms{
+		Buyer:   instructions[2].Data,
+		Seller:  instructions[3].Data,
+		Arbiter: instructions[4].Data,
+		Timeout: uint32(timeout),
+	}
+	// the terms must build the very same script
+	if built, err := Escrow(*terms); err != nil || !bytes.Equal(built, script) {
+		return nil, errNotEscrow
+	}
+	return terms, nil
+}
+
+// EscrowSettle unlocks an escrow output by two of the parties: <sig> <sig> 1, the signatures in the order
+// of the keys, the buyer's before the seller's before the arbiter's.
+func EscrowSettle(first, second []byte) ([]byte, error) {
+	return NewBuilder().AddData(first).AddData(second).AddInt(1).Script()
+}
+
+// EscrowRefund unlocks an escrow output for the buyer after the timeout: <sig> 0.
+func EscrowRefund(sig []byte) ([]byte, error) {
+	return NewBuilder().AddData(sig).AddInt(0).Script()
+}
@@ -0,0 +1,89 @@
+package script
+
+import (
+	"bytes"
+	"errors"
+)
+
+// HTLCTerms are the terms of a hash-time-locked contract: the receiver claims the output with the preimage
+// of Hash, the sender takes it back once the transaction lock time reaches Deadline.
+type HTLCTerms struct {
+	Hash     []byte
+	Receiver []byte
+	Sender   []byte
+	Deadline uint32
+}
+
+// HTLC locks an output to the terms:
+// OP_IF OP_SHA256 <hash> OP_EQUALVERIFY <receiver> OP_ELSE <deadline> OP_CHECKLOCKTIMEVERIFY OP_DROP <sender> OP_ENDIF
+// OP_CHECKSIG. The receiver unlocks it by <sig> <preimage> 1, the sender by <sig> 0.
+func HTLC(terms HTLCTerms) ([]byte, error) {
+	return NewBuilder().
+		AddOp(OP_IF).
+		AddOp(OP_SHA256).AddData(terms.Hash).AddOp(OP_EQUALVERIFY).AddData(terms.Receiver).
+		AddOp(OP_ELSE).
+		AddInt(int64(terms.Deadline)).AddOp(OP_CHECKLOCKTIMEVERIFY).AddOp(OP_DROP).AddData(terms.Sender).
+		AddOp(OP_ENDIF).
+		AddOp(OP_CHECKSIG).
+		Script()
+}
+
+// ParseHTLC recovers the terms of a script built by HTLC.
+func ParseHTLC(script []byte) (*HTLCTerms, error) {
+	errNotHTLC := errors.New("script is not a hash-time-locked contract")
+	instructions, err := Parse(script)
+	if err != nil {
+		return nil, err
+	}
+	if len(instructions) != 12 {
+		return nil, errNotHTLC
+	}
+	deadline, err := instructionNum(instructions[6])
+	if err != nil || deadline < 0 || deadline > int64(^uint32(0)) {
+		return nil, errNotHTLC
+	}
+	terms := &HTLCTerms{
+		Hash:     instructions[2].Data,
+		Receiver: instructions[4].Data,
+		Sender:   instructions[9].Data,
+		Deadline: uint32(deadline),
+	}
+	// the terms must build the very same script
+	if built, err := HTLC(*terms); err != nil || !bytes.Equal(built, script) {
+		return nil, errNotHTLC
+	}
+	return terms, nil
+}
+
+// HTLCClaim unlocks an HTLC output for the receiver: <sig> <preimage> 1.
+func HTLCClaim(sig, preimage []byte) ([]byte, error) {
+	return NewBuilder().AddData(sig).AddData(preimage).AddInt(1).Script()
+}
+
+// HTLCRefund unlocks an HTLC output for the sender: <sig> 0.
+func HTLCRefund(sig []byte) ([]byte, error) {
+	return NewBuilder().AddData(sig).AddInt(0).Script()
+}
+
+// HTLCPreimage returns the preimage an unlocking script built by HTLCClaim reveals, so the counterparty of a swap
+// can claim its side with it.
+func HTLCPreimage(unlocking []byte) ([]byte, bool) {
+	instructions, err := Parse(unlocking)
+	if err != nil || len(instructions) != 3 || instructions[2].Op != OP_1 {
+		return nil, false
+	}
+	return instructions[1].Data, true
+}
+
+// instructionNum reads the number a push instruction pushes
+func instructionNum(ins Instruction) (int64, error) {
+	switch {
+	case ins.Op == OP_1NEGATE:
+		return -1, nil
+	case ins.Op >= OP_1 && ins.Op <= OP_16:
+		return int64(ins.Op-OP_1) + 1, nil
+	case isPush(ins.Op):
+		return decodeNum(ins.Data)
+	}
+	return 0, errors.New("instruction does not push a number")
+}
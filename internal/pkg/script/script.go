@@ -0,0 +1,470 @@
+// Package script implements a small stack-based language locking transaction outputs.
+//
+// An output carries a locking script and the input spending it an unlocking script. The unlocking script may only
+// push data, then the locking script runs on the same stack and the output is spent when exactly one true element
+// is left. There are no loops and every script is limited in size and in executed steps, so evaluation always
+// terminates and depends only on the scripts and the spending transaction.
+package script
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+const (
+	// MaxScriptSize is the largest script in bytes
+	MaxScriptSize = 10_000
+	// MaxElementSize is the largest element pushed to the stack in bytes
+	MaxElementSize = 520
+	// MaxStackSize is the largest number of elements on the stack
+	MaxStackSize = 1000
+	// MaxSteps is the largest number of instructions evaluated for both scripts together, skipped branches included
+	MaxSteps = 1000
+	// MaxMultisigKeys is the largest number of keys of OP_CHECKMULTISIG
+	MaxMultisigKeys = 20
+	// maxNumSize is the largest number operand in bytes, enough for lock times and sequences
+	maxNumSize = 5
+)
+
+var (
+	// ErrEvalFalse is returned when the scripts run to the end but don't leave a single true element
+	ErrEvalFalse = errors.New("script evaluated to false")
+	// ErrVerify is returned when a *VERIFY instruction fails
+	ErrVerify = errors.New("script verification failed")
+)
+
+// Checker verifies what the scripts can't compute themselves: signatures over the spending transaction
+// and the locks of the transaction and the input.
+type Checker interface {
+	// CheckSig verifies the signature of the spending transaction by the public key
+	CheckSig(sig, pubKey []byte) bool
+	// CheckLockTime tells whether the transaction's lock time is at least the lock time (OP_CHECKLOCKTIMEVERIFY)
+	CheckLockTime(lockTime int64) bool
+	// CheckSequence tells whether the input's relative lock is at least the sequence (OP_CHECKSEQUENCEVERIFY)
+	CheckSequence(sequence int64) bool
+}
+
+// Instruction is an opcode with the data it pushes, if any.
+type Instruction struct {
+	Op   byte
+	Data []byte
+}
+
+// Parse splits the script into instructions.
+func Parse(script []byte) ([]Instruction, error) {
+	if len(script) > MaxScriptSize {
+		return nil, fmt.Errorf("script size %d exceeds the maximum %d", len(script), MaxScriptSize)
+	}
+	var instructions []Instruction
+	for i := 0; i < len(script); {
+		op := script[i]
+		i++
+		var size int
+		switch {
+		case op > OP_0 && op < OP_PUSHDATA1:
+			size = int(op)
+		case op == OP_PUSHDATA1:
+			if i+1 > len(script) {
+				return nil, errors.New("OP_PUSHDATA1 without a length")
+			}
+			size = int(script[i])
+			i++
+		case op == OP_PUSHDATA2:
+			if i+2 > len(script) {
+				return nil, errors.New("OP_PUSHDATA2 without a length")
+			}
+			size = int(binary.LittleEndian.Uint16(script[i:]))
+			i += 2
+		default:
+			instructions = append(instructions, Instruction{Op: op})
+			continue
+		}
+		if i+size > len(script) {
+			return nil, fmt.Errorf("push of %d bytes past the end of the script", size)
+		}
+		if size > MaxElementSize {
+			return nil, fmt.Errorf("push of %d bytes exceeds the maximum element size %d", size, MaxElementSize)
+		}
+		instructions = append(instructions, Instruction{Op: op, Data: script[i : i+size]})
+		i += size
+	}
+	return instructions, nil
+}
+
+// Execute runs the unlocking script and then the locking script on the same stack.
+// It returns nil when the output is spent: exactly one true element is left on the stack.
+func Execute(unlocking, locking []byte, checker Checker) error {
+	unlock, err := Parse(unlocking)
+	if err != nil {
+		return fmt.Errorf("unlocking script: %w", err)
+	}
+	for _, ins := range unlock {
+		if !isPush(ins.Op) {
+			return errors.New("unlocking script must only push data")
+		}
+	}
+	lock, err := Parse(locking)
+	if err != nil {
+		return fmt.Errorf("locking script: %w", err)
+	}
+
+	e := &engine{checker: checker}
+	if err = e.run(unlock); err != nil {
+		return fmt.Errorf("unlocking script: %w", err)
+	}
+	if err = e.run(lock); err != nil {
+		return fmt.Errorf("locking script: %w", err)
+	}
+	if len(e.stack) != 1 || !asBool(e.stack[0]) {
+		return ErrEvalFalse
+	}
+	return nil
+}
+
+type engine struct {
+	stack   [][]byte
+	checker Checker
+	steps   int
+}
+
+func (e *engine) run(instructions []Instruction) error {
+	// branches of the enclosing OP_IFs, an instruction is executed when all of them are taken
+	var branches []bool
+	executing := func() bool {
+		for _, taken := range branches {
+			if !taken {
+				return false
+			}
+		}
+		return true
+	}
+	for _, ins := range instructions {
+		e.steps++
+		if e.steps > MaxSteps {
+			return fmt.Errorf("script exceeds %d steps", MaxSteps)
+		}
+		switch ins.Op {
+		case OP_IF, OP_NOTIF:
+			taken := false
+			if executing() {
+				v, err := e.pop()
+				if err != nil {
+					return err
+				}
+				taken = asBool(v) == (ins.Op == OP_IF)
+			}
+			branches = append(branches, taken)
+			continue
+		case OP_ELSE:
+			if len(branches) == 0 {
+				return errors.New("OP_ELSE without OP_IF")
+			}
+			branches[len(branches)-1] = !branches[len(branches)-1]
+			continue
+		case OP_ENDIF:
+			if len(branches) == 0 {
+				return errors.New("OP_ENDIF without OP_IF")
+			}
+			branches = branches[:len(branches)-1]
+			continue
+		}
+		if !executing() {
+			continue
+		}
+		if err := e.step(ins); err != nil {
+			return fmt.Errorf("%s: %w", opcodeName(ins.Op), err)
+		}
+		if len(e.stack) > MaxStackSize {
+			return fmt.Errorf("stack exceeds %d elements", MaxStackSize)
+		}
+	}
+	if len(branches) > 0 {
+		return errors.New("OP_IF without OP_ENDIF")
+	}
+	return nil
+}
+
+// step executes an instruction other than a flow control one
+func (e *engine) step(ins Instruction) error {
+	switch op := ins.Op; {
+	case op <= OP_PUSHDATA2:
+		e.push(ins.Data)
+	case op == OP_1NEGATE:
+		e.push(encodeNum(-1))
+	case op >= OP_1 && op <= OP_16:
+		e.push(encodeNum(int64(op - OP_1 + 1)))
+	case op == OP_VERIFY:
+		v, err := e.pop()
+		if err != nil {
+			return err
+		}
+		if !asBool(v) {
+			return ErrVerify
+		}
+	case op == OP_RETURN:
+		return errors.New("output is unspendable")
+	case op == OP_DROP:
+		_, err := e.pop()
+		return err
+	case op == OP_DUP:
+		v, err := e.peek(0)
+		if err != nil {
+			return err
+		}
+		e.push(v)
+	case op == OP_OVER:
+		v, err := e.peek(1)
+		if err != nil {
+			return err
+		}
+		e.push(v)
+	case op == OP_SWAP:
+		b, err := e.pop()
+		if err != nil {
+			return err
+		}
+		a, err := e.pop()
+		if err != nil {
+			return err
+		}
+		e.push(b)
+		e.push(a)
+	case op == OP_SIZE:
+		v, err := e.peek(0)
+		if err != nil {
+			return err
+		}
+		e.push(encodeNum(int64(len(v))))
+	case op == OP_EQUAL || op == OP_EQUALVERIFY:
+		b, err := e.pop()
+		if err != nil {
+			return err
+		}
+		a, err := e.pop()
+		if err != nil {
+			return err
+		}
+		return e.result(op == OP_EQUALVERIFY, bytes.Equal(a, b))
+	case op == OP_NOT:
+		n, err := e.popNum()
+		if err != nil {
+			return err
+		}
+		e.push(encodeBool(n == 0))
+	case op == OP_ADD || op == OP_SUB || op == OP_NUMEQUAL || op == OP_LESSTHAN || op == OP_GREATERTHAN:
+		b, err := e.popNum()
+		if err != nil {
+			return err
+		}
+		a, err := e.popNum()
+		if err != nil {
+			return err
+		}
+		switch op {
+		case OP_ADD:
+			e.push(encodeNum(a + b))
+		case OP_SUB:
+			e.push(encodeNum(a - b))
+		case OP_NUMEQUAL:
+			e.push(encodeBool(a == b))
+		case OP_LESSTHAN:
+			e.push(encodeBool(a < b))
+		case OP_GREATERTHAN:
+			e.push(encodeBool(a > b))
+		}
+	case op == OP_SHA256:
+		v, err := e.pop()
+		if err != nil {
+			return err
+		}
+		hash := sha256.Sum256(v)
+		e.push(hash[:])
+	case op == OP_CHECKSIG || op == OP_CHECKSIGVERIFY:
+		pubKey, err := e.pop()
+		if err != nil {
+			return err
+		}
+		sig, err := e.pop()
+		if err != nil {
+			return err
+		}
+		return e.result(op == OP_CHECKSIGVERIFY, len(sig) > 0 && e.checker.CheckSig(sig, pubKey))
+	case op == OP_CHECKMULTISIG || op == OP_CHECKMULTISIGVERIFY:
+		ok, err := e.checkMultisig()
+		if err != nil {
+			return err
+		}
+		return e.result(op == OP_CHECKMULTISIGVERIFY, ok)
+	case op == OP_CHECKLOCKTIMEVERIFY || op == OP_CHECKSEQUENCEVERIFY:
+		// the operand stays on the stack, scripts drop it explicitly
+		v, err := e.peek(0)
+		if err != nil {
+			return err
+		}
+		n, err := decodeNum(v)
+		if err != nil {
+			return err
+		}
+		if n < 0 {
+			return errors.New("negative lock")
+		}
+		if op == OP_CHECKLOCKTIMEVERIFY && !e.checker.CheckLockTime(n) ||
+			op == OP_CHECKSEQUENCEVERIFY && !e.checker.CheckSequence(n) {
+			return ErrVerify
+		}
+	default:
+		return fmt.Errorf("unknown opcode 0x%02x", op)
+	}
+	return nil
+}
+
+// checkMultisig pops "<sig>... m <pubKey>... n" and checks the m signatures were made, in order, by m of the n keys
+func (e *engine) checkMultisig() (bool, error) {
+	n, err := e.popNum()
+	if err != nil {
+		return false, err
+	}
+	if n < 0 || n > MaxMultisigKeys {
+		return false, fmt.Errorf("number of keys %d is out of range", n)
+	}
+	pubKeys, err := e.popN(int(n))
+	if err != nil {
+		return false, err
+	}
+	m, err := e.popNum()
+	if err != nil {
+		return false, err
+	}
+	if m < 0 || m > n {
+		return false, fmt.Errorf("number of signatures %d is out of range", m)
+	}
+	sigs, err := e.popN(int(m))
+	if err != nil {
+		return false, err
+	}
+	for len(sigs) > 0 && len(sigs) <= len(pubKeys) {
+		if len(sigs[0]) > 0 && e.checker.CheckSig(sigs[0], pubKeys[0]) {
+			sigs = sigs[1:]
+		}
+		pubKeys = pubKeys[1:]
+	}
+	return len(sigs) == 0, nil
+}
+
+// result pushes the outcome of a check or, for the *VERIFY variants, fails unless it succeeded
+func (e *engine) result(verify, ok bool) error {
+	if !verify {
+		e.push(encodeBool(ok))
+		return nil
+	}
+	if !ok {
+		return ErrVerify
+	}
+	return nil
+}
+
+func (e *engine) push(v []byte) {
+	e.stack = append(e.stack, v)
+}
+
+func (e *engine) pop() ([]byte, error) {
+	if len(e.stack) == 0 {
+		return nil, errors.New("stack is empty")
+	}
+	v := e.stack[len(e.stack)-1]
+	e.stack = e.stack[:len(e.stack)-1]
+	return v, nil
+}
+
+// popN pops n elements and returns them in the order they were pushed
+func (e *engine) popN(n int) ([][]byte, error) {
+	if len(e.stack) < n {
+		return nil, fmt.Errorf("stack has %d elements, %d needed", len(e.stack), n)
+	}
+	values := make([][]byte, n)
+	copy(values, e.stack[len(e.stack)-n:])
+	e.stack = e.stack[:len(e.stack)-n]
+	return values, nil
+}
+
+func (e *engine) popNum() (int64, error) {
+	v, err := e.pop()
+	if err != nil {
+		return 0, err
+	}
+	return decodeNum(v)
+}
+
+func (e *engine) peek(depth int) ([]byte, error) {
+	if len(e.stack) <= depth {
+		return nil, fmt.Errorf("stack has %d elements, %d needed", len(e.stack), depth+1)
+	}
+	return e.stack[len(e.stack)-1-depth], nil
+}
+
+func opcodeName(op byte) string {
+	if name, ok := opcodeNames[op]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%02x", op)
+}
+
+// asBool is false for an empty element, zeros and the negative zero
+func asBool(v []byte) bool {
+	for i, b := range v {
+		if b != 0 {
+			return i != len(v)-1 || b != 0x80
+		}
+	}
+	return false
+}
+
+func encodeBool(v bool) []byte {
+	if v {
+		return []byte{1}
+	}
+	return nil
+}
+
+// encodeNum encodes the number little-endian with the sign in the highest bit of the last byte, zero is empty
+func encodeNum(n int64) []byte {
+	if n == 0 {
+		return nil
+	}
+	negative := n < 0
+	abs := uint64(n)
+	if negative {
+		abs = uint64(-n)
+	}
+	var v []byte
+	for ; abs > 0; abs >>= 8 {
+		v = append(v, byte(abs))
+	}
+	if v[len(v)-1]&0x80 != 0 {
+		v = append(v, 0)
+	}
+	if negative {
+		v[len(v)-1] |= 0x80
+	}
+	return v
+}
+
+func decodeNum(v []byte) (int64, error) {
+	if len(v) > maxNumSize {
+		return 0, fmt.Errorf("number of %d bytes exceeds %d bytes", len(v), maxNumSize)
+	}
+	if len(v) == 0 {
+		return 0, nil
+	}
+	var n int64
+	for i, b := range v {
+		n |= int64(b) << (8 * i)
+	}
+	if v[len(v)-1]&0x80 != 0 {
+		return -(n &^ (int64(0x80) << (8 * (len(v) - 1)))), nil
+	}
+	return n, nil
+}
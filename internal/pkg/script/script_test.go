@@ -0,0 +1,244 @@
+package script_test
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"local-chain/internal/pkg/script"
+
+	"github.com/stretchr/testify/require"
+)
+
+// fakeChecker accepts a signature equal to "sig:" followed by the key and lock times up to its own
+type fakeChecker struct {
+	lockTime int64
+	sequence int64
+}
+
+func (c fakeChecker) CheckSig(sig, pubKey []byte) bool {
+	return bytes.Equal(sig, append([]byte("sig:"), pubKey...))
+}
+
+func (c fakeChecker) CheckLockTime(lockTime int64) bool {
+	return lockTime <= c.lockTime
+}
+
+func (c fakeChecker) CheckSequence(sequence int64) bool {
+	return sequence <= c.sequence
+}
+
+func sig(pubKey []byte) []byte {
+	return append([]byte("sig:"), pubKey...)
+}
+
+func TestExecute(t *testing.T) {
+	alice, bob, carol := []byte("alice"), []byte("bob"), []byte("carol")
+	preimage := []byte("secret")
+	hash := sha256.Sum256(preimage)
+
+	must := func(s []byte, err error) []byte {
+		require.NoError(t, err)
+		return s
+	}
+	asm := func(text string) []byte {
+		return must(script.Assemble(text))
+	}
+
+	tests := []struct {
+		name      string
+		unlocking []byte
+		locking   []byte
+		checker   fakeChecker
+		wantErr   bool
+	}{
+		{
+			name:      "pay to pubkey",
+			unlocking: must(script.Unlock(sig(alice))),
+			locking:   must(script.PayToPubKey(alice)),
+		},
+		{
+			name:      "pay to pubkey signed by another key",
+			unlocking: must(script.Unlock(sig(bob))),
+			locking:   must(script.PayToPubKey(alice)),
+			wantErr:   true,
+		},
+		{
+			name:      "2 of 3 multisig",
+			unlocking: must(script.Unlock(sig(alice), sig(carol))),
+			locking:   must(script.Multisig(2, alice, bob, carol)),
+		},
+		{
+			name:      "2 of 3 multisig with one signature",
+			unlocking: must(script.Unlock(sig(bob))),
+			locking:   must(script.Multisig(2, alice, bob, carol)),
+			wantErr:   true,
+		},
+		{
+			name:      "2 of 3 multisig with signatures out of the keys order",
+			unlocking: must(script.Unlock(sig(carol), sig(alice))),
+			locking:   must(script.Multisig(2, alice, bob, carol)),
+			wantErr:   true,
+		},
+		{
+			name:      "hash lock with the preimage",
+			unlocking: must(script.Unlock(sig(alice), preimage)),
+			locking:   must(script.HashLock(hash[:], alice)),
+		},
+		{
+			name:      "hash lock with a wrong preimage",
+			unlocking: must(script.Unlock(sig(alice), []byte("guess"))),
+			locking:   must(script.HashLock(hash[:], alice)),
+			wantErr:   true,
+		},
+		{
+			name:      "time lock reached",
+			unlocking: must(script.Unlock(sig(alice))),
+			locking:   must(script.TimeLock(100, alice)),
+			checker:   fakeChecker{lockTime: 100},
+		},
+		{
+			name:      "time lock not reached",
+			unlocking: must(script.Unlock(sig(alice))),
+			locking:   must(script.TimeLock(100, alice)),
+			checker:   fakeChecker{lockTime: 99},
+			wantErr:   true,
+		},
+		{
+			name:      "relative lock reached",
+			unlocking: must(script.Unlock(sig(alice))),
+			locking:   asm("10 OP_CHECKSEQUENCEVERIFY OP_DROP 0x" + hex.EncodeToString(alice) + " OP_CHECKSIG"),
+			checker:   fakeChecker{sequence: 10},
+		},
+		{
+			name:      "either branch of an if",
+			unlocking: asm("0x" + hex.EncodeToString(sig(bob)) + " 0"),
+			locking:   asm("OP_IF 0x" + hex.EncodeToString(alice) + " OP_ELSE 0x" + hex.EncodeToString(bob) + " OP_ENDIF OP_CHECKSIG"),
+		},
+		{
+			name:    "arithmetic",
+			locking: asm("2 3 OP_ADD 5 OP_NUMEQUAL"),
+		},
+		{
+			name:    "unbalanced if",
+			locking: asm("1 OP_IF 1"),
+			wantErr: true,
+		},
+		{
+			name:    "op return",
+			locking: asm("1 OP_RETURN"),
+			wantErr: true,
+		},
+		{
+			name:      "unlocking script with an opcode",
+			unlocking: asm("1 OP_DUP"),
+			locking:   asm("OP_EQUAL"),
+			wantErr:   true,
+		},
+		{
+			name:    "extra elements left on the stack",
+			locking: asm("1 1"),
+			wantErr: true,
+		},
+		{
+			name:    "step limit",
+			locking: asm("1" + strings.Repeat(" OP_DUP OP_DROP", script.MaxSteps)),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := script.Execute(tt.unlocking, tt.locking, tt.checker)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAssembleDisassemble(t *testing.T) {
+	hash := sha256.Sum256([]byte("secret"))
+	locking, err := script.HashLock(hash[:], []byte("alice"))
+	require.NoError(t, err)
+
+	text, err := script.Disassemble(locking)
+	require.NoError(t, err)
+	require.Equal(t, "OP_SHA256 0x"+hex.EncodeToString(hash[:])+" OP_EQUALVERIFY 0x"+hex.EncodeToString([]byte("alice"))+" OP_CHECKSIG", text)
+
+	assembled, err := script.Assemble(text)
+	require.NoError(t, err)
+	require.Equal(t, locking, assembled)
+
+	_, err = script.Assemble("OP_NOPE")
+	require.Error(t, err)
+}
+
+func TestHTLC(t *testing.T) {
+	preimage := []byte("secret")
+	hash := sha256.Sum256(preimage)
+	terms := script.HTLCTerms{Hash: hash[:], Receiver: []byte("bob"), Sender: []byte("alice"), Deadline: 1_700_000_000}
+	locking, err := script.HTLC(terms)
+	require.NoError(t, err)
+
+	parsed, err := script.ParseHTLC(locking)
+	require.NoError(t, err)
+	require.Equal(t, terms, *parsed)
+	_, err = script.ParseHTLC(append(locking, script.OP_DROP))
+	require.Error(t, err)
+
+	claim, err := script.HTLCClaim(sig([]byte("bob")), preimage)
+	require.NoError(t, err)
+	require.NoError(t, script.Execute(claim, locking, fakeChecker{}))
+	revealed, ok := script.HTLCPreimage(claim)
+	require.True(t, ok)
+	require.Equal(t, preimage, revealed)
+
+	refund, err := script.HTLCRefund(sig([]byte("alice")))
+	require.NoError(t, err)
+	require.Error(t, script.Execute(refund, locking, fakeChecker{lockTime: 1_699_999_999}))
+	require.NoError(t, script.Execute(refund, locking, fakeChecker{lockTime: 1_700_000_000}))
+	_, ok = script.HTLCPreimage(refund)
+	require.False(t, ok)
+}
+
+func TestEscrow(t *testing.T) {
+	buyer, seller, arbiter := []byte("alice"), []byte("bob"), []byte("carol")
+	terms := script.EscrowTerms{Buyer: buyer, Seller: seller, Arbiter: arbiter, Timeout: 1_700_000_000}
+	locking, err := script.Escrow(terms)
+	require.NoError(t, err)
+
+	parsed, err := script.ParseEscrow(locking)
+	require.NoError(t, err)
+	require.Equal(t, terms, *parsed)
+	_, err = script.ParseEscrow(append(locking, script.OP_DROP))
+	require.Error(t, err)
+	htlc, err := script.HTLC(script.HTLCTerms{Hash: make([]byte, 32), Receiver: seller, Sender: buyer, Deadline: 1})
+	require.NoError(t, err)
+	_, err = script.ParseEscrow(htlc)
+	require.Error(t, err)
+
+	for _, signers := range [][2][]byte{{buyer, seller}, {buyer, arbiter}, {seller, arbiter}} {
+		settle, err := script.EscrowSettle(sig(signers[0]), sig(signers[1]))
+		require.NoError(t, err)
+		require.NoError(t, script.Execute(settle, locking, fakeChecker{}))
+	}
+	// a party can't sign twice, nor can the signatures come out of the keys order
+	settle, err := script.EscrowSettle(sig(buyer), sig(buyer))
+	require.NoError(t, err)
+	require.Error(t, script.Execute(settle, locking, fakeChecker{}))
+	settle, err = script.EscrowSettle(sig(seller), sig(buyer))
+	require.NoError(t, err)
+	require.Error(t, script.Execute(settle, locking, fakeChecker{}))
+
+	refund, err := script.EscrowRefund(sig(buyer))
+	require.NoError(t, err)
+	require.Error(t, script.Execute(refund, locking, fakeChecker{lockTime: 1_699_999_999}))
+	require.NoError(t, script.Execute(refund, locking, fakeChecker{lockTime: 1_700_000_000}))
+	refund, err = script.EscrowRefund(sig(seller))
+	require.NoError(t, err)
+	require.Error(t, script.Execute(refund, locking, fakeChecker{lockTime: 1_700_000_000}))
+}
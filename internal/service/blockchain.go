@@ -1,8 +1,13 @@
 package service
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"math"
+	"slices"
 	"time"
 
 	"local-chain/internal/pkg/merkle"
@@ -11,6 +16,7 @@ import (
 
 	"local-chain/internal/types"
 
+	"github.com/google/uuid"
 	"github.com/hashicorp/raft"
 )
 
@@ -20,6 +26,7 @@ const (
 
 type BlockchainStore interface {
 	GetAll() (types.Blocks, error)
+	GetLast() (*types.Block, error)
 	Put(*types.Block) error
 }
 
@@ -33,8 +40,12 @@ type Blockchain struct {
 	raftApi          RaftAPI
 	blockchainStore  BlockchainStore
 	transactionStore TransactionStore
-	prevBlock        *types.Block
+	utxoStore        UTXOStore
+	orderStore       StandingOrderStore
 	txPool           TxPool
+	// feeCollector is the address the fees collected in a block are credited to
+	feeCollector []byte
+	maxBlockSize int
 }
 
 // NewBlockchain creates a new blockchain with a genesis block.
@@ -42,13 +53,21 @@ func NewBlockchain(
 	raftApi RaftAPI,
 	blockchainStore BlockchainStore,
 	txStore TransactionStore,
+	utxoStore UTXOStore,
+	orderStore StandingOrderStore,
 	txPool TxPool,
+	feeCollector []byte,
+	maxBlockSize int,
 ) *Blockchain {
 	b := &Blockchain{
 		raftApi:          raftApi,
 		blockchainStore:  blockchainStore,
 		transactionStore: txStore,
+		utxoStore:        utxoStore,
+		orderStore:       orderStore,
 		txPool:           txPool,
+		feeCollector:     feeCollector,
+		maxBlockSize:     maxBlockSize,
 	}
 	blocks, err := b.blockchainStore.GetAll()
 	if err != nil {
@@ -64,14 +83,6 @@ func NewBlockchain(
 			panic(err)
 		}
 	}
-	for _, block := range blocks {
-		if b.prevBlock == nil {
-			b.prevBlock = block
-		}
-		if b.prevBlock.Timestamp < block.Timestamp {
-			b.prevBlock = block
-		}
-	}
 
 	return b
 }
@@ -81,17 +92,34 @@ func (bc *Blockchain) CreateBlock(ctx context.Context) error {
 	if _, leaderID := bc.raftApi.LeaderWithID(); leaderID != pkg.ServerIDFromContext(ctx) {
 		return nil
 	}
-	txs := bc.txPool.GetPool().AsSlice()
+	prevBlock, err := bc.getCurrentBlock()
+	if err != nil {
+		return err
+	}
+	height := prevBlock.Height + 1
+	// the block gets a later timestamp, locks expired now are expired for the block too
+	now := uint64(time.Now().UnixNano())
+	// transactions spending outputs of other pending transactions must follow them in the block
+	txs, fees := bc.selectTxs(bc.txPool.Ordered(), height, now)
+	// standing orders due are paid after the pool transactions, from the outputs those don't spend
+	txs, err = bc.executeOrders(txs, now)
+	if err != nil {
+		return err
+	}
 	if len(txs) == 0 {
 		return nil
 	}
+	// the fees are those of the pool transactions, the payments of standing orders pay none
+	if fees > 0 {
+		txs = append(txs, types.NewFeeCollectorTx(fees, bc.feeCollector))
+	}
 
 	merkleTree, err := merkle.NewMerkleTree(txs...)
 	if err != nil {
 		return fmt.Errorf("failed to create merkle tree: %w", err)
 	}
 
-	block := types.NewBlock(bc.getCurrentBlock().ComputeHash(), merkleTree.Root.Hash)
+	block := types.NewBlock(height, prevBlock.ComputeHash(), merkleTree.Root.Hash)
 	blockTxsEnvelope := types.NewBlockTxsEnvelope(block, txs)
 	bytes, err := blockTxsEnvelope.ToBytes()
 	if err != nil {
@@ -107,31 +135,169 @@ func (bc *Blockchain) CreateBlock(ctx context.Context) error {
 	}
 	if response := future.Response(); response != nil {
 		if err, ok := response.(error); ok {
+			var validationErr *BlockValidationError
+			if errors.As(err, &validationErr) && validationErr.TxID != uuid.Nil && !errors.Is(err, types.ErrNonFinal) {
+				// every replica has evicted the offending transaction from its pool, the next block goes through
+				log.Printf("transaction %s was evicted from the pool: %v", validationErr.TxID, validationErr)
+				return nil
+			}
 			return fmt.Errorf("FSM failed to apply block: %w", err)
 		}
 	}
 
-	bc.prevBlock = block
-	bc.txPool.Purge()
-
 	return nil
 }
 
-func (bc *Blockchain) getCurrentBlock() *types.Block {
-	if bc.prevBlock != nil {
-		return bc.prevBlock
+// selectTxs takes transactions in the pool order until the block is full, leaving room for the fee collector.
+// A transaction that doesn't fit or isn't final at the height and the time yet is skipped together with
+// the transactions spending its outputs, it waits in the pool for a later block. So does a transaction whose fee
+// would overflow the fees of the block. The fees of the selected transactions are returned with them.
+func (bc *Blockchain) selectTxs(txs types.Transactions, height, timestamp uint64) (types.Transactions, uint64) {
+	space := bc.blockSpace()
+	skipped := make(map[uuid.UUID]struct{})
+	selected := make(map[uuid.UUID]struct{}, len(txs))
+	result := make(types.Transactions, 0, len(txs))
+	var fees uint64
+	for _, tx := range txs {
+		size := tx.Size()
+		if size > space || spendsFrom(tx, skipped) || !bc.isFinal(tx, height, timestamp, selected) {
+			skipped[tx.ID] = struct{}{}
+			continue
+		}
+		sum, err := types.AddValues(fees, tx.Fee)
+		if err != nil {
+			skipped[tx.ID] = struct{}{}
+			continue
+		}
+		fees = sum
+		space -= size
+		selected[tx.ID] = struct{}{}
+		result = append(result, tx)
 	}
-	blocks, err := bc.blockchainStore.GetAll()
+	return result, fees
+}
+
+// executeOrders appends the payments of the standing orders due at the time, paid from the payer's confirmed
+// outputs the block doesn't spend yet. A payment the payer can't afford is recorded as failed, a payment
+// that doesn't fit in the block waits for a later one.
+func (bc *Blockchain) executeOrders(txs types.Transactions, timestamp uint64) (types.Transactions, error) {
+	records, err := bc.orderStore.GetAll()
 	if err != nil {
-		panic(err)
+		return nil, fmt.Errorf("failed to get standing orders: %w", err)
+	}
+	space := bc.blockSpace()
+	spent := make(map[string]struct{})
+	for _, tx := range txs {
+		space -= tx.Size()
+		for _, in := range tx.Inputs {
+			spent[outpointKey(in.Prev)] = struct{}{}
+		}
 	}
-	var latestBlock *types.Block
-	for _, block := range blocks {
-		if latestBlock == nil || latestBlock.Timestamp < block.Timestamp {
-			latestBlock = block
+	for _, record := range records {
+		seq, due := record.Due(timestamp)
+		if !due {
+			continue
+		}
+		tx, err := bc.executeOrder(record.Order, seq, spent)
+		if err != nil {
+			return nil, err
+		}
+		if size := tx.Size(); size <= space {
+			space -= size
+			for _, in := range tx.Inputs {
+				spent[outpointKey(in.Prev)] = struct{}{}
+			}
+			txs = append(txs, tx)
 		}
 	}
-	bc.prevBlock = latestBlock
+	return txs, nil
+}
+
+// executeOrder creates payment seq of the order from the payer's outputs not spent in the block, largest first
+func (bc *Blockchain) executeOrder(order *types.StandingOrder, seq uint32, spent map[string]struct{}) (*types.Transaction, error) {
+	payer := types.AddressOf(order.Payer)
+	utxos, err := bc.utxoStore.GetByOwner(payer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the payer's outputs: %w", err)
+	}
+	utxos = slices.DeleteFunc(utxos, func(utxo *types.UnspentOutput) bool {
+		_, ok := spent[outpointKey(utxo.UTXO)]
+		return ok || !utxo.Output.IsPaymentOutput(payer)
+	})
+	slices.SortFunc(utxos, func(a, b *types.UnspentOutput) int {
+		return cmp.Compare(b.Output.Amount.Value, a.Output.Amount.Value)
+	})
+
+	tx := types.NewOrderExecutionTx(order, seq)
+	var value uint64
+	for _, utxo := range utxos {
+		if value >= order.Amount.Value {
+			break
+		}
+		tx.AddInput(types.NewTxIn(utxo.UTXO, order.Payer, nil, nil, types.SequenceFinal))
+		value += utxo.Output.Amount.Value
+	}
+	if value < order.Amount.Value {
+		failure := fmt.Sprintf("insufficient funds: %d of %d available", value, order.Amount.Value)
+		return types.NewOrderFailureTx(order, seq, failure), nil
+	}
+	tx.AddOutput(types.NewTxOut(tx.ID, order.Amount, order.Receiver))
+	if change := value - order.Amount.Value; change > 0 {
+		tx.AddOutput(types.NewTxOut(tx.ID, types.Amount{Value: change, Unit: order.Amount.Unit}, payer))
+	}
+	tx.ComputeHash()
+	return tx, nil
+}
+
+// blockSpace is the size left for the transactions of a block once the fee collector is in
+func (bc *Blockchain) blockSpace() int {
+	if bc.maxBlockSize <= 0 {
+		return math.MaxInt
+	}
+	return bc.maxBlockSize - types.NewFeeCollectorTx(math.MaxUint64, bc.feeCollector).Size()
+}
+
+// isFinal checks the transaction's locks as the validator will: outputs of the transactions selected
+// for the block are confirmed by the block itself
+func (bc *Blockchain) isFinal(tx *types.Transaction, height, timestamp uint64, selected map[uuid.UUID]struct{}) bool {
+	err := types.CheckFinal(tx, height, timestamp, func(prev *types.UTXO) (uint64, uint64, error) {
+		if _, ok := selected[prev.TxID]; ok {
+			return height, timestamp, nil
+		}
+		utxo, err := bc.utxoStore.Get(prev)
+		if err != nil {
+			return 0, 0, err
+		}
+		if utxo == nil {
+			return 0, 0, fmt.Errorf("output %s:%d does not exist", prev.TxID, prev.Index)
+		}
+		return utxo.Height, utxo.Timestamp, nil
+	})
+	return err == nil
+}
+
+func spendsFrom(tx *types.Transaction, txIDs map[uuid.UUID]struct{}) bool {
+	for _, in := range tx.Inputs {
+		if in.Prev == nil {
+			continue
+		}
+		if _, ok := txIDs[in.Prev.TxID]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// getCurrentBlock reads the latest block from the store: blocks are applied by the FSM on every replica,
+// so a newly elected leader continues the chain from where the previous one stopped
+func (bc *Blockchain) getCurrentBlock() (*types.Block, error) {
+	block, err := bc.blockchainStore.GetLast()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the latest block: %w", err)
+	}
+	if block == nil {
+		return nil, errors.New("blockchain has no blocks")
+	}
 
-	return latestBlock
+	return block, nil
 }
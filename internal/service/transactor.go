@@ -1,21 +1,25 @@
 package service
 
 import (
-	"crypto/ecdsa"
+	"bytes"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"local-chain/internal/pkg/merkle"
-	"math/big"
+	"maps"
+	"slices"
+	"time"
 
-	"local-chain/internal/adapters/outbound/inMem"
+	"local-chain/internal/pkg/coinselect"
 	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/pkg/script"
 
 	"local-chain/internal/types"
 
 	"github.com/google/uuid"
 )
 
-//go:generate mockgen --build_flags=--mod=mod -destination transactor_mock_test.go -package service_test . TransactionStore,BStore,UTXOStore,TxPool,UserStore,BlockTxStore,Store
+//go:generate mockgen --build_flags=--mod=mod -destination transactor_mock_test.go -package service_test . TransactionStore,BStore,UTXOStore,TxPool,UserStore,BlockTxStore,AssetStore,EscrowStore,StandingOrderStore,Store,RaftAPI,KeyStore,Sweeper,StandingOrderLister
 
 type Store interface {
 	Transaction() TransactionStore
@@ -23,10 +27,15 @@ type Store interface {
 	Utxo() UTXOStore
 	User() UserStore
 	BlockTransactions() BlockTxStore
+	Asset() AssetStore
+	Escrow() EscrowStore
+	StandingOrder() StandingOrderStore
 }
 
 type TransactionStore interface {
 	Get(id uuid.UUID) (*types.Transaction, error)
+	Has(id uuid.UUID) (bool, error)
+	GetByData(data []byte) (*types.Transaction, error)
 	Put(*types.Transaction) error
 }
 
@@ -35,36 +44,81 @@ type BlockTxStore interface {
 	GetByBlockTimestamp(t uint64) (types.Transactions, error)
 }
 
+type AssetStore interface {
+	Get(id []byte) (*types.Asset, error)
+	GetAll() ([]*types.Asset, error)
+	Put(asset *types.Asset) error
+}
+
+type EscrowStore interface {
+	GetByParticipant(pubKey []byte) ([]*types.Escrow, error)
+	Put(escrow *types.Escrow) error
+}
+
+type StandingOrderStore interface {
+	Get(id []byte) (*types.StandingOrderRecord, error)
+	GetAll() ([]*types.StandingOrderRecord, error)
+	Put(record *types.StandingOrderRecord) error
+}
+
 type BStore interface {
 	GetAll() (types.Blocks, error)
 	GetByTimestamp(t uint64) (*types.Block, error)
+	GetLast() (*types.Block, error)
 	Put(block *types.Block) error
 	GetKeys() ([]uint64, error)
 	Delete() error
 }
 
 type UTXOStore interface {
-	Get(pubKey []byte) ([]*types.UTXO, error)
-	Put(pubKey []byte, utxos ...*types.UTXO) error
+	Get(outpoint *types.UTXO) (*types.UnspentOutput, error)
+	GetByOwner(owner []byte) ([]*types.UnspentOutput, error)
+	Apply(txs ...*types.Transaction) error
 }
 
+// TxPool is a read-only view of the pending transactions: the pool is changed only by the FSM
+// when transaction and block envelopes are applied, so every replica holds the same mempool
 type TxPool interface {
-	GetPool() inMem.Pool
-	Purge()
-	AddTx(tx *types.Transaction) error
-	GetUTXOs(pubKey []byte) types.UTXOs
-	AddUtxos(pubKey []byte, utxos ...*types.UTXO)
+	Get(id uuid.UUID) (*types.Transaction, bool)
+	Ordered() types.Transactions
+	GetUTXOs(owner []byte) []*types.UnspentOutput
+	IsSpent(outpoint *types.UTXO) bool
+	Spender(outpoint *types.UTXO) (*types.Transaction, bool)
 }
 
 type Transactor struct {
-	store  Store
-	txPool TxPool
+	store   Store
+	txPool  TxPool
+	raftApi RaftAPI
+	chainID string
+	// minRelayFee is the lowest fee rate per kilobyte of transactions accepted into the pool
+	minRelayFee  uint64
+	coinSelector coinselect.Selector
+	// minters are the public keys authorized to mint tokens
+	minters [][]byte
+	// keys seals the seeds of the wallets, the keystore is local to the node
+	keys KeyStore
 }
 
-func NewTransactor(store Store, txPool TxPool) *Transactor {
+func NewTransactor(
+	store Store,
+	txPool TxPool,
+	raftApi RaftAPI,
+	chainID string,
+	minRelayFee uint64,
+	coinSelector coinselect.Selector,
+	minters [][]byte,
+	keys KeyStore,
+) *Transactor {
 	return &Transactor{
-		store:  store,
-		txPool: txPool,
+		store:        store,
+		txPool:       txPool,
+		raftApi:      raftApi,
+		chainID:      chainID,
+		minRelayFee:  minRelayFee,
+		coinSelector: coinSelector,
+		minters:      minters,
+		keys:         keys,
 	}
 }
 
@@ -73,48 +127,971 @@ func NewTransactor(store Store, txPool TxPool) *Transactor {
 // of the new transaction, since it is impossible to sign the inputs of the new transaction without having the
 // private key corresponding to the public key.
 func (t *Transactor) CreateTx(txReq *types.TransactionRequest) (*types.Transaction, error) {
-	receiverPub := crypto.PublicKeyToBytes(txReq.Receiver)
-	senderPub := crypto.PublicKeyToBytes(&txReq.Sender.PublicKey)
-	newTx := types.NewTransaction()
-	balance, err := t.getBalance(
-		txReq.Sender,
-		func(utxo *types.UTXO, pubKey []byte, r, s *big.Int, id uint32) {
-			newTx.AddInput(types.NewTxIn(utxo, pubKey, r, s, id))
-		},
-	)
+	return t.CreateBatchTx(&types.BatchTransactionRequest{
+		Sender:   txReq.Sender,
+		Payments: []types.Payment{{Receiver: txReq.Receiver, Amount: txReq.Amount}},
+		Fee:      txReq.Fee,
+		LockTime: txReq.LockTime,
+	})
+}
+
+// CreateBatchTx creates one transaction with an output per payment, in the request order, and the change output last.
+// Inputs are picked by the coin selector, so only as many of the sender's outputs are spent as the payments need.
+// A transaction with a lock time waits in the pool until the lock expires.
+func (t *Transactor) CreateBatchTx(txReq *types.BatchTransactionRequest) (*types.Transaction, error) {
+	senderPub := crypto.PublicKeyToBytes(txReq.Sender.Public())
+	utxos, err := t.getOwnedUTXOs(txReq.Sender.Public())
 	if err != nil {
 		return nil, fmt.Errorf("error getting balance : %v", err)
 	}
+	newTx, prevouts, err := t.buildTx(utxos, txReq.Payments, txReq.Fee, txReq.LockTime, senderPub,
+		func(id uuid.UUID, change types.Amount) *types.TxOut {
+			// change goes back to the sender
+			return types.NewTxOut(id, change, crypto.PublicKeyHash(txReq.Sender.Public()))
+		})
+	if err != nil {
+		return nil, err
+	}
 
-	if balance.Value < txReq.Amount.Value {
-		return nil, errors.New("insufficient balance")
+	if err = t.signAndAdd(newTx, prevouts, txReq.Sender); err != nil {
+		return nil, err
+	}
+	return newTx, nil
+}
+
+// AddWallet stores the user of a wallet created or restored from its seed, the keystore seals the seed under
+// the passphrase. The keys past the used ones are looked up on the chain until WalletGap keys in a row own
+// no outputs, so a restored wallet finds the funds of its keys.
+func (t *Transactor) AddWallet(user *types.User, seed []byte, passphrase string) error {
+	if user.Wallet == nil {
+		return errors.New("user has no wallet")
+	}
+	for _, chain := range []struct {
+		chain uint32
+		used  *uint32
+	}{{types.ReceiveChain, &user.Wallet.Receive}, {types.ChangeChain, &user.Wallet.Change}} {
+		for index, unused := *chain.used, 0; unused < types.WalletGap; index++ {
+			key, err := user.Wallet.PublicKey(chain.chain, index)
+			if err != nil {
+				return err
+			}
+			utxos, err := t.getUTXOs(crypto.PublicKeyHash(key))
+			if err != nil {
+				return err
+			}
+			if len(utxos) == 0 {
+				unused++
+				continue
+			}
+			*chain.used, unused = index+1, 0
+		}
+	}
+	if err := t.keys.Store(user.Username, seed, passphrase); err != nil {
+		return fmt.Errorf("error storing seed : %w", err)
+	}
+	if err := applyUser(t.raftApi, types.UserOpCreate, user); err != nil {
+		err = fmt.Errorf("error adding user : %v", err)
+		if deleteErr := t.keys.Delete(user.Username); deleteErr != nil {
+			return errors.Join(err, deleteErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// CreateWalletTx creates a batch transaction paid from the outputs of every used key of the user's wallet,
+// each input is signed by the key owning the output it spends. The keys are derived from the seed, which must be
+// unlocked. The change goes to a fresh change key.
+func (t *Transactor) CreateWalletTx(req *types.WalletTransactionRequest) (*types.Transaction, error) {
+	user, err := t.store.User().Get(req.Username)
+	if err != nil {
+		return nil, fmt.Errorf("error getting user : %v", err)
+	}
+	if !user.Active() {
+		return nil, fmt.Errorf("sender %s is %s", user.Username, user.Status)
+	}
+	wallet := user.Wallet
+	if wallet == nil {
+		return nil, errors.New("user has no wallet")
+	}
+	seed, err := unlockedKey(t.keys, user.Username)
+	if err != nil {
+		return nil, err
+	}
+	keys, err := wallet.Keys(seed)
+	if err != nil {
+		return nil, err
+	}
+	var utxos []*types.UnspentOutput
+	owners := make(map[string][]byte, len(keys))
+	signers := make([]crypto.Signer, 0, len(keys))
+	for _, key := range keys {
+		owned, err := t.getOwnedUTXOs(&key.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("error getting balance : %v", err)
+		}
+		utxos = append(utxos, owned...)
+		owners[string(crypto.PublicKeyHash(&key.PublicKey))] = crypto.PublicKeyToBytes(&key.PublicKey)
+		signers = append(signers, key)
+	}
+	changeKey, err := wallet.PublicKey(types.ChangeChain, wallet.Change)
+	if err != nil {
+		return nil, err
+	}
+	changed := false
+	newTx, prevouts, err := t.buildTx(utxos, req.Payments, req.Fee, req.LockTime, nil,
+		func(id uuid.UUID, change types.Amount) *types.TxOut {
+			changed = true
+			return types.NewTxOut(id, change, crypto.PublicKeyHash(changeKey))
+		})
+	if err != nil {
+		return nil, err
+	}
+	for i, in := range newTx.Inputs {
+		in.PubKey = owners[string(prevouts[i].Owner())]
+	}
+
+	if err = t.signAndAdd(newTx, prevouts, signers...); err != nil {
+		return nil, err
+	}
+	if changed {
+		wallet.Change++
+		if err = applyUser(t.raftApi, types.UserOpUpdate, user); err != nil {
+			return nil, fmt.Errorf("error updating user : %v", err)
+		}
+	}
+	return newTx, nil
+}
+
+// checkSender refuses the key of a user that isn't active as a sender, keys of no user are accepted
+func (t *Transactor) checkSender(sender crypto.PublicKey) error {
+	user, err := t.store.User().GetByAddress(crypto.PublicKeyHash(sender))
+	if err != nil {
+		return fmt.Errorf("error getting user : %v", err)
+	}
+	if user != nil && !user.Active() {
+		return fmt.Errorf("sender %s is %s", user.Username, user.Status)
+	}
+	return nil
+}
+
+// checkSigners refuses the keys of users that aren't active as signers
+func (t *Transactor) checkSigners(keys ...crypto.Signer) error {
+	for _, key := range keys {
+		if err := t.checkSender(key.Public()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkInputKeys refuses a transaction signed by a key of a user that isn't active: the key of every signed input
+// and the keys of the signatures of a multisig input. The keys signing a script input are named by the script,
+// the node checks them when it signs a contract spend.
+func (t *Transactor) checkInputKeys(tx *types.Transaction) error {
+	checked := make(map[string]struct{})
+	for i, in := range tx.Inputs {
+		keys := [][]byte{in.PubKey}
+		for _, sig := range in.Signatures {
+			keys = append(keys, sig.PubKey)
+		}
+		for _, key := range keys {
+			if _, ok := checked[string(key)]; ok || len(key) == 0 {
+				continue
+			}
+			checked[string(key)] = struct{}{}
+			pubKey, err := crypto.PublicKeyFromBytes(key)
+			if err != nil {
+				return fmt.Errorf("input %d: %w", i, err)
+			}
+			if err = t.checkSender(pubKey); err != nil {
+				return fmt.Errorf("input %d: %w", i, err)
+			}
+		}
+	}
+	return nil
+}
+
+// SweepTx creates a transaction moving every output the sender's key owns to the receiver: one output of the native
+// coin less the fee, one per asset and one per token. It returns a nil transaction if the key owns nothing.
+func (t *Transactor) SweepTx(req *types.SweepRequest) (*types.Transaction, error) {
+	senderPub := crypto.PublicKeyToBytes(req.Sender.Public())
+	utxos, err := t.getOwnedUTXOs(req.Sender.Public())
+	if err != nil {
+		return nil, fmt.Errorf("error getting balance : %v", err)
+	}
+	if len(utxos) == 0 {
+		return nil, nil
 	}
 
-	newTx.AddOutput(types.NewTxOut(newTx.ID, txReq.Amount, receiverPub))
-	balance.Value -= txReq.Amount.Value
-	// output contains actual sender balance
-	newTx.AddOutput(types.NewTxOut(newTx.ID, *balance, senderPub))
+	newTx := types.NewTransaction()
+	prevouts := make([]*types.TxOut, 0, len(utxos))
+	native := types.NewAmount(0)
+	// assets keep the order they are met in
+	var assetIDs [][]byte
+	assets := make(map[string]*types.Amount)
+	var tokens []*types.TxOut
+	for _, utxo := range utxos {
+		out := utxo.Output
+		switch {
+		case out.IsToken():
+			token := &types.Token{ID: out.TokenID, MetadataHash: out.MetadataHash}
+			tokens = append(tokens, types.NewTokenTxOut(newTx.ID, token, req.Receiver))
+		case out.IsAsset():
+			amount, ok := assets[string(out.AssetID)]
+			if !ok {
+				amount = types.NewAmount(0)
+				assets[string(out.AssetID)] = amount
+				assetIDs = append(assetIDs, out.AssetID)
+			}
+			if amount.Value, err = types.AddValues(amount.Value, out.Amount.Value); err != nil {
+				return nil, fmt.Errorf("asset %x: %w", out.AssetID, err)
+			}
+			amount.Unit = out.Amount.Unit
+		default:
+			if native.Value, err = types.AddValues(native.Value, out.Amount.Value); err != nil {
+				return nil, err
+			}
+			// assume all outputs have the same unit
+			native.Unit = out.Amount.Unit
+		}
+		newTx.AddInput(types.NewTxIn(utxo.UTXO, senderPub, nil, nil, types.SequenceFinal))
+		prevouts = append(prevouts, out)
+	}
+	if native.Value < req.Fee {
+		return nil, errors.New("insufficient balance")
+	}
+	if native.Value -= req.Fee; native.Value > 0 {
+		newTx.AddOutput(types.NewTxOut(newTx.ID, *native, req.Receiver))
+	}
+	for _, assetID := range assetIDs {
+		out := types.NewTxOut(newTx.ID, *assets[string(assetID)], req.Receiver)
+		out.AssetID = assetID
+		newTx.AddOutput(out)
+	}
+	for _, out := range tokens {
+		newTx.AddOutput(out)
+	}
+	newTx.Fee = req.Fee
 	newTx.ComputeHash()
 
-	t.txPool.AddUtxos(senderPub, types.NewUTXO(newTx.ID, newTx.GetHash(), 1))
-	t.txPool.AddUtxos(receiverPub, types.NewUTXO(newTx.ID, newTx.GetHash(), 0))
-	if err = t.txPool.AddTx(newTx); err != nil {
-		return nil, fmt.Errorf("error adding tx to pool : %v", err)
+	if err = t.signAndAdd(newTx, prevouts, req.Sender); err != nil {
+		return nil, err
+	}
+	return newTx, nil
+}
+
+// IssueAsset creates the issuance transaction of a new asset: the whole supply is minted to the issuer
+// and the fee is paid with the issuer's native coin. The asset ID is derived from the transaction.
+func (t *Transactor) IssueAsset(req *types.AssetIssueRequest) (*types.Transaction, error) {
+	issuerPub := crypto.PublicKeyToBytes(req.Issuer.Public())
+	utxos, err := t.getOwnedUTXOs(req.Issuer.Public())
+	if err != nil {
+		return nil, fmt.Errorf("error getting balance : %v", err)
 	}
 
+	newTx := types.NewTransaction()
+	newTx.Issuance = &types.Asset{
+		ID:       types.NewAssetID(newTx.ID, req.Name),
+		Name:     req.Name,
+		Decimals: req.Decimals,
+		Supply:   req.Supply,
+		Issuer:   issuerPub,
+	}
+	supply := types.NewTxOut(newTx.ID, types.Amount{Value: req.Supply}, crypto.PublicKeyHash(req.Issuer.Public()))
+	supply.AssetID = newTx.Issuance.ID
+	newTx.AddOutput(supply)
+	prevouts, err := t.payFee(newTx, utxos, req.Fee, issuerPub)
+	if err != nil {
+		return nil, err
+	}
+	if err = types.CheckValues(newTx, prevouts); err != nil {
+		return nil, err
+	}
+
+	if err = t.signAndAdd(newTx, prevouts, req.Issuer); err != nil {
+		return nil, err
+	}
 	return newTx, nil
 }
 
-func (t *Transactor) GetBalance(req *types.BalanceRequest) (*types.Amount, error) {
-	balance, err := t.getBalance(req.Sender, nil)
+// MintToken creates the mint transaction of a new token paying it to the owner, the minter must be authorized
+// and pays the fee with the native coin. The token ID is derived from the transaction.
+func (t *Transactor) MintToken(req *types.TokenMintRequest) (*types.Transaction, error) {
+	minterPub := crypto.PublicKeyToBytes(req.Minter.Public())
+	if !slices.ContainsFunc(t.minters, func(minter []byte) bool { return bytes.Equal(minter, minterPub) }) {
+		return nil, errors.New("token minter is not authorized")
+	}
+	owner := req.Owner
+	if owner == nil {
+		owner = crypto.PublicKeyHash(req.Minter.Public())
+	}
+	utxos, err := t.getOwnedUTXOs(req.Minter.Public())
 	if err != nil {
 		return nil, fmt.Errorf("error getting balance : %v", err)
 	}
-	return balance, nil
+
+	newTx := types.NewTransaction()
+	newTx.Mint = &types.Token{
+		ID:           types.NewTokenID(newTx.ID, req.MetadataHash),
+		MetadataHash: req.MetadataHash,
+		Minter:       minterPub,
+	}
+	newTx.AddOutput(types.NewTokenTxOut(newTx.ID, newTx.Mint, owner))
+	prevouts, err := t.payFee(newTx, utxos, req.Fee, minterPub)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkValues(newTx, prevouts, t.minters); err != nil {
+		return nil, err
+	}
+
+	if err = t.signAndAdd(newTx, prevouts, req.Minter); err != nil {
+		return nil, err
+	}
+	return newTx, nil
 }
 
-func (t *Transactor) VerifyTx(txID uuid.UUID) (*types.Transaction, error) {
-	tx, err := t.store.Transaction().Get(txID)
+// TransferToken moves a token of the owner to the receiver, the owner pays the fee with the native coin.
+func (t *Transactor) TransferToken(req *types.TokenTransferRequest) (*types.Transaction, error) {
+	ownerPub := crypto.PublicKeyToBytes(req.Owner.Public())
+	utxos, err := t.getOwnedUTXOs(req.Owner.Public())
+	if err != nil {
+		return nil, fmt.Errorf("error getting balance : %v", err)
+	}
+	i := slices.IndexFunc(utxos, func(utxo *types.UnspentOutput) bool { return bytes.Equal(utxo.Output.TokenID, req.TokenID) })
+	if i < 0 {
+		return nil, fmt.Errorf("token %x is not owned by the sender", req.TokenID)
+	}
+	tokenUTXO := utxos[i]
+
+	newTx := types.NewTransaction()
+	newTx.AddInput(types.NewTxIn(tokenUTXO.UTXO, ownerPub, nil, nil, types.SequenceFinal))
+	token := &types.Token{ID: tokenUTXO.Output.TokenID, MetadataHash: tokenUTXO.Output.MetadataHash}
+	newTx.AddOutput(types.NewTokenTxOut(newTx.ID, token, req.Receiver))
+	prevouts, err := t.payFee(newTx, utxos, req.Fee, ownerPub)
+	if err != nil {
+		return nil, err
+	}
+	prevouts = append([]*types.TxOut{tokenUTXO.Output}, prevouts...)
+
+	if err = t.signAndAdd(newTx, prevouts, req.Owner); err != nil {
+		return nil, err
+	}
+	return newTx, nil
+}
+
+// GetToken finds the token's mint and every transfer of it in the confirmed blocks, oldest first.
+// The owner of the last transfer is the current owner.
+func (t *Transactor) GetToken(tokenID []byte) (*types.TokenProvenance, error) {
+	keys, err := t.store.Blockchain().GetKeys()
+	if err != nil {
+		return nil, fmt.Errorf("error getting block keys : %v", err)
+	}
+	slices.Sort(keys)
+	provenance := &types.TokenProvenance{}
+	for _, key := range keys {
+		txs, err := t.store.BlockTransactions().GetByBlockTimestamp(key)
+		if err != nil {
+			return nil, fmt.Errorf("error getting block transactions : %v", err)
+		}
+		for _, tx := range txs {
+			if tx.Mint != nil && bytes.Equal(tx.Mint.ID, tokenID) {
+				provenance.Token = tx.Mint
+			}
+			i := slices.IndexFunc(tx.Outputs, func(out *types.TxOut) bool { return bytes.Equal(out.TokenID, tokenID) })
+			if i < 0 {
+				continue
+			}
+			// transactions are stored with the block before their block height is set
+			block, err := t.store.Blockchain().GetByTimestamp(key)
+			if err != nil {
+				return nil, fmt.Errorf("error getting block : %v", err)
+			}
+			if block == nil {
+				return nil, fmt.Errorf("block %d not found", key)
+			}
+			provenance.History = append(provenance.History, types.TokenTransfer{
+				TxID:           tx.ID,
+				Owner:          tx.Outputs[i].Owner(),
+				BlockHeight:    block.Height,
+				BlockTimestamp: block.Timestamp,
+			})
+		}
+	}
+	if provenance.Token == nil {
+		return nil, fmt.Errorf("token %x not found", tokenID)
+	}
+	provenance.Owner = provenance.History[len(provenance.History)-1].Owner
+	return provenance, nil
+}
+
+// CreateStandingOrder creates the transaction of a standing order of the payer: once confirmed, the block producer
+// pays the receiver from the payer's outputs whenever a payment is due. The payer pays the fee with the native coin.
+func (t *Transactor) CreateStandingOrder(req *types.StandingOrderRequest) (*types.Transaction, error) {
+	if req.Receiver == nil {
+		return nil, errors.New("receiver must be provided")
+	}
+	payerPub := crypto.PublicKeyToBytes(req.Payer.Public())
+	utxos, err := t.getOwnedUTXOs(req.Payer.Public())
+	if err != nil {
+		return nil, fmt.Errorf("error getting balance : %v", err)
+	}
+
+	newTx := types.NewTransaction()
+	newTx.StandingOrder = &types.StandingOrder{
+		ID:          types.NewStandingOrderID(newTx.ID),
+		Payer:       payerPub,
+		Receiver:    req.Receiver,
+		Amount:      req.Amount,
+		Start:       req.Start,
+		Interval:    req.Interval,
+		MaxPayments: req.MaxPayments,
+		End:         req.End,
+	}
+	if newTx.StandingOrder.Start == 0 {
+		newTx.StandingOrder.Start = uint64(time.Now().Unix())
+	}
+	prevouts, err := t.payFee(newTx, utxos, req.Fee, payerPub)
+	if err != nil {
+		return nil, err
+	}
+	if err = types.CheckStandingOrder(newTx, prevouts); err != nil {
+		return nil, err
+	}
+
+	if err = t.signAndAdd(newTx, prevouts, req.Payer); err != nil {
+		return nil, err
+	}
+	return newTx, nil
+}
+
+// CancelStandingOrder creates the transaction cancelling a standing order of the payer, no payment is due
+// after it is confirmed. The payer pays the fee with the native coin.
+func (t *Transactor) CancelStandingOrder(req *types.StandingOrderCancelRequest) (*types.Transaction, error) {
+	if len(req.OrderID) == 0 {
+		return nil, errors.New("order ID must be provided")
+	}
+	payerPub := crypto.PublicKeyToBytes(req.Payer.Public())
+	utxos, err := t.getOwnedUTXOs(req.Payer.Public())
+	if err != nil {
+		return nil, fmt.Errorf("error getting balance : %v", err)
+	}
+
+	newTx := types.NewTransaction()
+	newTx.CancelOrder = req.OrderID
+	prevouts, err := t.payFee(newTx, utxos, req.Fee, payerPub)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkStandingOrders(t.store, newTx, prevouts); err != nil {
+		return nil, err
+	}
+
+	if err = t.signAndAdd(newTx, prevouts, req.Payer); err != nil {
+		return nil, err
+	}
+	return newTx, nil
+}
+
+// ListStandingOrders lists the confirmed standing orders the key pays or receives, cancelled and ended ones
+// included, each with the audit records of its payments.
+func (t *Transactor) ListStandingOrders(pubKey []byte) ([]*types.StandingOrderRecord, error) {
+	records, err := t.store.StandingOrder().GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("error getting standing orders : %v", err)
+	}
+	address := types.AddressOf(pubKey)
+	var listed []*types.StandingOrderRecord
+	for _, record := range records {
+		if bytes.Equal(record.Order.Payer, pubKey) || bytes.Equal(record.Order.Receiver, address) {
+			listed = append(listed, record)
+		}
+	}
+	return listed, nil
+}
+
+// payFee adds inputs spending native outputs of the key worth the fee and the change output going back to the key.
+// The owner signs a transaction by spending an output, so a transaction without inputs gets one even without a fee.
+// The outputs spent by the added inputs are returned in the input order.
+func (t *Transactor) payFee(newTx *types.Transaction, utxos []*types.UnspentOutput, fee uint64, pubKey []byte) ([]*types.TxOut, error) {
+	newTx.Fee = fee
+	if fee == 0 && len(newTx.Inputs) > 0 {
+		newTx.ComputeHash()
+		return nil, nil
+	}
+	selected, err := t.coinSelector.Select(assetUTXOs(utxos, nil), max(fee, 1))
+	if errors.Is(err, coinselect.ErrInsufficientFunds) {
+		return nil, errors.New("insufficient balance")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error selecting inputs : %v", err)
+	}
+	prevouts := make([]*types.TxOut, 0, len(selected))
+	change := types.NewAmount(0)
+	for _, utxo := range selected {
+		newTx.AddInput(types.NewTxIn(utxo.UTXO, pubKey, nil, nil, types.SequenceFinal))
+		prevouts = append(prevouts, utxo.Output)
+		change.Value += utxo.Output.Amount.Value
+		change.Unit = utxo.Output.Amount.Unit
+	}
+	if change.Value -= fee; change.Value > 0 {
+		newTx.AddOutput(types.NewTxOut(newTx.ID, *change, types.AddressOf(pubKey)))
+	}
+	newTx.ComputeHash()
+	return prevouts, nil
+}
+
+// signAndAdd signs the inputs of a transaction built by the node with the keys owning them and puts it into the pool,
+// prevouts holds the outputs the inputs spend, in the input order. Every transaction the node builds is signed here,
+// so a key of a user that isn't active signs none.
+func (t *Transactor) signAndAdd(newTx *types.Transaction, prevouts []*types.TxOut, keys ...crypto.Signer) error {
+	if err := t.checkSigners(keys...); err != nil {
+		return err
+	}
+	// inputs are signed once all outputs are in place: the signature commits to the whole transaction
+	for _, key := range keys {
+		if err := newTx.SignInputs(key, t.chainID); err != nil {
+			return fmt.Errorf("error signing transaction : %v", err)
+		}
+	}
+	spentOutputs := make(map[*types.UTXO]*types.TxOut, len(prevouts))
+	for i, in := range newTx.Inputs {
+		spentOutputs[in.Prev] = prevouts[i]
+	}
+	if err := types.VerifySignatures(newTx, t.chainID, func(prev *types.UTXO) (*types.TxOut, error) {
+		return spentOutputs[prev], nil
+	}); err != nil {
+		return fmt.Errorf("can not verify transaction, not valid private key: %v", err)
+	}
+	if err := t.checkRelayFee(newTx); err != nil {
+		return err
+	}
+
+	if err := t.addTx(newTx); err != nil {
+		return fmt.Errorf("error adding tx to pool : %v", err)
+	}
+	return nil
+}
+
+// CreateMultisigTx creates a transaction spending outputs of the multisig lock, the change goes back to the lock.
+// The node holds none of the lock's keys: the transaction is returned unsigned, together with the outputs it spends,
+// for the key holders to sign and submit once the threshold of signatures is collected.
+func (t *Transactor) CreateMultisigTx(txReq *types.MultisigTransactionRequest) (*types.PartiallySignedTx, error) {
+	if err := txReq.Lock.Check(); err != nil {
+		return nil, err
+	}
+	utxos, err := t.getUTXOs(txReq.Lock.Owner())
+	if err != nil {
+		return nil, fmt.Errorf("error getting balance : %v", err)
+	}
+	newTx, prevouts, err := t.buildTx(utxos, txReq.Payments, txReq.Fee, txReq.LockTime, nil,
+		func(id uuid.UUID, change types.Amount) *types.TxOut {
+			return types.NewMultisigTxOut(id, change, txReq.Lock)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return types.NewPartiallySignedTx(newTx, prevouts), nil
+}
+
+// SubmitPartiallySignedTx submits a transaction whose signers have signed it all.
+func (t *Transactor) SubmitPartiallySignedTx(psbt *types.PartiallySignedTx) (*types.Transaction, error) {
+	if err := psbt.Complete(); err != nil {
+		return nil, fmt.Errorf("invalid transaction: %w", err)
+	}
+	return t.SubmitTx(psbt.Tx)
+}
+
+// CreateHTLC locks the amount in a hash-time-locked contract output, the first output of the transaction.
+// Both ledgers of an atomic swap lock the same hash, the side locked first gets the later deadline.
+func (t *Transactor) CreateHTLC(req *types.HTLCRequest) (*types.Transaction, error) {
+	if len(req.Hash) != sha256.Size {
+		return nil, fmt.Errorf("hash must be a %d bytes SHA-256 hash", sha256.Size)
+	}
+	if req.Receiver == nil {
+		return nil, errors.New("receiver must be provided")
+	}
+	if req.Deadline == 0 {
+		return nil, errors.New("deadline must be provided")
+	}
+	lockingScript, err := script.HTLC(script.HTLCTerms{
+		Hash:     req.Hash,
+		Receiver: crypto.PublicKeyToBytes(req.Receiver),
+		Sender:   crypto.PublicKeyToBytes(req.Sender.Public()),
+		Deadline: req.Deadline,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return t.CreateBatchTx(&types.BatchTransactionRequest{
+		Sender:   req.Sender,
+		Payments: []types.Payment{{Script: lockingScript, Amount: req.Amount}},
+		Fee:      req.Fee,
+	})
+}
+
+// ClaimHTLC pays the contract output to the receiver, the claiming transaction reveals the preimage.
+// The script lets the receiver claim as long as the sender hasn't refunded, but the node refuses claims
+// after the deadline: by then the sender may already be refunding.
+func (t *Transactor) ClaimHTLC(req *types.HTLCClaimRequest) (*types.Transaction, error) {
+	output, terms, err := t.htlcOutput(req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(terms.Receiver, crypto.PublicKeyToBytes(req.Receiver.Public())) {
+		return nil, errors.New("claimer is not the receiver of the contract")
+	}
+	if !bytes.Equal(types.HashPreimage(req.Preimage), terms.Hash) {
+		return nil, errors.New("preimage does not match the contract hash")
+	}
+	passed, err := t.deadlinePassed(terms.Deadline)
+	if err != nil {
+		return nil, err
+	}
+	if passed {
+		return nil, fmt.Errorf("contract deadline %d has passed, it can only be refunded", terms.Deadline)
+	}
+	if err = t.checkSigners(req.Receiver); err != nil {
+		return nil, err
+	}
+	tx, err := t.spendContract(req.Outpoint, output, req.Receiver.Public(), req.Fee, 0, types.SequenceFinal)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := tx.ScriptSignature(req.Receiver, t.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("error signing transaction : %v", err)
+	}
+	if tx.Inputs[0].Script, err = script.HTLCClaim(sig, req.Preimage); err != nil {
+		return nil, err
+	}
+	return t.SubmitTx(tx)
+}
+
+// RefundHTLC pays the contract output back to the sender. The refund is refused before the deadline:
+// a pending refund would keep the receiver from claiming.
+func (t *Transactor) RefundHTLC(req *types.HTLCRefundRequest) (*types.Transaction, error) {
+	output, terms, err := t.htlcOutput(req.Outpoint)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(terms.Sender, crypto.PublicKeyToBytes(req.Sender.Public())) {
+		return nil, errors.New("refund requester is not the sender of the contract")
+	}
+	passed, err := t.deadlinePassed(terms.Deadline)
+	if err != nil {
+		return nil, err
+	}
+	if !passed {
+		return nil, fmt.Errorf("contract deadline %d has not passed yet", terms.Deadline)
+	}
+	if err = t.checkSigners(req.Sender); err != nil {
+		return nil, err
+	}
+	// the lock time satisfies OP_CHECKLOCKTIMEVERIFY, which needs a non-final input to be enforced
+	tx, err := t.spendContract(req.Outpoint, output, req.Sender.Public(), req.Fee, terms.Deadline, types.SequenceFinal-1)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := tx.ScriptSignature(req.Sender, t.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("error signing transaction : %v", err)
+	}
+	if tx.Inputs[0].Script, err = script.HTLCRefund(sig); err != nil {
+		return nil, err
+	}
+	return t.SubmitTx(tx)
+}
+
+// htlcOutput resolves an unspent contract output and its terms
+func (t *Transactor) htlcOutput(outpoint *types.UTXO) (*types.TxOut, *script.HTLCTerms, error) {
+	if outpoint == nil {
+		return nil, nil, errors.New("contract output must be provided")
+	}
+	if t.txPool.IsSpent(outpoint) {
+		return nil, nil, fmt.Errorf("contract output %s is already spent", outpointKey(outpoint))
+	}
+	output, err := t.prevOutput(outpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	if output == nil {
+		return nil, nil, fmt.Errorf("contract output %s does not exist or is already spent", outpointKey(outpoint))
+	}
+	if output.IsAsset() || output.IsToken() {
+		// the fee is taken from the contract amount, which works for the native coin only
+		return nil, nil, fmt.Errorf("contract output %s carries an asset or a token", outpointKey(outpoint))
+	}
+	terms, err := script.ParseHTLC(output.Script)
+	if err != nil {
+		return nil, nil, err
+	}
+	return output, terms, nil
+}
+
+// spendHTLC creates an unsigned transaction paying the contract output less the fee to the key
+func (t *Transactor) spendContract(
+	outpoint *types.UTXO,
+	output *types.TxOut,
+	to crypto.PublicKey,
+	fee uint64,
+	lockTime uint32,
+	sequence uint32,
+) (*types.Transaction, error) {
+	if fee >= output.Amount.Value {
+		return nil, fmt.Errorf("fee %d exceeds the contract amount %d", fee, output.Amount.Value)
+	}
+	tx := types.NewTransaction()
+	tx.LockTime = lockTime
+	tx.Fee = fee
+	tx.AddInput(types.NewTxIn(outpoint, nil, nil, nil, sequence))
+	tx.AddOutput(types.NewTxOut(tx.ID, types.Amount{Value: output.Amount.Value - fee, Unit: output.Amount.Unit}, crypto.PublicKeyHash(to)))
+	tx.ComputeHash()
+	return tx, nil
+}
+
+// deadlinePassed tells whether a transaction locked until the deadline can go into the next block
+func (t *Transactor) deadlinePassed(deadline uint32) (bool, error) {
+	last, err := t.store.Blockchain().GetLast()
+	if err != nil {
+		return false, fmt.Errorf("error getting last block : %v", err)
+	}
+	var height uint64
+	if last != nil {
+		height = last.Height + 1
+	}
+	locked := &types.Transaction{
+		LockTime: deadline,
+		Inputs:   []*types.TxIn{{NSequence: types.SequenceFinal - 1}},
+	}
+	return locked.IsFinal(height, uint64(time.Now().UnixNano())), nil
+}
+
+// CreateEscrow locks the amount in an escrow output, the first output of the escrow transaction, and submits
+// the buyer's refund: a transaction locked until the timeout, mined once the timeout has passed unless
+// a release or a dispute settlement replaces it first. The refund is returned along with the escrow transaction.
+func (t *Transactor) CreateEscrow(req *types.EscrowRequest) (*types.Transaction, *types.Transaction, error) {
+	if req.Seller == nil || req.Arbiter == nil {
+		return nil, nil, errors.New("seller and arbiter must be provided")
+	}
+	if req.Timeout == 0 {
+		return nil, nil, errors.New("timeout must be provided")
+	}
+	terms := script.EscrowTerms{
+		Buyer:   crypto.PublicKeyToBytes(req.Buyer.Public()),
+		Seller:  crypto.PublicKeyToBytes(req.Seller),
+		Arbiter: crypto.PublicKeyToBytes(req.Arbiter),
+		Timeout: req.Timeout,
+	}
+	if bytes.Equal(terms.Buyer, terms.Seller) || bytes.Equal(terms.Buyer, terms.Arbiter) ||
+		bytes.Equal(terms.Seller, terms.Arbiter) {
+		return nil, nil, errors.New("buyer, seller and arbiter must be different keys")
+	}
+	passed, err := t.deadlinePassed(req.Timeout)
+	if err != nil {
+		return nil, nil, err
+	}
+	if passed {
+		return nil, nil, fmt.Errorf("escrow timeout %d has already passed", req.Timeout)
+	}
+	lockingScript, err := script.Escrow(terms)
+	if err != nil {
+		return nil, nil, err
+	}
+	tx, err := t.CreateBatchTx(&types.BatchTransactionRequest{
+		Sender:   req.Buyer,
+		Payments: []types.Payment{{Script: lockingScript, Amount: req.Amount}},
+		Fee:      req.Fee,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	outpoint := types.NewUTXO(tx.ID, tx.GetHash(), 0)
+	refund, err := t.refundEscrow(req.Buyer, outpoint, tx.Outputs[0], req.Timeout, req.Fee)
+	if err != nil {
+		// the escrow stands, the buyer can still refund it after the timeout
+		return tx, nil, fmt.Errorf("escrow %s is created, but its refund is not: %w", outpointKey(outpoint), err)
+	}
+	return tx, refund, nil
+}
+
+// ReleaseEscrow pays the escrow output to the seller, the buyer and the seller sign together.
+func (t *Transactor) ReleaseEscrow(req *types.EscrowReleaseRequest) (*types.Transaction, error) {
+	output, terms, err := t.escrowOutput(req.Outpoint, true)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(terms.Buyer, crypto.PublicKeyToBytes(req.Buyer.Public())) {
+		return nil, errors.New("buyer does not match the escrow")
+	}
+	if !bytes.Equal(terms.Seller, crypto.PublicKeyToBytes(req.Seller.Public())) {
+		return nil, errors.New("seller does not match the escrow")
+	}
+	return t.settleEscrow(req.Outpoint, output, req.Buyer, req.Seller, req.Seller.Public(), req.Fee)
+}
+
+// DisputeEscrow settles a dispute: the arbiter signs with the party it rules for, which gets the escrow output.
+func (t *Transactor) DisputeEscrow(req *types.EscrowDisputeRequest) (*types.Transaction, error) {
+	output, terms, err := t.escrowOutput(req.Outpoint, true)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(terms.Arbiter, crypto.PublicKeyToBytes(req.Arbiter.Public())) {
+		return nil, errors.New("arbiter does not match the escrow")
+	}
+	party := crypto.PublicKeyToBytes(req.Party.Public())
+	if !bytes.Equal(terms.Buyer, party) && !bytes.Equal(terms.Seller, party) {
+		return nil, errors.New("party is neither the buyer nor the seller of the escrow")
+	}
+	// the arbiter's key is the last of the escrow keys, so its signature goes last
+	return t.settleEscrow(req.Outpoint, output, req.Party, req.Arbiter, req.Party.Public(), req.Fee)
+}
+
+// RefundEscrow pays the escrow output back to the buyer once the timeout has passed. It is needed only when
+// the refund submitted with the escrow is gone, e.g. evicted from the pool.
+func (t *Transactor) RefundEscrow(req *types.EscrowRefundRequest) (*types.Transaction, error) {
+	output, terms, err := t.escrowOutput(req.Outpoint, false)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(terms.Buyer, crypto.PublicKeyToBytes(req.Buyer.Public())) {
+		return nil, errors.New("refund requester is not the buyer of the escrow")
+	}
+	passed, err := t.deadlinePassed(terms.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	if !passed {
+		return nil, fmt.Errorf("escrow timeout %d has not passed yet", terms.Timeout)
+	}
+	return t.refundEscrow(req.Buyer, req.Outpoint, output, terms.Timeout, req.Fee)
+}
+
+// ListEscrows lists the open escrows of the participant: confirmed escrows it is a party of that neither
+// a confirmed nor a pending transaction spends, a pending refund aside.
+func (t *Transactor) ListEscrows(pubKey []byte) ([]*types.Escrow, error) {
+	escrows, err := t.store.Escrow().GetByParticipant(pubKey)
+	if err != nil {
+		return nil, fmt.Errorf("error getting escrows : %v", err)
+	}
+	open := make([]*types.Escrow, 0, len(escrows))
+	for _, escrow := range escrows {
+		utxo, err := t.store.Utxo().Get(escrow.Outpoint)
+		if err != nil {
+			return nil, fmt.Errorf("error getting utxo : %v", err)
+		}
+		if utxo == nil {
+			continue
+		}
+		if spender, ok := t.txPool.Spender(escrow.Outpoint); ok && !spender.LockTimeInForce() {
+			continue
+		}
+		open = append(open, escrow)
+	}
+	return open, nil
+}
+
+// escrowOutput resolves an unspent escrow output and its terms. When settling, a pending transaction waiting
+// for its lock time, the buyer's refund, doesn't count as spending the output: the settlement replaces it.
+func (t *Transactor) escrowOutput(outpoint *types.UTXO, settling bool) (*types.TxOut, *script.EscrowTerms, error) {
+	if outpoint == nil {
+		return nil, nil, errors.New("escrow output must be provided")
+	}
+	if spender, ok := t.txPool.Spender(outpoint); ok && !(settling && spender.LockTimeInForce()) {
+		return nil, nil, fmt.Errorf("escrow output %s is already spent by pending transaction %s", outpointKey(outpoint), spender.ID)
+	}
+	output, err := t.prevOutput(outpoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	if output == nil {
+		return nil, nil, fmt.Errorf("escrow output %s does not exist or is already spent", outpointKey(outpoint))
+	}
+	if output.IsAsset() || output.IsToken() {
+		return nil, nil, fmt.Errorf("escrow output %s carries an asset or a token", outpointKey(outpoint))
+	}
+	terms, err := script.ParseEscrow(output.Script)
+	if err != nil {
+		return nil, nil, err
+	}
+	return output, terms, nil
+}
+
+// settleEscrow pays the escrow output to the key, signed by two of the parties in the order of the escrow keys
+func (t *Transactor) settleEscrow(
+	outpoint *types.UTXO,
+	output *types.TxOut,
+	first, second crypto.Signer,
+	to crypto.PublicKey,
+	fee uint64,
+) (*types.Transaction, error) {
+	if err := t.checkSigners(first, second); err != nil {
+		return nil, err
+	}
+	tx, err := t.spendContract(outpoint, output, to, fee, 0, types.SequenceFinal)
+	if err != nil {
+		return nil, err
+	}
+	firstSig, err := tx.ScriptSignature(first, t.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("error signing transaction : %v", err)
+	}
+	secondSig, err := tx.ScriptSignature(second, t.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("error signing transaction : %v", err)
+	}
+	if tx.Inputs[0].Script, err = script.EscrowSettle(firstSig, secondSig); err != nil {
+		return nil, err
+	}
+	return t.SubmitTx(tx)
+}
+
+// refundEscrow submits the buyer's refund of the escrow output, locked until the timeout
+func (t *Transactor) refundEscrow(
+	buyer crypto.Signer,
+	outpoint *types.UTXO,
+	output *types.TxOut,
+	timeout uint32,
+	fee uint64,
+) (*types.Transaction, error) {
+	if err := t.checkSigners(buyer); err != nil {
+		return nil, err
+	}
+	// the lock time satisfies OP_CHECKLOCKTIMEVERIFY, which needs a non-final input to be enforced
+	tx, err := t.spendContract(outpoint, output, buyer.Public(), fee, timeout, types.SequenceFinal-1)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := tx.ScriptSignature(buyer, t.chainID)
+	if err != nil {
+		return nil, fmt.Errorf("error signing transaction : %v", err)
+	}
+	if tx.Inputs[0].Script, err = script.EscrowRefund(sig); err != nil {
+		return nil, err
+	}
+	return t.SubmitTx(tx)
+}
+
+// Notarize anchors the data in a zero-value data output, the first output of a transaction of the sender.
+func (t *Transactor) Notarize(req *types.NotarizeRequest) (*types.Transaction, error) {
+	if len(req.Data) == 0 || len(req.Data) > types.MaxDataSize {
+		return nil, fmt.Errorf("data must be 1 to %d bytes", types.MaxDataSize)
+	}
+	lockingScript, err := script.NullData(req.Data)
+	if err != nil {
+		return nil, err
+	}
+	return t.CreateBatchTx(&types.BatchTransactionRequest{
+		Sender:   req.Sender,
+		Payments: []types.Payment{{Script: lockingScript}},
+		Fee:      req.Fee,
+	})
+}
+
+// ProveNotarization finds the confirmed transaction that first anchored the data and proves it is in its block.
+func (t *Transactor) ProveNotarization(data []byte) (*types.Notarization, error) {
+	tx, err := t.store.Transaction().GetByData(data)
 	if err != nil {
 		return nil, fmt.Errorf("error getting transaction : %v", err)
 	}
@@ -123,7 +1100,7 @@ func (t *Transactor) VerifyTx(txID uuid.UUID) (*types.Transaction, error) {
 		return nil, fmt.Errorf("error getting block : %v", err)
 	}
 	if block == nil {
-		return nil, fmt.Errorf("transaction's block not found: txID %s, timestamp %d", txID.String(), tx.BlockTimestamp)
+		return nil, fmt.Errorf("transaction's block not found: txID %s, timestamp %d", tx.ID, tx.BlockTimestamp)
 	}
 	blockTxs, err := t.store.BlockTransactions().GetByBlockTimestamp(block.Timestamp)
 	if err != nil {
@@ -133,89 +1110,424 @@ func (t *Transactor) VerifyTx(txID uuid.UUID) (*types.Transaction, error) {
 	if err != nil {
 		return nil, fmt.Errorf("error creating merkle tree : %v", err)
 	}
-	ok, err := merkleTree.VerifyTransaction(tx)
+	proof, err := merkleTree.Proof(tx)
 	if err != nil {
-		return nil, fmt.Errorf("error verifying transaction in merkle tree : %v", err)
+		return nil, fmt.Errorf("error proving transaction in merkle tree : %v", err)
 	}
-	if !ok {
-		return nil, fmt.Errorf("transaction %s not found in block %d", txID.String(), block.Timestamp)
+	if !merkle.VerifyProof(block.MerkleRoot, tx.GetHash(), proof) {
+		return nil, fmt.Errorf("transaction %s does not match the merkle root of block %d", tx.ID, block.Timestamp)
+	}
+	return &types.Notarization{Tx: tx, Block: block, Proof: proof}, nil
+}
+
+// buildTx creates an unsigned transaction paying the payments and the fee from the outputs the coin selector picks,
+// the change output, if any, is made by change. Every asset is selected and changed separately, the fee is paid with
+// the native coin. The outputs spent by the inputs are returned in the input order.
+func (t *Transactor) buildTx(
+	utxos []*types.UnspentOutput,
+	payments []types.Payment,
+	fee uint64,
+	lockTime uint32,
+	inputPubKey []byte,
+	change func(id uuid.UUID, change types.Amount) *types.TxOut,
+) (*types.Transaction, []*types.TxOut, error) {
+	if len(payments) == 0 {
+		return nil, nil, errors.New("at least one payment must be provided")
+	}
+	// the native coin comes first, then the assets in the payments order
+	assetIDs := [][]byte{nil}
+	totals := map[string]uint64{"": fee}
+	for i, payment := range payments {
+		if payment.Receiver == nil && payment.Multisig == nil && len(payment.Script) == 0 {
+			return nil, nil, fmt.Errorf("payment %d: receiver must be provided", i)
+		}
+		if len(payment.Script) > 0 {
+			if err := types.NewScriptTxOut(uuid.Nil, payment.Amount, payment.Script).CheckLock(); err != nil {
+				return nil, nil, fmt.Errorf("payment %d: invalid script: %w", i, err)
+			}
+		}
+		if payment.Multisig != nil {
+			if err := payment.Multisig.Check(); err != nil {
+				return nil, nil, fmt.Errorf("payment %d: %w", i, err)
+			}
+		}
+		if _, ok := totals[string(payment.AssetID)]; !ok {
+			assetIDs = append(assetIDs, payment.AssetID)
+		}
+		total, err := types.AddValues(totals[string(payment.AssetID)], payment.Amount.Value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("payment %d: %w", i, err)
+		}
+		totals[string(payment.AssetID)] = total
+	}
+
+	newTx := types.NewTransaction()
+	newTx.LockTime = lockTime
+	// the lock time is enforced only if some input isn't final, relative locks stay disabled
+	sequence := uint32(types.SequenceFinal)
+	if newTx.LockTime != 0 {
+		sequence = types.SequenceFinal - 1
+	}
+	var prevouts []*types.TxOut
+	var changes []*types.TxOut
+	for _, assetID := range assetIDs {
+		if totals[string(assetID)] == 0 && len(assetIDs) > 1 {
+			// nothing to pay with the coin, e.g. an asset transfer without a fee
+			continue
+		}
+		selected, err := t.coinSelector.Select(assetUTXOs(utxos, assetID), totals[string(assetID)])
+		if errors.Is(err, coinselect.ErrInsufficientFunds) {
+			if assetID != nil {
+				return nil, nil, fmt.Errorf("insufficient balance of asset %x", assetID)
+			}
+			return nil, nil, errors.New("insufficient balance")
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("error selecting inputs : %v", err)
+		}
+		changeAmount := types.NewAmount(0)
+		for _, utxo := range selected {
+			newTx.AddInput(types.NewTxIn(utxo.UTXO, inputPubKey, nil, nil, sequence))
+			prevouts = append(prevouts, utxo.Output)
+			if changeAmount.Value, err = types.AddValues(changeAmount.Value, utxo.Output.Amount.Value); err != nil {
+				return nil, nil, fmt.Errorf("inputs: %w", err)
+			}
+			// assume all outputs of the asset have the same unit
+			changeAmount.Unit = utxo.Output.Amount.Unit
+		}
+		changeAmount.Value -= totals[string(assetID)]
+		if changeAmount.Value > 0 {
+			changeOut := change(newTx.ID, *changeAmount)
+			changeOut.AssetID = assetID
+			changes = append(changes, changeOut)
+		}
+	}
+	for _, payment := range payments {
+		var out *types.TxOut
+		switch {
+		case len(payment.Script) > 0:
+			out = types.NewScriptTxOut(newTx.ID, payment.Amount, payment.Script)
+		case payment.Multisig != nil:
+			out = types.NewMultisigTxOut(newTx.ID, payment.Amount, payment.Multisig)
+		default:
+			out = types.NewTxOut(newTx.ID, payment.Amount, payment.Receiver)
+		}
+		out.AssetID = payment.AssetID
+		newTx.AddOutput(out)
+	}
+	for _, out := range changes {
+		newTx.AddOutput(out)
+	}
+	newTx.Fee = fee
+	newTx.ComputeHash()
+	return newTx, prevouts, nil
+}
+
+// assetUTXOs filters the outputs carrying the asset, the native coin for a nil asset ID; token outputs carry neither
+func assetUTXOs(utxos []*types.UnspentOutput, assetID []byte) []*types.UnspentOutput {
+	filtered := make([]*types.UnspentOutput, 0, len(utxos))
+	for _, utxo := range utxos {
+		if bytes.Equal(utxo.Output.AssetID, assetID) && !utxo.Output.IsData() && !utxo.Output.IsToken() {
+			filtered = append(filtered, utxo)
+		}
+	}
+	return filtered
+}
+
+// SubmitTx accepts a transaction that was built and signed by the wallet.
+// The node never sees the sender's private key: it only validates the transaction and puts it into the pool.
+func (t *Transactor) SubmitTx(tx *types.Transaction) (*types.Transaction, error) {
+	if err := t.ValidateTx(tx); err != nil {
+		return nil, fmt.Errorf("invalid transaction: %w", err)
+	}
+	if err := t.checkRelayFee(tx); err != nil {
+		return nil, err
 	}
+	if err := t.addTx(tx); err != nil {
+		return nil, fmt.Errorf("error adding tx to pool : %v", err)
+	}
+
 	return tx, nil
 }
 
-func (t *Transactor) getBalance(key *ecdsa.PrivateKey, fillInputFunc func(utxo *types.UTXO, senderPubKey []byte, r, s *big.Int, id uint32)) (*types.Amount, error) {
-	pubKey := crypto.PublicKeyToBytes(&key.PublicKey)
-	utxos, err := t.getUTXOs(pubKey)
+// addTx replicates the transaction through raft, the FSM of every replica puts it into its pool
+func (t *Transactor) addTx(tx *types.Transaction) error {
+	txBytes, err := tx.ToBytes()
 	if err != nil {
-		return nil, fmt.Errorf("error getting utxos : %v", err)
+		return fmt.Errorf("error while encoding transaction: %w", err)
+	}
+	envelopeBytes, err := types.NewEnvelope(types.EnvelopeTypeTransaction, txBytes).ToBytes()
+	if err != nil {
+		return fmt.Errorf("error while encoding envelope: %w", err)
+	}
+	future := t.raftApi.Apply(envelopeBytes, applyTimeout)
+	if err = future.Error(); err != nil {
+		return fmt.Errorf("error while applying transaction to raft: %w", err)
 	}
-	balance := types.NewAmount(0)
-	for id, utxo := range utxos {
-		tx, err := t.getTx(utxo.TxID)
+	if response := future.Response(); response != nil {
+		if err, ok := response.(error); ok {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateTx checks an externally built transaction: every input must reference an unspent output and carry
+// a valid signature of the output's owner, and the outputs and the fee must spend exactly the inputs' value.
+// Outputs of pending transactions may be spent as well; conflicts with other pending transactions
+// are detected by the pool when the transaction is added.
+func (t *Transactor) ValidateTx(tx *types.Transaction) error {
+	if tx.Execution != nil {
+		return errors.New("standing order payments are executed by the block producer only")
+	}
+	if len(tx.Inputs) == 0 {
+		return errors.New("transaction has no inputs")
+	}
+	if len(tx.Outputs) == 0 {
+		return errors.New("transaction has no outputs")
+	}
+	hash := tx.Hash
+	tx.ComputeHash()
+	if hash != nil && !bytes.Equal(hash, tx.Hash) {
+		return errors.New("transaction hash mismatch")
+	}
+	if err := checkNotConfirmed(t.store, tx.ID); err != nil {
+		return err
+	}
+
+	spent := make(map[string]struct{}, len(tx.Inputs))
+	spentOutputs := make(map[*types.UTXO]*types.TxOut, len(tx.Inputs))
+	prevouts := make([]*types.TxOut, 0, len(tx.Inputs))
+	for i, in := range tx.Inputs {
+		if in.Prev == nil {
+			return fmt.Errorf("input %d does not reference an output", i)
+		}
+		outpoint := outpointKey(in.Prev)
+		if _, ok := spent[outpoint]; ok {
+			return fmt.Errorf("input %d spends output %s twice", i, outpoint)
+		}
+		spent[outpoint] = struct{}{}
+
+		output, err := t.prevOutput(in.Prev)
 		if err != nil {
-			return nil, fmt.Errorf("get utxo tx hash err: %v", err)
+			return fmt.Errorf("input %d: %w", i, err)
 		}
-		if int(utxo.Index) >= len(tx.Outputs) {
-			return nil, fmt.Errorf("UTXO index %d is out of bounds for transaction %s", utxo.Index, string(utxo.TxHash))
+		if output == nil {
+			return fmt.Errorf("input %d: output %s does not exist or is already spent", i, outpoint)
 		}
-		output := tx.Outputs[utxo.Index]
-		// Check if the output belongs to the key owner
-		outputPubKey, err := crypto.PublicKeyFromBytes(output.PubKey)
+		spentOutputs[in.Prev] = output
+		prevouts = append(prevouts, output)
+	}
+	if err := types.VerifySignatures(tx, t.chainID, func(prev *types.UTXO) (*types.TxOut, error) {
+		return spentOutputs[prev], nil
+	}); err != nil {
+		return err
+	}
+	if err := t.checkInputKeys(tx); err != nil {
+		return err
+	}
+
+	for i, out := range tx.Outputs {
+		if err := out.CheckLock(); err != nil {
+			return fmt.Errorf("output %d: %w", i, err)
+		}
+	}
+
+	if err := checkValues(tx, prevouts, t.minters); err != nil {
+		return err
+	}
+	return checkStandingOrders(t.store, tx, prevouts)
+}
+
+// checkRelayFee rejects transactions paying less than the minimum relay fee rate for their size
+func (t *Transactor) checkRelayFee(tx *types.Transaction) error {
+	minFee, err := types.MinFee(t.minRelayFee, tx.Size())
+	if err != nil {
+		return fmt.Errorf("minimum relay fee for a transaction of %d bytes: %w", tx.Size(), err)
+	}
+	if tx.Fee < minFee {
+		return fmt.Errorf("fee %d is below the minimum relay fee %d for a transaction of %d bytes", tx.Fee, minFee, tx.Size())
+	}
+	return nil
+}
+
+// EstimateFee suggests a fee rate per kilobyte: the median fee rate of transactions in the recent blocks,
+// but never less than the minimum relay fee.
+func (t *Transactor) EstimateFee(blocks int) (uint64, error) {
+	keys, err := t.store.Blockchain().GetKeys()
+	if err != nil {
+		return 0, fmt.Errorf("error getting block keys : %v", err)
+	}
+	slices.Sort(keys)
+	if len(keys) > blocks {
+		keys = keys[len(keys)-blocks:]
+	}
+	var feeRates []uint64
+	for _, key := range keys {
+		txs, err := t.store.BlockTransactions().GetByBlockTimestamp(key)
 		if err != nil {
-			return nil, fmt.Errorf("get output public key err: %v", err)
+			return 0, fmt.Errorf("error getting block transactions : %v", err)
 		}
-		if !outputPubKey.Equal(&key.PublicKey) {
-			return nil, fmt.Errorf("sender do not own transaction's output: tx: %s", string(utxo.TxHash))
+		for _, tx := range txs {
+			if !tx.IsFeeCollector() && tx.Execution == nil {
+				feeRates = append(feeRates, tx.FeeRate())
+			}
 		}
+	}
+	if len(feeRates) == 0 {
+		return t.minRelayFee, nil
+	}
+	slices.Sort(feeRates)
+	return max(feeRates[len(feeRates)/2], t.minRelayFee), nil
+}
 
-		r, s, err := utxo.Sign(key)
+// GetBalance returns the native coin balance of the owner and the balance of every asset the owner owns.
+func (t *Transactor) GetBalance(req *types.BalanceRequest) (*types.Balance, error) {
+	balance, err := t.getBalance(req.Owner)
+	if err != nil {
+		return nil, fmt.Errorf("error getting balance : %v", err)
+	}
+	for i := range balance.Assets {
+		asset, err := t.store.Asset().Get(balance.Assets[i].AssetID)
 		if err != nil {
-			return nil, fmt.Errorf("sign UTXO:%s err: %s", string(utxo.TxHash), err.Error())
+			return nil, fmt.Errorf("error getting asset : %v", err)
 		}
+		balance.Assets[i].Asset = asset
+	}
+	return balance, nil
+}
+
+// ListAssets returns the assets issued by confirmed transactions.
+func (t *Transactor) ListAssets() ([]*types.Asset, error) {
+	assets, err := t.store.Asset().GetAll()
+	if err != nil {
+		return nil, fmt.Errorf("error getting assets : %v", err)
+	}
+	return assets, nil
+}
 
-		if !utxo.Verify(key.PublicKey, r, s) {
-			return nil, fmt.Errorf("can not verify UTXO:%s err: not valid private key", string(utxo.TxHash))
+func (t *Transactor) VerifyTx(txID uuid.UUID) (*types.Transaction, error) {
+	tx, err := t.store.Transaction().Get(txID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting transaction : %v", err)
+	}
+	block, err := t.store.Blockchain().GetByTimestamp(tx.BlockTimestamp)
+	if err != nil {
+		return nil, fmt.Errorf("error getting block : %v", err)
+	}
+	if block == nil {
+		return nil, fmt.Errorf("transaction's block not found: txID %s, timestamp %d", txID.String(), tx.BlockTimestamp)
+	}
+	blockTxs, err := t.store.BlockTransactions().GetByBlockTimestamp(block.Timestamp)
+	if err != nil {
+		return nil, fmt.Errorf("error getting block transactions : %v", err)
+	}
+	merkleTree, err := merkle.NewMerkleTree(blockTxs...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating merkle tree : %v", err)
+	}
+	ok, err := merkleTree.VerifyTransaction(tx)
+	if err != nil {
+		return nil, fmt.Errorf("error verifying transaction in merkle tree : %v", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("transaction %s not found in block %d", txID.String(), block.Timestamp)
+	}
+	// a standing order payment is authorized by the order, not by signatures
+	if tx.Execution != nil {
+		return tx, nil
+	}
+	if err = types.VerifySignatures(tx, t.chainID, t.storedOutput); err != nil {
+		return tx, fmt.Errorf("error verifying transaction signatures : %v", err)
+	}
+	return tx, nil
+}
+
+func (t *Transactor) getBalance(owner crypto.PublicKey) (*types.Balance, error) {
+	utxos, err := t.getOwnedUTXOs(owner)
+	if err != nil {
+		return nil, err
+	}
+	balance := &types.Balance{Amount: *types.NewAmount(0)}
+	assets := make(map[string]uint64)
+	for _, utxo := range utxos {
+		if utxo.Output.IsAsset() {
+			assetID := string(utxo.Output.AssetID)
+			if assets[assetID], err = types.AddValues(assets[assetID], utxo.Output.Amount.Value); err != nil {
+				return nil, fmt.Errorf("asset %x: %w", utxo.Output.AssetID, err)
+			}
+			continue
 		}
-		if fillInputFunc != nil {
-			fillInputFunc(utxo, pubKey, r, s, uint32(id))
+		if balance.Amount.Value, err = types.AddValues(balance.Amount.Value, utxo.Output.Amount.Value); err != nil {
+			return nil, err
 		}
-		balance.Value += output.Amount.Value
 		// assume all outputs have the same unit
-		balance.Unit = output.Amount.Unit
+		balance.Amount.Unit = utxo.Output.Amount.Unit
+	}
+	for _, assetID := range slices.Sorted(maps.Keys(assets)) {
+		balance.Assets = append(balance.Assets, types.AssetBalance{AssetID: []byte(assetID), Value: assets[assetID]})
 	}
 	return balance, nil
 }
 
-// GetUTXOs gets unspent transaction outputs for public key
-func (t *Transactor) getUTXOs(pubKey []byte) (types.UTXOs, error) {
-	// we need to get utxos from the pool as well to avoid double spending
-	// also we need to get the utxo with index > 0 only once (the rest are change utxos)
-	utxosPool := t.txPool.GetUTXOs(pubKey)
-	utxos, err := t.store.Utxo().Get(pubKey)
+// getOwnedUTXOs returns the unspent outputs of the key owner, checking every output is locked to the key
+func (t *Transactor) getOwnedUTXOs(owner crypto.PublicKey) ([]*types.UnspentOutput, error) {
+	utxos, err := t.getUTXOs(crypto.PublicKeyHash(owner))
 	if err != nil {
 		return nil, fmt.Errorf("error getting utxos : %v", err)
 	}
-	for _, utxo := range utxosPool {
-		if utxo.Index == 0 {
-			utxos = append(utxos, utxo)
-			continue
-		}
-		if utxo.Index > 0 {
-			return types.UTXOs{utxo}, nil
+	pubKey := crypto.PublicKeyToBytes(owner)
+	for _, utxo := range utxos {
+		if !utxo.Output.IsLockedTo(pubKey) {
+			return nil, fmt.Errorf("sender do not own transaction's output: %s", outpointKey(utxo.UTXO))
 		}
 	}
 	return utxos, nil
 }
 
-func (t *Transactor) getTx(txID uuid.UUID) (*types.Transaction, error) {
-	var err error
-	tx, ok := t.txPool.GetPool()[txID]
-	if !ok {
-		tx, err = t.store.Transaction().Get(txID)
-		if err != nil {
-			return nil, fmt.Errorf("error getting transaction : %v", err)
+// GetUTXOs gets unspent transaction outputs of the owner, an address or a multisig lock owner: confirmed outputs
+// that no pending transaction spends yet and outputs of pending transactions, so unconfirmed change can be spent right away
+func (t *Transactor) getUTXOs(owner []byte) ([]*types.UnspentOutput, error) {
+	stored, err := t.store.Utxo().GetByOwner(owner)
+	if err != nil {
+		return nil, fmt.Errorf("error getting utxos : %v", err)
+	}
+	utxos := make([]*types.UnspentOutput, 0, len(stored))
+	for _, utxo := range stored {
+		if !t.txPool.IsSpent(utxo.UTXO) {
+			utxos = append(utxos, utxo)
 		}
 	}
+	return append(utxos, t.txPool.GetUTXOs(owner)...), nil
+}
 
-	return tx, nil
+// prevOutput resolves the output spent by an input: an output of a pending transaction or an unspent confirmed one
+func (t *Transactor) prevOutput(prev *types.UTXO) (*types.TxOut, error) {
+	if tx, ok := t.txPool.Get(prev.TxID); ok {
+		return outputAt(tx, prev.Index)
+	}
+	utxo, err := t.store.Utxo().Get(prev)
+	if err != nil {
+		return nil, fmt.Errorf("error getting utxo : %v", err)
+	}
+	if utxo == nil {
+		return nil, nil
+	}
+	return utxo.Output, nil
+}
+
+// storedOutput resolves the output spent by an input of an already stored transaction
+func (t *Transactor) storedOutput(prev *types.UTXO) (*types.TxOut, error) {
+	tx, err := t.store.Transaction().Get(prev.TxID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting transaction : %v", err)
+	}
+	return outputAt(tx, prev.Index)
+}
+
+func outputAt(tx *types.Transaction, index uint32) (*types.TxOut, error) {
+	if int(index) >= len(tx.Outputs) {
+		return nil, fmt.Errorf("output index %d is out of bounds for transaction %s", index, tx.ID)
+	}
+	return tx.Outputs[index], nil
 }
@@ -1,17 +1,41 @@
 package service_test
 
 import (
+	"fmt"
+
 	"local-chain/internal/service"
+	"local-chain/internal/types"
 
 	"github.com/golang/mock/gomock"
 )
 
+// applyFuture is a raft.ApplyFuture of an already applied log entry, response is what the FSM returned
+type applyFuture struct {
+	err      error
+	response interface{}
+}
+
+func (f applyFuture) Error() error {
+	return f.err
+}
+
+func (f applyFuture) Response() interface{} {
+	return f.response
+}
+
+func (f applyFuture) Index() uint64 {
+	return 0
+}
+
 type MockCustomStore struct {
 	TransactionStore *MockTransactionStore
 	BStore           *MockBStore
 	UTXOStore        *MockUTXOStore
 	UserStore        *MockUserStore
 	BlockTxStore     *MockBlockTxStore
+	AssetStore       *MockAssetStore
+	EscrowStore      *MockEscrowStore
+	OrderStore       *MockStandingOrderStore
 }
 
 func (m MockCustomStore) Transaction() service.TransactionStore {
@@ -34,6 +58,18 @@ func (m MockCustomStore) BlockTransactions() service.BlockTxStore {
 	return m.BlockTxStore
 }
 
+func (m MockCustomStore) Asset() service.AssetStore {
+	return m.AssetStore
+}
+
+func (m MockCustomStore) Escrow() service.EscrowStore {
+	return m.EscrowStore
+}
+
+func (m MockCustomStore) StandingOrder() service.StandingOrderStore {
+	return m.OrderStore
+}
+
 func NewMockCustomStore(ctrl *gomock.Controller) *MockCustomStore {
 	return &MockCustomStore{
 		TransactionStore: NewMockTransactionStore(ctrl),
@@ -41,5 +77,38 @@ func NewMockCustomStore(ctrl *gomock.Controller) *MockCustomStore {
 		UTXOStore:        NewMockUTXOStore(ctrl),
 		UserStore:        NewMockUserStore(ctrl),
 		BlockTxStore:     NewMockBlockTxStore(ctrl),
+		AssetStore:       NewMockAssetStore(ctrl),
+		EscrowStore:      NewMockEscrowStore(ctrl),
+		OrderStore:       NewMockStandingOrderStore(ctrl),
 	}
 }
+
+// userCommandMatcher matches a raft log entry of the user command of the operation on the username
+type userCommandMatcher struct {
+	op       types.UserOp
+	username string
+}
+
+func userCommand(op types.UserOp, username string) gomock.Matcher {
+	return userCommandMatcher{op: op, username: username}
+}
+
+func (m userCommandMatcher) Matches(x interface{}) bool {
+	data, ok := x.([]byte)
+	if !ok {
+		return false
+	}
+	envelope, err := types.EnvelopeFromBytes(data)
+	if err != nil || envelope.Type != types.EnvelopeTypeUser {
+		return false
+	}
+	cmd := &types.UserCommand{}
+	if err = cmd.FromBytes(envelope.Data); err != nil || cmd.User == nil {
+		return false
+	}
+	return cmd.Op == m.op && cmd.User.Username == m.username
+}
+
+func (m userCommandMatcher) String() string {
+	return fmt.Sprintf("is the user command %d of %s", m.op, m.username)
+}
@@ -1,17 +1,18 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: local-chain/internal/service (interfaces: TransactionStore,BStore,UTXOStore,TxPool,UserStore,BlockTxStore,Store)
+// Source: local-chain/internal/service (interfaces: TransactionStore,BStore,UTXOStore,TxPool,UserStore,BlockTxStore,AssetStore,EscrowStore,StandingOrderStore,Store,RaftAPI,KeyStore,Sweeper,StandingOrderLister)
 
 // Package service_test is a generated GoMock package.
 package service_test
 
 import (
-	inMem "local-chain/internal/adapters/outbound/inMem"
 	service "local-chain/internal/service"
 	types "local-chain/internal/types"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
+	raft "github.com/hashicorp/raft"
 )
 
 // MockTransactionStore is a mock of TransactionStore interface.
@@ -52,6 +53,36 @@ func (mr *MockTransactionStoreMockRecorder) Get(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTransactionStore)(nil).Get), arg0)
 }
 
+// GetByData mocks base method.
+func (m *MockTransactionStore) GetByData(arg0 []byte) (*types.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByData", arg0)
+	ret0, _ := ret[0].(*types.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByData indicates an expected call of GetByData.
+func (mr *MockTransactionStoreMockRecorder) GetByData(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByData", reflect.TypeOf((*MockTransactionStore)(nil).GetByData), arg0)
+}
+
+// Has mocks base method.
+func (m *MockTransactionStore) Has(arg0 uuid.UUID) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Has", arg0)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Has indicates an expected call of Has.
+func (mr *MockTransactionStoreMockRecorder) Has(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Has", reflect.TypeOf((*MockTransactionStore)(nil).Has), arg0)
+}
+
 // Put mocks base method.
 func (m *MockTransactionStore) Put(arg0 *types.Transaction) error {
 	m.ctrl.T.Helper()
@@ -148,6 +179,21 @@ func (mr *MockBStoreMockRecorder) GetKeys() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeys", reflect.TypeOf((*MockBStore)(nil).GetKeys))
 }
 
+// GetLast mocks base method.
+func (m *MockBStore) GetLast() (*types.Block, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLast")
+	ret0, _ := ret[0].(*types.Block)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLast indicates an expected call of GetLast.
+func (mr *MockBStoreMockRecorder) GetLast() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLast", reflect.TypeOf((*MockBStore)(nil).GetLast))
+}
+
 // Put mocks base method.
 func (m *MockBStore) Put(arg0 *types.Block) error {
 	m.ctrl.T.Helper()
@@ -185,11 +231,29 @@ func (m *MockUTXOStore) EXPECT() *MockUTXOStoreMockRecorder {
 	return m.recorder
 }
 
+// Apply mocks base method.
+func (m *MockUTXOStore) Apply(arg0 ...*types.Transaction) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range arg0 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Apply", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockUTXOStoreMockRecorder) Apply(arg0 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockUTXOStore)(nil).Apply), arg0...)
+}
+
 // Get mocks base method.
-func (m *MockUTXOStore) Get(arg0 []byte) ([]*types.UTXO, error) {
+func (m *MockUTXOStore) Get(arg0 *types.UTXO) (*types.UnspentOutput, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Get", arg0)
-	ret0, _ := ret[0].([]*types.UTXO)
+	ret0, _ := ret[0].(*types.UnspentOutput)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -200,23 +264,19 @@ func (mr *MockUTXOStoreMockRecorder) Get(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockUTXOStore)(nil).Get), arg0)
 }
 
-// Put mocks base method.
-func (m *MockUTXOStore) Put(arg0 []byte, arg1 ...*types.UTXO) error {
+// GetByOwner mocks base method.
+func (m *MockUTXOStore) GetByOwner(arg0 []byte) ([]*types.UnspentOutput, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0}
-	for _, a := range arg1 {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "Put", varargs...)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "GetByOwner", arg0)
+	ret0, _ := ret[0].([]*types.UnspentOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Put indicates an expected call of Put.
-func (mr *MockUTXOStoreMockRecorder) Put(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+// GetByOwner indicates an expected call of GetByOwner.
+func (mr *MockUTXOStoreMockRecorder) GetByOwner(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0}, arg1...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockUTXOStore)(nil).Put), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByOwner", reflect.TypeOf((*MockUTXOStore)(nil).GetByOwner), arg0)
 }
 
 // MockTxPool is a mock of TxPool interface.
@@ -242,75 +302,76 @@ func (m *MockTxPool) EXPECT() *MockTxPoolMockRecorder {
 	return m.recorder
 }
 
-// AddTx mocks base method.
-func (m *MockTxPool) AddTx(arg0 *types.Transaction) error {
+// Get mocks base method.
+func (m *MockTxPool) Get(arg0 uuid.UUID) (*types.Transaction, bool) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "AddTx", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*types.Transaction)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
 }
 
-// AddTx indicates an expected call of AddTx.
-func (mr *MockTxPoolMockRecorder) AddTx(arg0 interface{}) *gomock.Call {
+// Get indicates an expected call of Get.
+func (mr *MockTxPoolMockRecorder) Get(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTx", reflect.TypeOf((*MockTxPool)(nil).AddTx), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockTxPool)(nil).Get), arg0)
 }
 
-// AddUtxos mocks base method.
-func (m *MockTxPool) AddUtxos(arg0 []byte, arg1 ...*types.UTXO) {
+// GetUTXOs mocks base method.
+func (m *MockTxPool) GetUTXOs(arg0 []byte) []*types.UnspentOutput {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{arg0}
-	for _, a := range arg1 {
-		varargs = append(varargs, a)
-	}
-	m.ctrl.Call(m, "AddUtxos", varargs...)
+	ret := m.ctrl.Call(m, "GetUTXOs", arg0)
+	ret0, _ := ret[0].([]*types.UnspentOutput)
+	return ret0
 }
 
-// AddUtxos indicates an expected call of AddUtxos.
-func (mr *MockTxPoolMockRecorder) AddUtxos(arg0 interface{}, arg1 ...interface{}) *gomock.Call {
+// GetUTXOs indicates an expected call of GetUTXOs.
+func (mr *MockTxPoolMockRecorder) GetUTXOs(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{arg0}, arg1...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUtxos", reflect.TypeOf((*MockTxPool)(nil).AddUtxos), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXOs", reflect.TypeOf((*MockTxPool)(nil).GetUTXOs), arg0)
 }
 
-// GetPool mocks base method.
-func (m *MockTxPool) GetPool() inMem.Pool {
+// IsSpent mocks base method.
+func (m *MockTxPool) IsSpent(arg0 *types.UTXO) bool {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetPool")
-	ret0, _ := ret[0].(inMem.Pool)
+	ret := m.ctrl.Call(m, "IsSpent", arg0)
+	ret0, _ := ret[0].(bool)
 	return ret0
 }
 
-// GetPool indicates an expected call of GetPool.
-func (mr *MockTxPoolMockRecorder) GetPool() *gomock.Call {
+// IsSpent indicates an expected call of IsSpent.
+func (mr *MockTxPoolMockRecorder) IsSpent(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPool", reflect.TypeOf((*MockTxPool)(nil).GetPool))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSpent", reflect.TypeOf((*MockTxPool)(nil).IsSpent), arg0)
 }
 
-// GetUTXOs mocks base method.
-func (m *MockTxPool) GetUTXOs(arg0 []byte) types.UTXOs {
+// Ordered mocks base method.
+func (m *MockTxPool) Ordered() types.Transactions {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUTXOs", arg0)
-	ret0, _ := ret[0].(types.UTXOs)
+	ret := m.ctrl.Call(m, "Ordered")
+	ret0, _ := ret[0].(types.Transactions)
 	return ret0
 }
 
-// GetUTXOs indicates an expected call of GetUTXOs.
-func (mr *MockTxPoolMockRecorder) GetUTXOs(arg0 interface{}) *gomock.Call {
+// Ordered indicates an expected call of Ordered.
+func (mr *MockTxPoolMockRecorder) Ordered() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUTXOs", reflect.TypeOf((*MockTxPool)(nil).GetUTXOs), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ordered", reflect.TypeOf((*MockTxPool)(nil).Ordered))
 }
 
-// Purge mocks base method.
-func (m *MockTxPool) Purge() {
+// Spender mocks base method.
+func (m *MockTxPool) Spender(arg0 *types.UTXO) (*types.Transaction, bool) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Purge")
+	ret := m.ctrl.Call(m, "Spender", arg0)
+	ret0, _ := ret[0].(*types.Transaction)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
 }
 
-// Purge indicates an expected call of Purge.
-func (mr *MockTxPoolMockRecorder) Purge() *gomock.Call {
+// Spender indicates an expected call of Spender.
+func (mr *MockTxPoolMockRecorder) Spender(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockTxPool)(nil).Purge))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Spender", reflect.TypeOf((*MockTxPool)(nil).Spender), arg0)
 }
 
 // MockUserStore is a mock of UserStore interface.
@@ -336,6 +397,20 @@ func (m *MockUserStore) EXPECT() *MockUserStoreMockRecorder {
 	return m.recorder
 }
 
+// Delete mocks base method.
+func (m *MockUserStore) Delete() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUserStoreMockRecorder) Delete() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUserStore)(nil).Delete))
+}
+
 // Get mocks base method.
 func (m *MockUserStore) Get(arg0 string) (*types.User, error) {
 	m.ctrl.T.Helper()
@@ -366,6 +441,21 @@ func (mr *MockUserStoreMockRecorder) GetAll() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockUserStore)(nil).GetAll))
 }
 
+// GetByAddress mocks base method.
+func (m *MockUserStore) GetByAddress(arg0 []byte) (*types.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByAddress", arg0)
+	ret0, _ := ret[0].(*types.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByAddress indicates an expected call of GetByAddress.
+func (mr *MockUserStoreMockRecorder) GetByAddress(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByAddress", reflect.TypeOf((*MockUserStore)(nil).GetByAddress), arg0)
+}
+
 // Put mocks base method.
 func (m *MockUserStore) Put(arg0 *types.User) error {
 	m.ctrl.T.Helper()
@@ -380,6 +470,20 @@ func (mr *MockUserStoreMockRecorder) Put(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockUserStore)(nil).Put), arg0)
 }
 
+// Update mocks base method.
+func (m *MockUserStore) Update(arg0 *types.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockUserStoreMockRecorder) Update(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserStore)(nil).Update), arg0)
+}
+
 // MockBlockTxStore is a mock of BlockTxStore interface.
 type MockBlockTxStore struct {
 	ctrl     *gomock.Controller
@@ -432,6 +536,192 @@ func (mr *MockBlockTxStoreMockRecorder) Put(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockBlockTxStore)(nil).Put), arg0)
 }
 
+// MockAssetStore is a mock of AssetStore interface.
+type MockAssetStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssetStoreMockRecorder
+}
+
+// MockAssetStoreMockRecorder is the mock recorder for MockAssetStore.
+type MockAssetStoreMockRecorder struct {
+	mock *MockAssetStore
+}
+
+// NewMockAssetStore creates a new mock instance.
+func NewMockAssetStore(ctrl *gomock.Controller) *MockAssetStore {
+	mock := &MockAssetStore{ctrl: ctrl}
+	mock.recorder = &MockAssetStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssetStore) EXPECT() *MockAssetStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockAssetStore) Get(arg0 []byte) (*types.Asset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*types.Asset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockAssetStoreMockRecorder) Get(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockAssetStore)(nil).Get), arg0)
+}
+
+// GetAll mocks base method.
+func (m *MockAssetStore) GetAll() ([]*types.Asset, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].([]*types.Asset)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockAssetStoreMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockAssetStore)(nil).GetAll))
+}
+
+// Put mocks base method.
+func (m *MockAssetStore) Put(arg0 *types.Asset) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockAssetStoreMockRecorder) Put(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockAssetStore)(nil).Put), arg0)
+}
+
+// MockEscrowStore is a mock of EscrowStore interface.
+type MockEscrowStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockEscrowStoreMockRecorder
+}
+
+// MockEscrowStoreMockRecorder is the mock recorder for MockEscrowStore.
+type MockEscrowStoreMockRecorder struct {
+	mock *MockEscrowStore
+}
+
+// NewMockEscrowStore creates a new mock instance.
+func NewMockEscrowStore(ctrl *gomock.Controller) *MockEscrowStore {
+	mock := &MockEscrowStore{ctrl: ctrl}
+	mock.recorder = &MockEscrowStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEscrowStore) EXPECT() *MockEscrowStoreMockRecorder {
+	return m.recorder
+}
+
+// GetByParticipant mocks base method.
+func (m *MockEscrowStore) GetByParticipant(arg0 []byte) ([]*types.Escrow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByParticipant", arg0)
+	ret0, _ := ret[0].([]*types.Escrow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByParticipant indicates an expected call of GetByParticipant.
+func (mr *MockEscrowStoreMockRecorder) GetByParticipant(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByParticipant", reflect.TypeOf((*MockEscrowStore)(nil).GetByParticipant), arg0)
+}
+
+// Put mocks base method.
+func (m *MockEscrowStore) Put(arg0 *types.Escrow) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockEscrowStoreMockRecorder) Put(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockEscrowStore)(nil).Put), arg0)
+}
+
+// MockStandingOrderStore is a mock of StandingOrderStore interface.
+type MockStandingOrderStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockStandingOrderStoreMockRecorder
+}
+
+// MockStandingOrderStoreMockRecorder is the mock recorder for MockStandingOrderStore.
+type MockStandingOrderStoreMockRecorder struct {
+	mock *MockStandingOrderStore
+}
+
+// NewMockStandingOrderStore creates a new mock instance.
+func NewMockStandingOrderStore(ctrl *gomock.Controller) *MockStandingOrderStore {
+	mock := &MockStandingOrderStore{ctrl: ctrl}
+	mock.recorder = &MockStandingOrderStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStandingOrderStore) EXPECT() *MockStandingOrderStoreMockRecorder {
+	return m.recorder
+}
+
+// Get mocks base method.
+func (m *MockStandingOrderStore) Get(arg0 []byte) (*types.StandingOrderRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", arg0)
+	ret0, _ := ret[0].(*types.StandingOrderRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStandingOrderStoreMockRecorder) Get(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStandingOrderStore)(nil).Get), arg0)
+}
+
+// GetAll mocks base method.
+func (m *MockStandingOrderStore) GetAll() ([]*types.StandingOrderRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAll")
+	ret0, _ := ret[0].([]*types.StandingOrderRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAll indicates an expected call of GetAll.
+func (mr *MockStandingOrderStoreMockRecorder) GetAll() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockStandingOrderStore)(nil).GetAll))
+}
+
+// Put mocks base method.
+func (m *MockStandingOrderStore) Put(arg0 *types.StandingOrderRecord) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockStandingOrderStoreMockRecorder) Put(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStandingOrderStore)(nil).Put), arg0)
+}
+
 // MockStore is a mock of Store interface.
 type MockStore struct {
 	ctrl     *gomock.Controller
@@ -455,6 +745,20 @@ func (m *MockStore) EXPECT() *MockStoreMockRecorder {
 	return m.recorder
 }
 
+// Asset mocks base method.
+func (m *MockStore) Asset() service.AssetStore {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Asset")
+	ret0, _ := ret[0].(service.AssetStore)
+	return ret0
+}
+
+// Asset indicates an expected call of Asset.
+func (mr *MockStoreMockRecorder) Asset() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Asset", reflect.TypeOf((*MockStore)(nil).Asset))
+}
+
 // BlockTransactions mocks base method.
 func (m *MockStore) BlockTransactions() service.BlockTxStore {
 	m.ctrl.T.Helper()
@@ -483,6 +787,34 @@ func (mr *MockStoreMockRecorder) Blockchain() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Blockchain", reflect.TypeOf((*MockStore)(nil).Blockchain))
 }
 
+// Escrow mocks base method.
+func (m *MockStore) Escrow() service.EscrowStore {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Escrow")
+	ret0, _ := ret[0].(service.EscrowStore)
+	return ret0
+}
+
+// Escrow indicates an expected call of Escrow.
+func (mr *MockStoreMockRecorder) Escrow() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Escrow", reflect.TypeOf((*MockStore)(nil).Escrow))
+}
+
+// StandingOrder mocks base method.
+func (m *MockStore) StandingOrder() service.StandingOrderStore {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StandingOrder")
+	ret0, _ := ret[0].(service.StandingOrderStore)
+	return ret0
+}
+
+// StandingOrder indicates an expected call of StandingOrder.
+func (mr *MockStoreMockRecorder) StandingOrder() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StandingOrder", reflect.TypeOf((*MockStore)(nil).StandingOrder))
+}
+
 // Transaction mocks base method.
 func (m *MockStore) Transaction() service.TransactionStore {
 	m.ctrl.T.Helper()
@@ -524,3 +856,237 @@ func (mr *MockStoreMockRecorder) Utxo() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Utxo", reflect.TypeOf((*MockStore)(nil).Utxo))
 }
+
+// MockRaftAPI is a mock of RaftAPI interface.
+type MockRaftAPI struct {
+	ctrl     *gomock.Controller
+	recorder *MockRaftAPIMockRecorder
+}
+
+// MockRaftAPIMockRecorder is the mock recorder for MockRaftAPI.
+type MockRaftAPIMockRecorder struct {
+	mock *MockRaftAPI
+}
+
+// NewMockRaftAPI creates a new mock instance.
+func NewMockRaftAPI(ctrl *gomock.Controller) *MockRaftAPI {
+	mock := &MockRaftAPI{ctrl: ctrl}
+	mock.recorder = &MockRaftAPIMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRaftAPI) EXPECT() *MockRaftAPIMockRecorder {
+	return m.recorder
+}
+
+// Apply mocks base method.
+func (m *MockRaftAPI) Apply(arg0 []byte, arg1 time.Duration) raft.ApplyFuture {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Apply", arg0, arg1)
+	ret0, _ := ret[0].(raft.ApplyFuture)
+	return ret0
+}
+
+// Apply indicates an expected call of Apply.
+func (mr *MockRaftAPIMockRecorder) Apply(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Apply", reflect.TypeOf((*MockRaftAPI)(nil).Apply), arg0, arg1)
+}
+
+// LeaderWithID mocks base method.
+func (m *MockRaftAPI) LeaderWithID() (raft.ServerAddress, raft.ServerID) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaderWithID")
+	ret0, _ := ret[0].(raft.ServerAddress)
+	ret1, _ := ret[1].(raft.ServerID)
+	return ret0, ret1
+}
+
+// LeaderWithID indicates an expected call of LeaderWithID.
+func (mr *MockRaftAPIMockRecorder) LeaderWithID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaderWithID", reflect.TypeOf((*MockRaftAPI)(nil).LeaderWithID))
+}
+
+// MockKeyStore is a mock of KeyStore interface.
+type MockKeyStore struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyStoreMockRecorder
+}
+
+// MockKeyStoreMockRecorder is the mock recorder for MockKeyStore.
+type MockKeyStoreMockRecorder struct {
+	mock *MockKeyStore
+}
+
+// NewMockKeyStore creates a new mock instance.
+func NewMockKeyStore(ctrl *gomock.Controller) *MockKeyStore {
+	mock := &MockKeyStore{ctrl: ctrl}
+	mock.recorder = &MockKeyStoreMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockKeyStore) EXPECT() *MockKeyStoreMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockKeyStore) Delete(arg0 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockKeyStoreMockRecorder) Delete(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKeyStore)(nil).Delete), arg0)
+}
+
+// Key mocks base method.
+func (m *MockKeyStore) Key(arg0 string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Key", arg0)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Key indicates an expected call of Key.
+func (mr *MockKeyStoreMockRecorder) Key(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Key", reflect.TypeOf((*MockKeyStore)(nil).Key), arg0)
+}
+
+// Lock mocks base method.
+func (m *MockKeyStore) Lock(arg0 string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Lock", arg0)
+}
+
+// Lock indicates an expected call of Lock.
+func (mr *MockKeyStoreMockRecorder) Lock(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockKeyStore)(nil).Lock), arg0)
+}
+
+// Replace mocks base method.
+func (m *MockKeyStore) Replace(arg0 string, arg1 []byte, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replace", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Replace indicates an expected call of Replace.
+func (mr *MockKeyStoreMockRecorder) Replace(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replace", reflect.TypeOf((*MockKeyStore)(nil).Replace), arg0, arg1, arg2)
+}
+
+// Store mocks base method.
+func (m *MockKeyStore) Store(arg0 string, arg1 []byte, arg2 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Store", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Store indicates an expected call of Store.
+func (mr *MockKeyStoreMockRecorder) Store(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Store", reflect.TypeOf((*MockKeyStore)(nil).Store), arg0, arg1, arg2)
+}
+
+// Unlock mocks base method.
+func (m *MockKeyStore) Unlock(arg0, arg1 string, arg2 time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockKeyStoreMockRecorder) Unlock(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockKeyStore)(nil).Unlock), arg0, arg1, arg2)
+}
+
+// MockSweeper is a mock of Sweeper interface.
+type MockSweeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockSweeperMockRecorder
+}
+
+// MockSweeperMockRecorder is the mock recorder for MockSweeper.
+type MockSweeperMockRecorder struct {
+	mock *MockSweeper
+}
+
+// NewMockSweeper creates a new mock instance.
+func NewMockSweeper(ctrl *gomock.Controller) *MockSweeper {
+	mock := &MockSweeper{ctrl: ctrl}
+	mock.recorder = &MockSweeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSweeper) EXPECT() *MockSweeperMockRecorder {
+	return m.recorder
+}
+
+// SweepTx mocks base method.
+func (m *MockSweeper) SweepTx(arg0 *types.SweepRequest) (*types.Transaction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SweepTx", arg0)
+	ret0, _ := ret[0].(*types.Transaction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SweepTx indicates an expected call of SweepTx.
+func (mr *MockSweeperMockRecorder) SweepTx(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SweepTx", reflect.TypeOf((*MockSweeper)(nil).SweepTx), arg0)
+}
+
+// MockStandingOrderLister is a mock of StandingOrderLister interface.
+type MockStandingOrderLister struct {
+	ctrl     *gomock.Controller
+	recorder *MockStandingOrderListerMockRecorder
+}
+
+// MockStandingOrderListerMockRecorder is the mock recorder for MockStandingOrderLister.
+type MockStandingOrderListerMockRecorder struct {
+	mock *MockStandingOrderLister
+}
+
+// NewMockStandingOrderLister creates a new mock instance.
+func NewMockStandingOrderLister(ctrl *gomock.Controller) *MockStandingOrderLister {
+	mock := &MockStandingOrderLister{ctrl: ctrl}
+	mock.recorder = &MockStandingOrderListerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStandingOrderLister) EXPECT() *MockStandingOrderListerMockRecorder {
+	return m.recorder
+}
+
+// ListStandingOrders mocks base method.
+func (m *MockStandingOrderLister) ListStandingOrders(arg0 []byte) ([]*types.StandingOrderRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStandingOrders", arg0)
+	ret0, _ := ret[0].([]*types.StandingOrderRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStandingOrders indicates an expected call of ListStandingOrders.
+func (mr *MockStandingOrderListerMockRecorder) ListStandingOrders(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStandingOrders", reflect.TypeOf((*MockStandingOrderLister)(nil).ListStandingOrders), arg0)
+}
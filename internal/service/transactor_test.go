@@ -1,23 +1,34 @@
 package service_test
 
 import (
+	"crypto/ecdsa"
+	"errors"
+	"math"
 	"testing"
+	"time"
 
+	"local-chain/internal/adapters/outbound/inMem"
+	"local-chain/internal/pkg/coinselect"
 	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/pkg/keystore"
+	"local-chain/internal/pkg/merkle"
+	"local-chain/internal/pkg/script"
 
 	"local-chain/internal/service"
 
 	"local-chain/internal/types"
 
 	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/raft"
 	"github.com/stretchr/testify/require"
 )
 
 func TestTransactor_CreateTx(t1 *testing.T) {
 	type args struct {
-		txReq  *types.TransactionRequest
-		store  service.Store
-		txPool service.TxPool
+		txReq   *types.TransactionRequest
+		store   service.Store
+		txPool  service.TxPool
+		raftApi service.RaftAPI
 	}
 	tests := []struct {
 		name       string
@@ -30,7 +41,7 @@ func TestTransactor_CreateTx(t1 *testing.T) {
 			name: "ok full amount",
 			args: func(ctrl *gomock.Controller) args {
 				from := crypto.GenerateKeyEllipticP256()
-				fromPubKey := crypto.PublicKeyToBytes(&from.PublicKey)
+				fromAddress := crypto.PublicKeyHash(&from.PublicKey)
 				to := crypto.GenerateKeyEllipticP256()
 
 				tx1 := types.NewTransaction().WithOutput(types.NewAmount(30), &from.PublicKey)
@@ -38,51 +49,39 @@ func TestTransactor_CreateTx(t1 *testing.T) {
 				tx3 := types.NewTransaction().WithOutput(types.NewAmount(20), &from.PublicKey)
 
 				store := NewMockCustomStore(ctrl)
-				store.TransactionStore.EXPECT().Get(tx1.ID).Return(tx1, nil).Times(1)
-				store.TransactionStore.EXPECT().Get(tx2.ID).Return(tx2, nil).Times(1)
-				store.TransactionStore.EXPECT().Get(tx3.ID).Return(tx3, nil).Times(1)
+				store.UserStore.EXPECT().GetByAddress(fromAddress).Return(nil, nil).Times(1)
 
 				txPool := NewMockTxPool(ctrl)
-				txPool.EXPECT().GetUTXOs(fromPubKey).Return(nil).Times(1)
-				txPool.EXPECT().GetPool().Return(nil).Times(3)
-				txPool.EXPECT().AddUtxos(gomock.Any(), gomock.Any()).Times(1)
-				txPool.EXPECT().AddUtxos(gomock.Any(), gomock.Any()).Times(1)
-				txPool.EXPECT().AddTx(gomock.Any()).Return(nil).Times(1)
-				store.UTXOStore.EXPECT().Get(fromPubKey).Return([]*types.UTXO{
-					{
-						TxHash: tx1.GetHash(),
-						Index:  0,
-						TxID:   tx1.ID,
-					},
-					{
-						TxHash: tx2.GetHash(),
-						Index:  0,
-						TxID:   tx2.ID,
-					},
-					{
-						TxHash: tx3.GetHash(),
-						Index:  0,
-						TxID:   tx3.ID,
-					},
+				txPool.EXPECT().GetUTXOs(fromAddress).Return(nil).Times(1)
+				txPool.EXPECT().IsSpent(gomock.Any()).Return(false).Times(3)
+				raftApi := NewMockRaftAPI(ctrl)
+				raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(applyFuture{}).Times(1)
+				store.UTXOStore.EXPECT().GetByOwner(fromAddress).Return([]*types.UnspentOutput{
+					{UTXO: types.NewUTXO(tx1.ID, tx1.GetHash(), 0), Output: tx1.Outputs[0]},
+					{UTXO: types.NewUTXO(tx2.ID, tx2.GetHash(), 0), Output: tx2.Outputs[0]},
+					{UTXO: types.NewUTXO(tx3.ID, tx3.GetHash(), 0), Output: tx3.Outputs[0]},
 				}, nil).Times(1)
 
 				return args{
 					txReq: &types.TransactionRequest{
 						Sender:   from,
-						Receiver: &to.PublicKey,
+						Receiver: crypto.PublicKeyHash(&to.PublicKey),
 						Amount:   *types.NewAmount(100),
 					},
-					txPool: txPool,
-					store:  store,
+					txPool:  txPool,
+					raftApi: raftApi,
+					store:   store,
 				}
 			},
 			transactor: func(args args) *service.Transactor {
-				t := service.NewTransactor(args.store, args.txPool)
+				t := service.NewTransactor(args.store, args.txPool, args.raftApi, types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
 
 				return t
 			},
 			want: func(args args) *types.Transaction {
-				return types.NewTransaction().WithOutput(types.NewAmount(100), args.txReq.Receiver)
+				tx := types.NewTransaction()
+				tx.AddOutput(types.NewTxOut(tx.ID, *types.NewAmount(100), args.txReq.Receiver))
+				return tx
 			},
 			wantErr: false,
 		},
@@ -94,7 +93,7 @@ func TestTransactor_CreateTx(t1 *testing.T) {
 				fakeFrom := crypto.GenerateKeyEllipticP256()
 				// make fakeFrom have the same public key as "from"
 				fakeFrom.PublicKey = from.PublicKey
-				fakeFromPubKey := crypto.PublicKeyToBytes(&fakeFrom.PublicKey)
+				fakeFromAddress := crypto.PublicKeyHash(&fakeFrom.PublicKey)
 				to := crypto.GenerateKeyEllipticP256()
 
 				tx1 := types.NewTransaction().WithOutput(types.NewAmount(30), &from.PublicKey)
@@ -102,33 +101,21 @@ func TestTransactor_CreateTx(t1 *testing.T) {
 				tx3 := types.NewTransaction().WithOutput(types.NewAmount(20), &from.PublicKey)
 
 				store := NewMockCustomStore(ctrl)
-				store.TransactionStore.EXPECT().Get(tx1.ID).Return(tx1, nil).Times(1)
+				store.UserStore.EXPECT().GetByAddress(fakeFromAddress).Return(nil, nil).Times(1)
 
 				txPool := NewMockTxPool(ctrl)
-				txPool.EXPECT().GetUTXOs(fakeFromPubKey).Return(nil).Times(1)
-				txPool.EXPECT().GetPool().Return(nil).Times(1)
-				store.UTXOStore.EXPECT().Get(fakeFromPubKey).Return([]*types.UTXO{
-					{
-						TxHash: tx1.GetHash(),
-						Index:  0,
-						TxID:   tx1.ID,
-					},
-					{
-						TxHash: tx2.GetHash(),
-						Index:  0,
-						TxID:   tx2.ID,
-					},
-					{
-						TxHash: tx3.GetHash(),
-						Index:  0,
-						TxID:   tx3.ID,
-					},
+				txPool.EXPECT().GetUTXOs(fakeFromAddress).Return(nil).Times(1)
+				txPool.EXPECT().IsSpent(gomock.Any()).Return(false).Times(3)
+				store.UTXOStore.EXPECT().GetByOwner(fakeFromAddress).Return([]*types.UnspentOutput{
+					{UTXO: types.NewUTXO(tx1.ID, tx1.GetHash(), 0), Output: tx1.Outputs[0]},
+					{UTXO: types.NewUTXO(tx2.ID, tx2.GetHash(), 0), Output: tx2.Outputs[0]},
+					{UTXO: types.NewUTXO(tx3.ID, tx3.GetHash(), 0), Output: tx3.Outputs[0]},
 				}, nil).Times(1)
 
 				return args{
 					txReq: &types.TransactionRequest{
 						Sender:   fakeFrom,
-						Receiver: &to.PublicKey,
+						Receiver: crypto.PublicKeyHash(&to.PublicKey),
 						Amount:   *types.NewAmount(100),
 					},
 					txPool: txPool,
@@ -136,12 +123,50 @@ func TestTransactor_CreateTx(t1 *testing.T) {
 				}
 			},
 			transactor: func(args args) *service.Transactor {
-				t := service.NewTransactor(args.store, args.txPool)
+				t := service.NewTransactor(args.store, args.txPool, args.raftApi, types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
 
 				return t
 			},
 			want: func(args args) *types.Transaction {
-				return types.NewTransaction().WithOutput(types.NewAmount(100), args.txReq.Receiver)
+				tx := types.NewTransaction()
+				tx.AddOutput(types.NewTxOut(tx.ID, *types.NewAmount(100), args.txReq.Receiver))
+				return tx
+			},
+			wantErr: true,
+		},
+		{
+			name: "err sender is a disabled user",
+			args: func(ctrl *gomock.Controller) args {
+				from := crypto.GenerateKeyEllipticP256()
+				fromAddress := crypto.PublicKeyHash(&from.PublicKey)
+				to := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+
+				store := NewMockCustomStore(ctrl)
+				store.UTXOStore.EXPECT().GetByOwner(fromAddress).Return([]*types.UnspentOutput{
+					{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0), Output: prevTx.Outputs[0]},
+				}, nil).Times(1)
+				store.UserStore.EXPECT().GetByAddress(fromAddress).Return(&types.User{
+					Username:  "alice",
+					PublicKey: crypto.PublicKeyToBytes(&from.PublicKey),
+					Status:    types.UserDisabled,
+				}, nil).Times(1)
+				txPool := NewMockTxPool(ctrl)
+				txPool.EXPECT().GetUTXOs(fromAddress).Return(nil).Times(1)
+				txPool.EXPECT().IsSpent(gomock.Any()).Return(false).Times(1)
+
+				return args{
+					txReq: &types.TransactionRequest{
+						Sender:   from,
+						Receiver: crypto.PublicKeyHash(&to.PublicKey),
+						Amount:   *types.NewAmount(100),
+					},
+					txPool: txPool,
+					store:  store,
+				}
+			},
+			transactor: func(args args) *service.Transactor {
+				return service.NewTransactor(args.store, args.txPool, args.raftApi, types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
 			},
 			wantErr: true,
 		},
@@ -162,7 +187,1641 @@ func TestTransactor_CreateTx(t1 *testing.T) {
 			}
 			want := tt.want(tArgs)
 			require.Equal(t1, want.Outputs[0].Amount, newTx.Outputs[0].Amount)
-			require.Equal(t1, want.Outputs[0].PubKey, newTx.Outputs[0].PubKey)
+			require.Equal(t1, want.Outputs[0].Address, newTx.Outputs[0].Address)
+		})
+	}
+}
+
+func TestTransactor_CreateBatchTx(t1 *testing.T) {
+	tests := []struct {
+		name     string
+		payments []uint64
+		balance  uint64
+		lockTime uint32
+		wantErr  bool
+	}{
+		{
+			name:     "ok output per payment and change",
+			payments: []uint64{10, 20, 30},
+			balance:  100,
+			wantErr:  false,
+		},
+		{
+			name:     "ok post-dated payout with a lock time",
+			payments: []uint64{10},
+			balance:  100,
+			lockTime: 42,
+			wantErr:  false,
+		},
+		{
+			name:     "err insufficient balance",
+			payments: []uint64{60, 50},
+			balance:  100,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			ctrl := gomock.NewController(t1)
+			from := crypto.GenerateKeyEllipticP256()
+			fromAddress := crypto.PublicKeyHash(&from.PublicKey)
+			prevTx := types.NewTransaction().WithOutput(types.NewAmount(tt.balance), &from.PublicKey)
+
+			store := NewMockCustomStore(ctrl)
+			store.UTXOStore.EXPECT().GetByOwner(fromAddress).Return([]*types.UnspentOutput{
+				{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0), Output: prevTx.Outputs[0]},
+			}, nil).Times(1)
+			txPool := NewMockTxPool(ctrl)
+			txPool.EXPECT().GetUTXOs(fromAddress).Return(nil).Times(1)
+			txPool.EXPECT().IsSpent(gomock.Any()).Return(false).Times(1)
+			raftApi := NewMockRaftAPI(ctrl)
+			if !tt.wantErr {
+				// the sender is checked when it signs, a transaction that can't be built isn't signed
+				store.UserStore.EXPECT().GetByAddress(fromAddress).Return(nil, nil).Times(1)
+				raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(applyFuture{}).Times(1)
+			}
+
+			txReq := &types.BatchTransactionRequest{Sender: from, LockTime: tt.lockTime}
+			for _, amount := range tt.payments {
+				to := crypto.GenerateKeyEllipticP256()
+				txReq.Payments = append(txReq.Payments, types.Payment{Receiver: crypto.PublicKeyHash(&to.PublicKey), Amount: *types.NewAmount(amount)})
+			}
+			transactor := service.NewTransactor(store, txPool, raftApi, types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
+			tx, err := transactor.CreateBatchTx(txReq)
+			if tt.wantErr {
+				require.Error(t1, err)
+				return
+			}
+			require.NoError(t1, err)
+			require.Len(t1, tx.Outputs, len(tt.payments)+1)
+			var paid uint64
+			for i, payment := range txReq.Payments {
+				require.Equal(t1, payment.Receiver, tx.Outputs[i].Address)
+				require.Equal(t1, payment.Amount.Value, tx.Outputs[i].Amount.Value)
+				paid += payment.Amount.Value
+			}
+			change := tx.Outputs[len(tx.Outputs)-1]
+			require.Equal(t1, fromAddress, change.Address)
+			require.Equal(t1, tt.balance-paid, change.Amount.Value)
+			require.Equal(t1, tt.lockTime, tx.LockTime)
+			// the lock time is in force only while some input is not final
+			require.Equal(t1, tt.lockTime == 0, tx.IsFinal(0, 0))
+		})
+	}
+}
+
+func TestTransactor_SubmitTx(t1 *testing.T) {
+	type args struct {
+		tx          *types.Transaction
+		store       service.Store
+		txPool      service.TxPool
+		raftApi     service.RaftAPI
+		minRelayFee uint64
+	}
+	tests := []struct {
+		name    string
+		args    func(ctrl *gomock.Controller) args
+		wantErr bool
+	}{
+		{
+			name: "ok signed by owner",
+			args: func(ctrl *gomock.Controller) args {
+				from := crypto.GenerateKeyEllipticP256()
+				fromPubKey := crypto.PublicKeyToBytes(&from.PublicKey)
+				to := crypto.GenerateKeyEllipticP256()
+
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				tx := types.NewTransaction().
+					WithInputs(types.NewTxIn(utxo, fromPubKey, nil, nil, 0)).
+					WithOutput(types.NewAmount(60), &to.PublicKey).
+					WithOutput(types.NewAmount(40), &from.PublicKey)
+				require.NoError(t1, tx.SignInputs(from, types.DefaultChainID))
+
+				store := NewMockCustomStore(ctrl)
+				// the signing keys are of no user
+				store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+				store.TransactionStore.EXPECT().Has(tx.ID).Return(false, nil).Times(1)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				txPool := NewMockTxPool(ctrl)
+				txPool.EXPECT().Get(gomock.Any()).Return(nil, false).Times(1)
+				raftApi := NewMockRaftAPI(ctrl)
+				raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(applyFuture{}).Times(1)
+
+				return args{tx: tx, store: store, txPool: txPool, raftApi: raftApi}
+			},
+			wantErr: false,
+		},
+		{
+			name: "err signed by a disabled user",
+			args: func(ctrl *gomock.Controller) args {
+				from := crypto.GenerateKeyEllipticP256()
+				fromPubKey := crypto.PublicKeyToBytes(&from.PublicKey)
+				to := crypto.GenerateKeyEllipticP256()
+
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				tx := types.NewTransaction().
+					WithInputs(types.NewTxIn(utxo, fromPubKey, nil, nil, 0)).
+					WithOutput(types.NewAmount(60), &to.PublicKey).
+					WithOutput(types.NewAmount(40), &from.PublicKey)
+				require.NoError(t1, tx.SignInputs(from, types.DefaultChainID))
+
+				store := NewMockCustomStore(ctrl)
+				store.UserStore.EXPECT().GetByAddress(crypto.PublicKeyHash(&from.PublicKey)).Return(&types.User{
+					Username:  "alice",
+					PublicKey: fromPubKey,
+					Status:    types.UserDisabled,
+				}, nil).Times(1)
+				store.TransactionStore.EXPECT().Has(tx.ID).Return(false, nil).Times(1)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				txPool := NewMockTxPool(ctrl)
+				txPool.EXPECT().Get(gomock.Any()).Return(nil, false).Times(1)
+
+				return args{tx: tx, store: store, txPool: txPool}
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok pays fee above minimum relay fee",
+			args: func(ctrl *gomock.Controller) args {
+				from := crypto.GenerateKeyEllipticP256()
+				fromPubKey := crypto.PublicKeyToBytes(&from.PublicKey)
+				to := crypto.GenerateKeyEllipticP256()
+
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100000), &from.PublicKey)
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				tx := types.NewTransaction().
+					WithInputs(types.NewTxIn(utxo, fromPubKey, nil, nil, 0)).
+					WithOutput(types.NewAmount(60000), &to.PublicKey).
+					WithOutput(types.NewAmount(30000), &from.PublicKey)
+				tx.Fee = 10000
+				require.NoError(t1, tx.SignInputs(from, types.DefaultChainID))
+
+				store := NewMockCustomStore(ctrl)
+				// the signing keys are of no user
+				store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+				store.TransactionStore.EXPECT().Has(tx.ID).Return(false, nil).Times(1)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				txPool := NewMockTxPool(ctrl)
+				txPool.EXPECT().Get(gomock.Any()).Return(nil, false).Times(1)
+				raftApi := NewMockRaftAPI(ctrl)
+				raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(applyFuture{}).Times(1)
+
+				return args{tx: tx, store: store, txPool: txPool, raftApi: raftApi, minRelayFee: 1000}
+			},
+			wantErr: false,
+		},
+		{
+			name: "err fee below minimum relay fee",
+			args: func(ctrl *gomock.Controller) args {
+				from := crypto.GenerateKeyEllipticP256()
+				fromPubKey := crypto.PublicKeyToBytes(&from.PublicKey)
+				to := crypto.GenerateKeyEllipticP256()
+
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				tx := types.NewTransaction().
+					WithInputs(types.NewTxIn(utxo, fromPubKey, nil, nil, 0)).
+					WithOutput(types.NewAmount(99), &to.PublicKey)
+				tx.Fee = 1
+				require.NoError(t1, tx.SignInputs(from, types.DefaultChainID))
+
+				store := NewMockCustomStore(ctrl)
+				// the signing keys are of no user
+				store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+				store.TransactionStore.EXPECT().Has(tx.ID).Return(false, nil).Times(1)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				txPool := NewMockTxPool(ctrl)
+				txPool.EXPECT().Get(gomock.Any()).Return(nil, false).Times(1)
+
+				return args{tx: tx, store: store, txPool: txPool, minRelayFee: 1000}
+			},
+			wantErr: true,
+		},
+		{
+			name: "err minimum relay fee overflows",
+			args: func(ctrl *gomock.Controller) args {
+				from := crypto.GenerateKeyEllipticP256()
+				fromPubKey := crypto.PublicKeyToBytes(&from.PublicKey)
+				to := crypto.GenerateKeyEllipticP256()
+
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(math.MaxUint64), &from.PublicKey)
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				tx := types.NewTransaction().
+					WithInputs(types.NewTxIn(utxo, fromPubKey, nil, nil, 0)).
+					WithOutput(types.NewAmount(1), &to.PublicKey)
+				// no fee pays the minimum relay fee at that rate, it doesn't wrap around to a low one
+				tx.Fee = math.MaxUint64 - 1
+				require.NoError(t1, tx.SignInputs(from, types.DefaultChainID))
+
+				store := NewMockCustomStore(ctrl)
+				// the signing keys are of no user
+				store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+				store.TransactionStore.EXPECT().Has(tx.ID).Return(false, nil).Times(1)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				txPool := NewMockTxPool(ctrl)
+				txPool.EXPECT().Get(gomock.Any()).Return(nil, false).Times(1)
+
+				return args{tx: tx, store: store, txPool: txPool, minRelayFee: math.MaxUint64}
+			},
+			wantErr: true,
+		},
+		{
+			name: "err output spent by a pending transaction",
+			args: func(ctrl *gomock.Controller) args {
+				from := crypto.GenerateKeyEllipticP256()
+				fromPubKey := crypto.PublicKeyToBytes(&from.PublicKey)
+				to := crypto.GenerateKeyEllipticP256()
+
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				tx := types.NewTransaction().
+					WithInputs(types.NewTxIn(utxo, fromPubKey, nil, nil, 0)).
+					WithOutput(types.NewAmount(100), &to.PublicKey)
+				require.NoError(t1, tx.SignInputs(from, types.DefaultChainID))
+
+				store := NewMockCustomStore(ctrl)
+				// the signing keys are of no user
+				store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+				store.TransactionStore.EXPECT().Has(tx.ID).Return(false, nil).Times(1)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				txPool := NewMockTxPool(ctrl)
+				txPool.EXPECT().Get(gomock.Any()).Return(nil, false).Times(1)
+				// the FSM rejects the transaction when it puts it into the pool
+				raftApi := NewMockRaftAPI(ctrl)
+				raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).
+					Return(applyFuture{response: inMem.ErrOutputAlreadySpent}).Times(1)
+
+				return args{tx: tx, store: store, txPool: txPool, raftApi: raftApi}
+			},
+			wantErr: true,
+		},
+		{
+			name: "err signed by foreign key",
+			args: func(ctrl *gomock.Controller) args {
+				from := crypto.GenerateKeyEllipticP256()
+				fromPubKey := crypto.PublicKeyToBytes(&from.PublicKey)
+				thief := crypto.GenerateKeyEllipticP256()
+
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				tx := types.NewTransaction().
+					WithInputs(types.NewTxIn(utxo, fromPubKey, nil, nil, 0)).
+					WithOutput(types.NewAmount(100), &thief.PublicKey)
+				// the thief signs on behalf of the owner's public key
+				thiefKey := *thief
+				thiefKey.PublicKey = from.PublicKey
+				require.NoError(t1, tx.SignInputs(&thiefKey, types.DefaultChainID))
+
+				store := NewMockCustomStore(ctrl)
+				// the signing keys are of no user
+				store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+				store.TransactionStore.EXPECT().Has(tx.ID).Return(false, nil).Times(1)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				txPool := NewMockTxPool(ctrl)
+				txPool.EXPECT().Get(gomock.Any()).Return(nil, false).Times(1)
+
+				return args{tx: tx, store: store, txPool: txPool}
+			},
+			wantErr: true,
+		},
+		{
+			name: "err signature replayed into another transaction",
+			args: func(ctrl *gomock.Controller) args {
+				from := crypto.GenerateKeyEllipticP256()
+				fromPubKey := crypto.PublicKeyToBytes(&from.PublicKey)
+				to := crypto.GenerateKeyEllipticP256()
+				thief := crypto.GenerateKeyEllipticP256()
+
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				signed := types.NewTransaction().
+					WithInputs(types.NewTxIn(utxo, fromPubKey, nil, nil, 0)).
+					WithOutput(types.NewAmount(100), &to.PublicKey)
+				require.NoError(t1, signed.SignInputs(from, types.DefaultChainID))
+				// the observed input is copied into a transaction that pays the thief
+				tx := types.NewTransaction().
+					WithInputs(signed.Inputs[0]).
+					WithOutput(types.NewAmount(100), &thief.PublicKey)
+
+				store := NewMockCustomStore(ctrl)
+				// the signing keys are of no user
+				store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+				store.TransactionStore.EXPECT().Has(tx.ID).Return(false, nil).Times(1)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				txPool := NewMockTxPool(ctrl)
+				txPool.EXPECT().Get(gomock.Any()).Return(nil, false).Times(1)
+
+				return args{tx: tx, store: store, txPool: txPool}
+			},
+			wantErr: true,
+		},
+		{
+			name: "err outputs exceed inputs",
+			args: func(ctrl *gomock.Controller) args {
+				from := crypto.GenerateKeyEllipticP256()
+				fromPubKey := crypto.PublicKeyToBytes(&from.PublicKey)
+
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				tx := types.NewTransaction().
+					WithInputs(types.NewTxIn(utxo, fromPubKey, nil, nil, 0)).
+					WithOutput(types.NewAmount(150), &from.PublicKey)
+				require.NoError(t1, tx.SignInputs(from, types.DefaultChainID))
+
+				store := NewMockCustomStore(ctrl)
+				// the signing keys are of no user
+				store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+				store.TransactionStore.EXPECT().Has(tx.ID).Return(false, nil).Times(1)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				txPool := NewMockTxPool(ctrl)
+				txPool.EXPECT().Get(gomock.Any()).Return(nil, false).Times(1)
+
+				return args{tx: tx, store: store, txPool: txPool}
+			},
+			wantErr: true,
+		},
+		{
+			name: "err transaction already confirmed",
+			args: func(ctrl *gomock.Controller) args {
+				from := crypto.GenerateKeyEllipticP256()
+				fromPubKey := crypto.PublicKeyToBytes(&from.PublicKey)
+
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				tx := types.NewTransaction().
+					WithInputs(types.NewTxIn(utxo, fromPubKey, nil, nil, 0)).
+					WithOutput(types.NewAmount(100), &from.PublicKey)
+				require.NoError(t1, tx.SignInputs(from, types.DefaultChainID))
+
+				// its outputs would overwrite the outputs of the confirmed transaction
+				store := NewMockCustomStore(ctrl)
+				// the signing keys are of no user
+				store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+				store.TransactionStore.EXPECT().Has(tx.ID).Return(true, nil).Times(1)
+
+				return args{tx: tx, store: store, txPool: NewMockTxPool(ctrl)}
+			},
+			wantErr: true,
+		},
+		{
+			name: "err outputs value overflows with the fee",
+			args: func(ctrl *gomock.Controller) args {
+				from := crypto.GenerateKeyEllipticP256()
+				fromPubKey := crypto.PublicKeyToBytes(&from.PublicKey)
+
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				// the output and the fee wrap around to the value of the input
+				tx := types.NewTransaction().
+					WithInputs(types.NewTxIn(utxo, fromPubKey, nil, nil, 0)).
+					WithOutput(types.NewAmount(math.MaxUint64), &from.PublicKey)
+				tx.Fee = 101
+				require.NoError(t1, tx.SignInputs(from, types.DefaultChainID))
+
+				store := NewMockCustomStore(ctrl)
+				// the signing keys are of no user
+				store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+				store.TransactionStore.EXPECT().Has(tx.ID).Return(false, nil).Times(1)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				txPool := NewMockTxPool(ctrl)
+				txPool.EXPECT().Get(gomock.Any()).Return(nil, false).Times(1)
+
+				return args{tx: tx, store: store, txPool: txPool}
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			ctrl := gomock.NewController(t1)
+			tArgs := tt.args(ctrl)
+			transactor := service.NewTransactor(tArgs.store, tArgs.txPool, tArgs.raftApi, types.DefaultChainID, tArgs.minRelayFee, coinselect.LargestFirst{}, nil, nil)
+			tx, err := transactor.SubmitTx(tArgs.tx)
+			if tt.wantErr {
+				require.Error(t1, err)
+				return
+			}
+			require.NoError(t1, err)
+			require.Equal(t1, tArgs.tx.ID, tx.ID)
 		})
 	}
 }
+
+func TestTransactor_MultisigTx(t1 *testing.T) {
+	tests := []struct {
+		name    string
+		signers []int
+		tamper  func(psbt *types.PartiallySignedTx)
+		wantErr bool
+	}{
+		{
+			name:    "ok 2 of 3 signatures collected on separate copies",
+			signers: []int{0, 2},
+			wantErr: false,
+		},
+		{
+			name:    "err 1 of 2 required signatures",
+			signers: []int{1},
+			wantErr: true,
+		},
+		{
+			name:    "err the same key signed twice",
+			signers: []int{1},
+			tamper: func(psbt *types.PartiallySignedTx) {
+				in := psbt.Tx.Inputs[0]
+				in.Signatures = append(in.Signatures, in.Signatures[0])
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			ctrl := gomock.NewController(t1)
+			keys := []*ecdsa.PrivateKey{
+				crypto.GenerateKeyEllipticP256(),
+				crypto.GenerateKeyEllipticP256(),
+				crypto.GenerateKeyEllipticP256(),
+			}
+			lock, err := types.NewMultisigLock(2, &keys[0].PublicKey, &keys[1].PublicKey, &keys[2].PublicKey)
+			require.NoError(t1, err)
+			to := crypto.GenerateKeyEllipticP256()
+			prevTx := types.NewTransaction()
+			prevTx.AddOutput(types.NewMultisigTxOut(prevTx.ID, *types.NewAmount(100), lock))
+			utxo := &types.UnspentOutput{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0), Output: prevTx.Outputs[0]}
+
+			store := NewMockCustomStore(ctrl)
+			// the signing keys are of no user
+			store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+			// the transactions built by the node are new
+			store.TransactionStore.EXPECT().Has(gomock.Any()).Return(false, nil).AnyTimes()
+			store.UTXOStore.EXPECT().GetByOwner(lock.Owner()).Return([]*types.UnspentOutput{utxo}, nil).Times(1)
+			store.UTXOStore.EXPECT().Get(utxo.UTXO).Return(utxo, nil).AnyTimes()
+			txPool := NewMockTxPool(ctrl)
+			txPool.EXPECT().IsSpent(utxo.UTXO).Return(false).Times(1)
+			txPool.EXPECT().GetUTXOs(lock.Owner()).Return(nil).Times(1)
+			txPool.EXPECT().Get(gomock.Any()).Return(nil, false).AnyTimes()
+			raftApi := NewMockRaftAPI(ctrl)
+			if !tt.wantErr {
+				raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(applyFuture{}).Times(1)
+			}
+
+			transactor := service.NewTransactor(store, txPool, raftApi, types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
+			psbt, err := transactor.CreateMultisigTx(&types.MultisigTransactionRequest{
+				Lock:     lock,
+				Payments: []types.Payment{{Receiver: crypto.PublicKeyHash(&to.PublicKey), Amount: *types.NewAmount(60)}},
+			})
+			require.NoError(t1, err)
+			change := psbt.Tx.Outputs[len(psbt.Tx.Outputs)-1]
+			require.Equal(t1, lock.Owner(), change.Owner())
+			require.Equal(t1, uint64(40), change.Amount.Value)
+
+			// every signer signs its own copy of the blob, the copies are combined afterwards
+			blob, err := psbt.Encode()
+			require.NoError(t1, err)
+			for _, signer := range tt.signers {
+				signed, err := types.DecodePartiallySignedTx(blob)
+				require.NoError(t1, err)
+				_, err = signed.Sign(keys[signer], types.DefaultChainID)
+				require.NoError(t1, err)
+				require.NoError(t1, psbt.Combine(signed))
+			}
+			if tt.tamper != nil {
+				tt.tamper(psbt)
+			}
+			tx, err := transactor.SubmitPartiallySignedTx(psbt)
+			if tt.wantErr {
+				require.Error(t1, err)
+				return
+			}
+			require.NoError(t1, err)
+			require.Equal(t1, psbt.Tx.ID, tx.ID)
+		})
+	}
+}
+
+func TestTransactor_HTLC(t1 *testing.T) {
+	secret := []byte("secret")
+	tests := []struct {
+		name     string
+		deadline uint32
+		spend    func(transactor *service.Transactor, sender, receiver *ecdsa.PrivateKey, outpoint *types.UTXO) (*types.Transaction, error)
+		wantErr  bool
+	}{
+		{
+			name:     "ok receiver claims with the secret before the deadline",
+			deadline: 20,
+			spend: func(transactor *service.Transactor, sender, receiver *ecdsa.PrivateKey, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.ClaimHTLC(&types.HTLCClaimRequest{Receiver: receiver, Outpoint: outpoint, Preimage: secret, Fee: 1})
+			},
+			wantErr: false,
+		},
+		{
+			name:     "err receiver claims with a wrong secret",
+			deadline: 20,
+			spend: func(transactor *service.Transactor, sender, receiver *ecdsa.PrivateKey, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.ClaimHTLC(&types.HTLCClaimRequest{Receiver: receiver, Outpoint: outpoint, Preimage: []byte("guess"), Fee: 1})
+			},
+			wantErr: true,
+		},
+		{
+			name:     "err sender claims with the secret",
+			deadline: 20,
+			spend: func(transactor *service.Transactor, sender, receiver *ecdsa.PrivateKey, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.ClaimHTLC(&types.HTLCClaimRequest{Receiver: sender, Outpoint: outpoint, Preimage: secret, Fee: 1})
+			},
+			wantErr: true,
+		},
+		{
+			name:     "err receiver claims after the deadline",
+			deadline: 5,
+			spend: func(transactor *service.Transactor, sender, receiver *ecdsa.PrivateKey, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.ClaimHTLC(&types.HTLCClaimRequest{Receiver: receiver, Outpoint: outpoint, Preimage: secret, Fee: 1})
+			},
+			wantErr: true,
+		},
+		{
+			name:     "ok sender refunds after the deadline",
+			deadline: 5,
+			spend: func(transactor *service.Transactor, sender, receiver *ecdsa.PrivateKey, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.RefundHTLC(&types.HTLCRefundRequest{Sender: sender, Outpoint: outpoint, Fee: 1})
+			},
+			wantErr: false,
+		},
+		{
+			name:     "err sender refunds before the deadline",
+			deadline: 20,
+			spend: func(transactor *service.Transactor, sender, receiver *ecdsa.PrivateKey, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.RefundHTLC(&types.HTLCRefundRequest{Sender: sender, Outpoint: outpoint, Fee: 1})
+			},
+			wantErr: true,
+		},
+		{
+			name:     "err receiver refunds after the deadline",
+			deadline: 5,
+			spend: func(transactor *service.Transactor, sender, receiver *ecdsa.PrivateKey, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.RefundHTLC(&types.HTLCRefundRequest{Sender: receiver, Outpoint: outpoint, Fee: 1})
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			ctrl := gomock.NewController(t1)
+			sender := crypto.GenerateKeyEllipticP256()
+			receiver := crypto.GenerateKeyEllipticP256()
+			lockingScript, err := script.HTLC(script.HTLCTerms{
+				Hash:     types.HashPreimage(secret),
+				Receiver: crypto.PublicKeyToBytes(&receiver.PublicKey),
+				Sender:   crypto.PublicKeyToBytes(&sender.PublicKey),
+				Deadline: tt.deadline,
+			})
+			require.NoError(t1, err)
+			prevTx := types.NewTransaction()
+			prevTx.AddOutput(types.NewScriptTxOut(prevTx.ID, *types.NewAmount(100), lockingScript))
+			prevTx.ComputeHash()
+			utxo := &types.UnspentOutput{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0), Output: prevTx.Outputs[0]}
+
+			store := NewMockCustomStore(ctrl)
+			// the signing keys are of no user
+			store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+			// the transactions built by the node are new
+			store.TransactionStore.EXPECT().Has(gomock.Any()).Return(false, nil).AnyTimes()
+			store.UTXOStore.EXPECT().Get(utxo.UTXO).Return(utxo, nil).AnyTimes()
+			store.BStore.EXPECT().GetLast().Return(&types.Block{Height: 10}, nil).AnyTimes()
+			txPool := NewMockTxPool(ctrl)
+			txPool.EXPECT().IsSpent(utxo.UTXO).Return(false).Times(1)
+			txPool.EXPECT().Get(gomock.Any()).Return(nil, false).AnyTimes()
+			raftApi := NewMockRaftAPI(ctrl)
+			if !tt.wantErr {
+				raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(applyFuture{}).Times(1)
+			}
+
+			transactor := service.NewTransactor(store, txPool, raftApi, types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
+			tx, err := tt.spend(transactor, sender, receiver, utxo.UTXO)
+			if tt.wantErr {
+				require.Error(t1, err)
+				return
+			}
+			require.NoError(t1, err)
+			require.Equal(t1, uint64(99), tx.Outputs[0].Amount.Value)
+		})
+	}
+}
+
+func TestTransactor_ProveNotarization(t1 *testing.T) {
+	docHash := []byte("document hash")
+	tests := []struct {
+		name    string
+		store   func(ctrl *gomock.Controller) service.Store
+		wantErr bool
+	}{
+		{
+			name: "ok proof links the anchoring transaction to its block",
+			store: func(ctrl *gomock.Controller) service.Store {
+				tx := types.NewTransaction()
+				out, err := types.NewDataTxOut(tx.ID, docHash)
+				require.NoError(t1, err)
+				tx.AddOutput(out)
+				tx.ComputeHash()
+				txs := types.Transactions{types.NewTransaction(), tx, types.NewTransaction()}
+				for _, tx := range txs {
+					tx.ComputeHash()
+				}
+				tree, err := merkle.NewMerkleTree(txs...)
+				require.NoError(t1, err)
+				block := types.NewBlock(1, nil, tree.Root.Hash)
+				tx.BlockTimestamp = block.Timestamp
+
+				store := NewMockCustomStore(ctrl)
+				store.TransactionStore.EXPECT().GetByData(docHash).Return(tx, nil).Times(1)
+				store.BStore.EXPECT().GetByTimestamp(block.Timestamp).Return(block, nil).Times(1)
+				store.BlockTxStore.EXPECT().GetByBlockTimestamp(block.Timestamp).Return(txs, nil).Times(1)
+				return store
+			},
+			wantErr: false,
+		},
+		{
+			name: "err block transactions do not match the merkle root",
+			store: func(ctrl *gomock.Controller) service.Store {
+				tx := types.NewTransaction()
+				out, err := types.NewDataTxOut(tx.ID, docHash)
+				require.NoError(t1, err)
+				tx.AddOutput(out)
+				tx.ComputeHash()
+				block := types.NewBlock(1, nil, []byte("root"))
+				tx.BlockTimestamp = block.Timestamp
+
+				store := NewMockCustomStore(ctrl)
+				store.TransactionStore.EXPECT().GetByData(docHash).Return(tx, nil).Times(1)
+				store.BStore.EXPECT().GetByTimestamp(block.Timestamp).Return(block, nil).Times(1)
+				store.BlockTxStore.EXPECT().GetByBlockTimestamp(block.Timestamp).Return(types.Transactions{tx}, nil).Times(1)
+				return store
+			},
+			wantErr: true,
+		},
+		{
+			name: "err data not anchored",
+			store: func(ctrl *gomock.Controller) service.Store {
+				store := NewMockCustomStore(ctrl)
+				store.TransactionStore.EXPECT().GetByData(docHash).Return(nil, errors.New("not found")).Times(1)
+				return store
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			ctrl := gomock.NewController(t1)
+			transactor := service.NewTransactor(tt.store(ctrl), NewMockTxPool(ctrl), NewMockRaftAPI(ctrl), types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
+			notarization, err := transactor.ProveNotarization(docHash)
+			if tt.wantErr {
+				require.Error(t1, err)
+				return
+			}
+			require.NoError(t1, err)
+			require.Equal(t1, docHash, notarization.Tx.Outputs[0].Data())
+			require.True(t1, merkle.VerifyProof(notarization.Block.MerkleRoot, notarization.Tx.Hash, notarization.Proof))
+		})
+	}
+}
+
+func TestTransactor_Assets(t1 *testing.T) {
+	assetID := []byte("asset")
+	tests := []struct {
+		name    string
+		action  func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey) (*types.Transaction, error)
+		check   func(t1 *testing.T, tx *types.Transaction, owner *ecdsa.PrivateKey)
+		wantErr bool
+	}{
+		{
+			name: "ok issuance mints the supply to the issuer",
+			action: func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey) (*types.Transaction, error) {
+				return transactor.IssueAsset(&types.AssetIssueRequest{Issuer: owner, Name: "GOLD", Decimals: 2, Supply: 1000, Fee: 5})
+			},
+			check: func(t1 *testing.T, tx *types.Transaction, owner *ecdsa.PrivateKey) {
+				require.NotNil(t1, tx.Issuance)
+				require.Equal(t1, types.NewAssetID(tx.ID, "GOLD"), tx.Issuance.ID)
+				require.Equal(t1, tx.Issuance.ID, tx.Outputs[0].AssetID)
+				require.Equal(t1, uint64(1000), tx.Outputs[0].Amount.Value)
+				require.Equal(t1, crypto.PublicKeyHash(&owner.PublicKey), tx.Outputs[0].Address)
+				// the fee is paid with the native coin
+				require.False(t1, tx.Outputs[1].IsAsset())
+				require.Equal(t1, uint64(95), tx.Outputs[1].Amount.Value)
+			},
+			wantErr: false,
+		},
+		{
+			name: "err issuance without a name",
+			action: func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey) (*types.Transaction, error) {
+				return transactor.IssueAsset(&types.AssetIssueRequest{Issuer: owner, Supply: 1000, Fee: 5})
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok asset payment changes the asset and the native coin apart",
+			action: func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey) (*types.Transaction, error) {
+				return transactor.CreateBatchTx(&types.BatchTransactionRequest{
+					Sender:   owner,
+					Payments: []types.Payment{{Receiver: crypto.PublicKeyHash(&receiver.PublicKey), Amount: *types.NewAmount(20), AssetID: assetID}},
+					Fee:      5,
+				})
+			},
+			check: func(t1 *testing.T, tx *types.Transaction, owner *ecdsa.PrivateKey) {
+				require.Len(t1, tx.Inputs, 2)
+				require.Len(t1, tx.Outputs, 3)
+				require.Equal(t1, assetID, tx.Outputs[0].AssetID)
+				require.Equal(t1, uint64(20), tx.Outputs[0].Amount.Value)
+				require.Nil(t1, tx.Outputs[1].AssetID)
+				require.Equal(t1, uint64(95), tx.Outputs[1].Amount.Value)
+				require.Equal(t1, assetID, tx.Outputs[2].AssetID)
+				require.Equal(t1, uint64(30), tx.Outputs[2].Amount.Value)
+			},
+			wantErr: false,
+		},
+		{
+			name: "err insufficient asset balance",
+			action: func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey) (*types.Transaction, error) {
+				return transactor.CreateBatchTx(&types.BatchTransactionRequest{
+					Sender:   owner,
+					Payments: []types.Payment{{Receiver: crypto.PublicKeyHash(&receiver.PublicKey), Amount: *types.NewAmount(60), AssetID: assetID}},
+					Fee:      5,
+				})
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			ctrl := gomock.NewController(t1)
+			owner := crypto.GenerateKeyEllipticP256()
+			receiver := crypto.GenerateKeyEllipticP256()
+			ownerAddress := crypto.PublicKeyHash(&owner.PublicKey)
+			prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &owner.PublicKey)
+			assetOut := types.NewTxOut(prevTx.ID, *types.NewAmount(50), ownerAddress)
+			assetOut.AssetID = assetID
+			prevTx.AddOutput(assetOut)
+			prevTx.ComputeHash()
+			utxos := []*types.UnspentOutput{
+				{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0), Output: prevTx.Outputs[0]},
+				{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), 1), Output: prevTx.Outputs[1]},
+			}
+
+			store := NewMockCustomStore(ctrl)
+			// the signing key is of no user
+			store.UserStore.EXPECT().GetByAddress(ownerAddress).Return(nil, nil).AnyTimes()
+			store.UTXOStore.EXPECT().GetByOwner(ownerAddress).Return(utxos, nil).Times(1)
+			txPool := NewMockTxPool(ctrl)
+			txPool.EXPECT().GetUTXOs(ownerAddress).Return(nil).Times(1)
+			txPool.EXPECT().IsSpent(gomock.Any()).Return(false).Times(len(utxos))
+			raftApi := NewMockRaftAPI(ctrl)
+			if !tt.wantErr {
+				raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(applyFuture{}).Times(1)
+			}
+
+			transactor := service.NewTransactor(store, txPool, raftApi, types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
+			tx, err := tt.action(transactor, owner, receiver)
+			if tt.wantErr {
+				require.Error(t1, err)
+				return
+			}
+			require.NoError(t1, err)
+			tt.check(t1, tx, owner)
+			// every asset is conserved on its own
+			spent := make([]*types.TxOut, 0, len(tx.Inputs))
+			for _, in := range tx.Inputs {
+				spent = append(spent, prevTx.Outputs[in.Prev.Index])
+			}
+			require.NoError(t1, types.CheckValues(tx, spent))
+		})
+	}
+}
+
+func TestTransactor_GetBalance(t1 *testing.T) {
+	ctrl := gomock.NewController(t1)
+	owner := crypto.GenerateKeyEllipticP256()
+	ownerAddress := crypto.PublicKeyHash(&owner.PublicKey)
+	gold := &types.Asset{ID: []byte("gold"), Name: "GOLD", Supply: 1000}
+	prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &owner.PublicKey)
+	for _, out := range []struct {
+		assetID []byte
+		value   uint64
+	}{{gold.ID, 30}, {[]byte("silver"), 7}, {gold.ID, 12}} {
+		txOut := types.NewTxOut(prevTx.ID, *types.NewAmount(out.value), ownerAddress)
+		txOut.AssetID = out.assetID
+		prevTx.AddOutput(txOut)
+	}
+	prevTx.ComputeHash()
+	utxos := make([]*types.UnspentOutput, 0, len(prevTx.Outputs))
+	for i, out := range prevTx.Outputs {
+		utxos = append(utxos, &types.UnspentOutput{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), uint32(i)), Output: out})
+	}
+
+	store := NewMockCustomStore(ctrl)
+	store.UTXOStore.EXPECT().GetByOwner(ownerAddress).Return(utxos, nil).Times(1)
+	store.AssetStore.EXPECT().Get(gold.ID).Return(gold, nil).Times(1)
+	// the issuance of silver isn't confirmed yet
+	store.AssetStore.EXPECT().Get([]byte("silver")).Return(nil, nil).Times(1)
+	txPool := NewMockTxPool(ctrl)
+	txPool.EXPECT().GetUTXOs(ownerAddress).Return(nil).Times(1)
+	txPool.EXPECT().IsSpent(gomock.Any()).Return(false).Times(len(utxos))
+
+	transactor := service.NewTransactor(store, txPool, NewMockRaftAPI(ctrl), types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
+	balance, err := transactor.GetBalance(&types.BalanceRequest{Owner: &owner.PublicKey})
+	require.NoError(t1, err)
+	require.Equal(t1, uint64(100), balance.Amount.Value)
+	require.Equal(t1, []types.AssetBalance{
+		{AssetID: gold.ID, Asset: gold, Value: 42},
+		{AssetID: []byte("silver"), Value: 7},
+	}, balance.Assets)
+}
+
+func TestTransactor_Tokens(t1 *testing.T) {
+	metadataHash := make([]byte, types.MetadataHashSize)
+	tests := []struct {
+		name       string
+		authorized bool
+		action     func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey, token *types.Token) (*types.Transaction, error)
+		wantErr    bool
+	}{
+		{
+			name:       "ok authorized key mints a token",
+			authorized: true,
+			action: func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey, token *types.Token) (*types.Transaction, error) {
+				return transactor.MintToken(&types.TokenMintRequest{Minter: owner, Owner: crypto.PublicKeyHash(&receiver.PublicKey), MetadataHash: metadataHash, Fee: 5})
+			},
+			wantErr: false,
+		},
+		{
+			name:       "err unauthorized key mints a token",
+			authorized: false,
+			action: func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey, token *types.Token) (*types.Transaction, error) {
+				return transactor.MintToken(&types.TokenMintRequest{Minter: owner, MetadataHash: metadataHash, Fee: 5})
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok owner transfers the token whole",
+			action: func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey, token *types.Token) (*types.Transaction, error) {
+				return transactor.TransferToken(&types.TokenTransferRequest{Owner: owner, Receiver: crypto.PublicKeyHash(&receiver.PublicKey), TokenID: token.ID, Fee: 5})
+			},
+			wantErr: false,
+		},
+		{
+			name: "err owner transfers a token of someone else",
+			action: func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey, token *types.Token) (*types.Transaction, error) {
+				return transactor.TransferToken(&types.TokenTransferRequest{Owner: owner, Receiver: crypto.PublicKeyHash(&receiver.PublicKey), TokenID: []byte("other"), Fee: 5})
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			ctrl := gomock.NewController(t1)
+			owner := crypto.GenerateKeyEllipticP256()
+			receiver := crypto.GenerateKeyEllipticP256()
+			ownerAddress := crypto.PublicKeyHash(&owner.PublicKey)
+			var minters [][]byte
+			if tt.authorized {
+				minters = append(minters, crypto.PublicKeyToBytes(&owner.PublicKey))
+			}
+			prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &owner.PublicKey)
+			token := &types.Token{ID: types.NewTokenID(prevTx.ID, metadataHash), MetadataHash: metadataHash}
+			prevTx.AddOutput(types.NewTokenTxOut(prevTx.ID, token, ownerAddress))
+			prevTx.ComputeHash()
+			utxos := []*types.UnspentOutput{
+				{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0), Output: prevTx.Outputs[0]},
+				{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), 1), Output: prevTx.Outputs[1]},
+			}
+
+			store := NewMockCustomStore(ctrl)
+			// the signing keys are of no user
+			store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+			store.UTXOStore.EXPECT().GetByOwner(ownerAddress).Return(utxos, nil).AnyTimes()
+			txPool := NewMockTxPool(ctrl)
+			txPool.EXPECT().GetUTXOs(ownerAddress).Return(nil).AnyTimes()
+			txPool.EXPECT().IsSpent(gomock.Any()).Return(false).AnyTimes()
+			raftApi := NewMockRaftAPI(ctrl)
+			if !tt.wantErr {
+				raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(applyFuture{}).Times(1)
+			}
+
+			transactor := service.NewTransactor(store, txPool, raftApi, types.DefaultChainID, 0, coinselect.LargestFirst{}, minters, nil)
+			tx, err := tt.action(transactor, owner, receiver, token)
+			if tt.wantErr {
+				require.Error(t1, err)
+				return
+			}
+			require.NoError(t1, err)
+			// the token goes to the receiver, the fee is paid with the native coin
+			require.True(t1, tx.Outputs[0].IsToken())
+			require.Equal(t1, crypto.PublicKeyHash(&receiver.PublicKey), tx.Outputs[0].Address)
+			require.Equal(t1, metadataHash, tx.Outputs[0].MetadataHash)
+			require.Equal(t1, uint64(95), tx.Outputs[1].Amount.Value)
+			spent := make([]*types.TxOut, 0, len(tx.Inputs))
+			for _, in := range tx.Inputs {
+				spent = append(spent, prevTx.Outputs[in.Prev.Index])
+			}
+			require.NoError(t1, types.CheckValues(tx, spent))
+			require.NoError(t1, types.CheckTokens(tx, spent))
+		})
+	}
+}
+
+func TestTransactor_SweepTx(t1 *testing.T) {
+	metadataHash := make([]byte, types.MetadataHashSize)
+	tests := []struct {
+		name     string
+		owned    bool
+		fee      uint64
+		disabled bool
+		// extra is the value of a second coin output, if any
+		extra     uint64
+		wantSweep bool
+		wantErr   bool
+	}{
+		{
+			name:      "ok coin, asset and token move to the receiver",
+			owned:     true,
+			fee:       5,
+			wantSweep: true,
+		},
+		{
+			name:  "ok key owning nothing has nothing to sweep",
+			owned: false,
+			fee:   5,
+		},
+		{
+			name:    "err fee above the coin balance",
+			owned:   true,
+			fee:     101,
+			wantErr: true,
+		},
+		{
+			name:    "err coin of the outputs overflows",
+			owned:   true,
+			fee:     5,
+			extra:   math.MaxUint64,
+			wantErr: true,
+		},
+		{
+			name:     "err owner is a disabled user",
+			owned:    true,
+			fee:      5,
+			disabled: true,
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			ctrl := gomock.NewController(t1)
+			owner := crypto.GenerateKeyEllipticP256()
+			receiver := crypto.PublicKeyHash(&crypto.GenerateKeyEllipticP256().PublicKey)
+			ownerAddress := crypto.PublicKeyHash(&owner.PublicKey)
+			prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &owner.PublicKey)
+			assetOut := types.NewTxOut(prevTx.ID, *types.NewAmount(50), ownerAddress)
+			assetOut.AssetID = []byte("gold")
+			prevTx.AddOutput(assetOut)
+			token := &types.Token{ID: types.NewTokenID(prevTx.ID, metadataHash), MetadataHash: metadataHash}
+			prevTx.AddOutput(types.NewTokenTxOut(prevTx.ID, token, ownerAddress))
+			if tt.extra > 0 {
+				prevTx.AddOutput(types.NewTxOut(prevTx.ID, *types.NewAmount(tt.extra), ownerAddress))
+			}
+			prevTx.ComputeHash()
+			var utxos []*types.UnspentOutput
+			if tt.owned {
+				for i, out := range prevTx.Outputs {
+					utxos = append(utxos, &types.UnspentOutput{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), uint32(i)), Output: out})
+				}
+			}
+
+			store := NewMockCustomStore(ctrl)
+			if tt.disabled {
+				store.UserStore.EXPECT().GetByAddress(ownerAddress).Return(&types.User{
+					Username:  "alice",
+					PublicKey: crypto.PublicKeyToBytes(&owner.PublicKey),
+					Status:    types.UserDisabled,
+				}, nil).Times(1)
+			}
+			// the signing keys are of no user
+			store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+			store.UTXOStore.EXPECT().GetByOwner(ownerAddress).Return(utxos, nil).Times(1)
+			txPool := NewMockTxPool(ctrl)
+			txPool.EXPECT().GetUTXOs(ownerAddress).Return(nil).Times(1)
+			txPool.EXPECT().IsSpent(gomock.Any()).Return(false).Times(len(utxos))
+			raftApi := NewMockRaftAPI(ctrl)
+			if tt.wantSweep {
+				raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(applyFuture{}).Times(1)
+			}
+
+			transactor := service.NewTransactor(store, txPool, raftApi, types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
+			tx, err := transactor.SweepTx(&types.SweepRequest{Sender: owner, Receiver: receiver, Fee: tt.fee})
+			if tt.wantErr {
+				require.Error(t1, err)
+				return
+			}
+			require.NoError(t1, err)
+			if !tt.wantSweep {
+				require.Nil(t1, tx)
+				return
+			}
+			require.Len(t1, tx.Inputs, 3)
+			require.Len(t1, tx.Outputs, 3)
+			for _, out := range tx.Outputs {
+				require.Equal(t1, receiver, out.Address)
+			}
+			require.Equal(t1, uint64(95), tx.Outputs[0].Amount.Value)
+			require.Equal(t1, []byte("gold"), tx.Outputs[1].AssetID)
+			require.Equal(t1, uint64(50), tx.Outputs[1].Amount.Value)
+			require.Equal(t1, token.ID, tx.Outputs[2].TokenID)
+			spent := make([]*types.TxOut, 0, len(tx.Inputs))
+			for _, in := range tx.Inputs {
+				spent = append(spent, prevTx.Outputs[in.Prev.Index])
+			}
+			require.NoError(t1, types.CheckValues(tx, spent))
+			require.NoError(t1, types.CheckTokens(tx, spent))
+		})
+	}
+}
+
+func TestTransactor_GetToken(t1 *testing.T) {
+	ctrl := gomock.NewController(t1)
+	minter := crypto.GenerateKeyEllipticP256()
+	buyer := crypto.GenerateKeyEllipticP256()
+	mintTx := types.NewTransaction()
+	mintTx.Mint = &types.Token{
+		ID:           types.NewTokenID(mintTx.ID, make([]byte, types.MetadataHashSize)),
+		MetadataHash: make([]byte, types.MetadataHashSize),
+		Minter:       crypto.PublicKeyToBytes(&minter.PublicKey),
+	}
+	mintTx.AddOutput(types.NewTokenTxOut(mintTx.ID, mintTx.Mint, crypto.PublicKeyHash(&minter.PublicKey)))
+	transferTx := types.NewTransaction()
+	transferTx.AddOutput(types.NewTokenTxOut(transferTx.ID, mintTx.Mint, crypto.PublicKeyHash(&buyer.PublicKey)))
+	blocks := []*types.Block{{Timestamp: 1, Height: 1}, {Timestamp: 2, Height: 2}, {Timestamp: 3, Height: 3}}
+
+	store := NewMockCustomStore(ctrl)
+	store.BStore.EXPECT().GetKeys().Return([]uint64{3, 1, 2}, nil).Times(1)
+	store.BlockTxStore.EXPECT().GetByBlockTimestamp(uint64(1)).Return(types.Transactions{mintTx}, nil).Times(1)
+	store.BlockTxStore.EXPECT().GetByBlockTimestamp(uint64(2)).Return(types.Transactions{types.NewTransaction()}, nil).Times(1)
+	store.BlockTxStore.EXPECT().GetByBlockTimestamp(uint64(3)).Return(types.Transactions{transferTx}, nil).Times(1)
+	store.BStore.EXPECT().GetByTimestamp(uint64(1)).Return(blocks[0], nil).Times(1)
+	store.BStore.EXPECT().GetByTimestamp(uint64(3)).Return(blocks[2], nil).Times(1)
+
+	transactor := service.NewTransactor(store, NewMockTxPool(ctrl), NewMockRaftAPI(ctrl), types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
+	provenance, err := transactor.GetToken(mintTx.Mint.ID)
+	require.NoError(t1, err)
+	require.Equal(t1, mintTx.Mint, provenance.Token)
+	require.Equal(t1, crypto.PublicKeyHash(&buyer.PublicKey), provenance.Owner)
+	require.Equal(t1, []types.TokenTransfer{
+		{TxID: mintTx.ID, Owner: crypto.PublicKeyHash(&minter.PublicKey), BlockHeight: 1, BlockTimestamp: 1},
+		{TxID: transferTx.ID, Owner: crypto.PublicKeyHash(&buyer.PublicKey), BlockHeight: 3, BlockTimestamp: 3},
+	}, provenance.History)
+}
+
+// applyToPool applies transaction envelopes to the pool the way the FSM does
+func applyToPool(t1 *testing.T, pool *inMem.TxPool) func(cmd []byte, timeout time.Duration) raft.ApplyFuture {
+	return func(cmd []byte, timeout time.Duration) raft.ApplyFuture {
+		envelope, err := types.EnvelopeFromBytes(cmd)
+		require.NoError(t1, err)
+		tx := &types.Transaction{}
+		require.NoError(t1, tx.FromBytes(envelope.Data))
+		if err = pool.AddTx(tx); err != nil {
+			return applyFuture{response: err}
+		}
+		return applyFuture{}
+	}
+}
+
+func TestTransactor_CreateEscrow(t1 *testing.T) {
+	ctrl := gomock.NewController(t1)
+	buyer := crypto.GenerateKeyEllipticP256()
+	seller := crypto.GenerateKeyEllipticP256()
+	arbiter := crypto.GenerateKeyEllipticP256()
+	buyerAddress := crypto.PublicKeyHash(&buyer.PublicKey)
+	prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &buyer.PublicKey)
+	prevTx.ComputeHash()
+
+	store := NewMockCustomStore(ctrl)
+	// the transactions built by the node are new
+	store.TransactionStore.EXPECT().Has(gomock.Any()).Return(false, nil).AnyTimes()
+	// the buyer signs the escrow and its refund
+	store.UserStore.EXPECT().GetByAddress(buyerAddress).Return(nil, nil).Times(2)
+	store.UTXOStore.EXPECT().GetByOwner(buyerAddress).
+		Return([]*types.UnspentOutput{{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0), Output: prevTx.Outputs[0]}}, nil).
+		Times(1)
+	store.BStore.EXPECT().GetLast().Return(&types.Block{Height: 10}, nil).Times(1)
+	txPool := inMem.NewTxPool()
+	raftApi := NewMockRaftAPI(ctrl)
+	raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).DoAndReturn(applyToPool(t1, txPool)).Times(2)
+
+	transactor := service.NewTransactor(store, txPool, raftApi, types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
+	_, _, err := transactor.CreateEscrow(&types.EscrowRequest{
+		Buyer: buyer, Seller: &buyer.PublicKey, Arbiter: &arbiter.PublicKey, Amount: *types.NewAmount(60), Timeout: 20, Fee: 1,
+	})
+	require.Error(t1, err, "the buyer can't be the seller")
+
+	tx, refund, err := transactor.CreateEscrow(&types.EscrowRequest{
+		Buyer: buyer, Seller: &seller.PublicKey, Arbiter: &arbiter.PublicKey, Amount: *types.NewAmount(60), Timeout: 20, Fee: 1,
+	})
+	require.NoError(t1, err)
+	terms, err := script.ParseEscrow(tx.Outputs[0].Script)
+	require.NoError(t1, err)
+	require.Equal(t1, crypto.PublicKeyToBytes(&seller.PublicKey), terms.Seller)
+	require.Equal(t1, uint64(60), tx.Outputs[0].Amount.Value)
+	// the refund waits in the pool for the timeout
+	require.Equal(t1, tx.ID, refund.Inputs[0].Prev.TxID)
+	require.Equal(t1, uint32(20), refund.LockTime)
+	require.True(t1, refund.LockTimeInForce())
+	require.Equal(t1, buyerAddress, refund.Outputs[0].Address)
+	require.Equal(t1, uint64(59), refund.Outputs[0].Amount.Value)
+	require.Equal(t1, types.Transactions{tx, refund}.IDs(), txPool.Ordered().IDs())
+}
+
+func TestTransactor_Escrow(t1 *testing.T) {
+	type parties struct {
+		buyer, seller, arbiter *ecdsa.PrivateKey
+	}
+	tests := []struct {
+		name    string
+		timeout uint32
+		// pending is the transaction of the parties already spending the escrow output, if any
+		pending func(transactor *service.Transactor, p parties, outpoint *types.UTXO) (*types.Transaction, error)
+		spend   func(transactor *service.Transactor, p parties, outpoint *types.UTXO) (*types.Transaction, error)
+		// paid is the party the escrow is paid to
+		paid    func(p parties) *ecdsa.PrivateKey
+		wantErr bool
+	}{
+		{
+			name:    "ok buyer and seller release to the seller",
+			timeout: 20,
+			spend: func(transactor *service.Transactor, p parties, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.ReleaseEscrow(&types.EscrowReleaseRequest{Buyer: p.buyer, Seller: p.seller, Outpoint: outpoint, Fee: 1})
+			},
+			paid:    func(p parties) *ecdsa.PrivateKey { return p.seller },
+			wantErr: false,
+		},
+		{
+			name:    "err arbiter releases in place of the buyer",
+			timeout: 20,
+			spend: func(transactor *service.Transactor, p parties, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.ReleaseEscrow(&types.EscrowReleaseRequest{Buyer: p.arbiter, Seller: p.seller, Outpoint: outpoint, Fee: 1})
+			},
+			wantErr: true,
+		},
+		{
+			name:    "ok arbiter settles the dispute for the buyer",
+			timeout: 20,
+			spend: func(transactor *service.Transactor, p parties, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.DisputeEscrow(&types.EscrowDisputeRequest{Arbiter: p.arbiter, Party: p.buyer, Outpoint: outpoint, Fee: 1})
+			},
+			paid:    func(p parties) *ecdsa.PrivateKey { return p.buyer },
+			wantErr: false,
+		},
+		{
+			name:    "ok arbiter settles the dispute for the seller",
+			timeout: 20,
+			spend: func(transactor *service.Transactor, p parties, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.DisputeEscrow(&types.EscrowDisputeRequest{Arbiter: p.arbiter, Party: p.seller, Outpoint: outpoint, Fee: 1})
+			},
+			paid:    func(p parties) *ecdsa.PrivateKey { return p.seller },
+			wantErr: false,
+		},
+		{
+			name:    "err arbiter settles the dispute for itself",
+			timeout: 20,
+			spend: func(transactor *service.Transactor, p parties, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.DisputeEscrow(&types.EscrowDisputeRequest{Arbiter: p.arbiter, Party: p.arbiter, Outpoint: outpoint, Fee: 1})
+			},
+			wantErr: true,
+		},
+		{
+			name:    "ok release replaces the pending refund",
+			timeout: 5,
+			pending: func(transactor *service.Transactor, p parties, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.RefundEscrow(&types.EscrowRefundRequest{Buyer: p.buyer, Outpoint: outpoint, Fee: 1})
+			},
+			spend: func(transactor *service.Transactor, p parties, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.ReleaseEscrow(&types.EscrowReleaseRequest{Buyer: p.buyer, Seller: p.seller, Outpoint: outpoint, Fee: 1})
+			},
+			paid:    func(p parties) *ecdsa.PrivateKey { return p.seller },
+			wantErr: false,
+		},
+		{
+			name:    "err dispute of an escrow already released",
+			timeout: 20,
+			pending: func(transactor *service.Transactor, p parties, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.ReleaseEscrow(&types.EscrowReleaseRequest{Buyer: p.buyer, Seller: p.seller, Outpoint: outpoint, Fee: 1})
+			},
+			spend: func(transactor *service.Transactor, p parties, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.DisputeEscrow(&types.EscrowDisputeRequest{Arbiter: p.arbiter, Party: p.buyer, Outpoint: outpoint, Fee: 1})
+			},
+			wantErr: true,
+		},
+		{
+			name:    "ok buyer refunds after the timeout",
+			timeout: 5,
+			spend: func(transactor *service.Transactor, p parties, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.RefundEscrow(&types.EscrowRefundRequest{Buyer: p.buyer, Outpoint: outpoint, Fee: 1})
+			},
+			paid:    func(p parties) *ecdsa.PrivateKey { return p.buyer },
+			wantErr: false,
+		},
+		{
+			name:    "err buyer refunds before the timeout",
+			timeout: 20,
+			spend: func(transactor *service.Transactor, p parties, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.RefundEscrow(&types.EscrowRefundRequest{Buyer: p.buyer, Outpoint: outpoint, Fee: 1})
+			},
+			wantErr: true,
+		},
+		{
+			name:    "err seller refunds after the timeout",
+			timeout: 5,
+			spend: func(transactor *service.Transactor, p parties, outpoint *types.UTXO) (*types.Transaction, error) {
+				return transactor.RefundEscrow(&types.EscrowRefundRequest{Buyer: p.seller, Outpoint: outpoint, Fee: 1})
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			ctrl := gomock.NewController(t1)
+			p := parties{
+				buyer:   crypto.GenerateKeyEllipticP256(),
+				seller:  crypto.GenerateKeyEllipticP256(),
+				arbiter: crypto.GenerateKeyEllipticP256(),
+			}
+			lockingScript, err := script.Escrow(script.EscrowTerms{
+				Buyer:   crypto.PublicKeyToBytes(&p.buyer.PublicKey),
+				Seller:  crypto.PublicKeyToBytes(&p.seller.PublicKey),
+				Arbiter: crypto.PublicKeyToBytes(&p.arbiter.PublicKey),
+				Timeout: tt.timeout,
+			})
+			require.NoError(t1, err)
+			prevTx := types.NewTransaction()
+			prevTx.AddOutput(types.NewScriptTxOut(prevTx.ID, *types.NewAmount(100), lockingScript))
+			prevTx.ComputeHash()
+			utxo := &types.UnspentOutput{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0), Output: prevTx.Outputs[0]}
+
+			store := NewMockCustomStore(ctrl)
+			// the signing keys are of no user
+			store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+			// the transactions built by the node are new
+			store.TransactionStore.EXPECT().Has(gomock.Any()).Return(false, nil).AnyTimes()
+			store.UTXOStore.EXPECT().Get(utxo.UTXO).Return(utxo, nil).AnyTimes()
+			store.BStore.EXPECT().GetLast().Return(&types.Block{Height: 10}, nil).AnyTimes()
+			txPool := inMem.NewTxPool()
+			raftApi := NewMockRaftAPI(ctrl)
+			raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).DoAndReturn(applyToPool(t1, txPool)).AnyTimes()
+
+			transactor := service.NewTransactor(store, txPool, raftApi, types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
+			if tt.pending != nil {
+				_, err = tt.pending(transactor, p, utxo.UTXO)
+				require.NoError(t1, err)
+			}
+			tx, err := tt.spend(transactor, p, utxo.UTXO)
+			if tt.wantErr {
+				require.Error(t1, err)
+				return
+			}
+			require.NoError(t1, err)
+			require.Equal(t1, crypto.PublicKeyHash(&tt.paid(p).PublicKey), tx.Outputs[0].Address)
+			require.Equal(t1, uint64(99), tx.Outputs[0].Amount.Value)
+			require.Equal(t1, types.Transactions{tx}.IDs(), txPool.Ordered().IDs())
+		})
+	}
+}
+
+func TestTransactor_ListEscrows(t1 *testing.T) {
+	ctrl := gomock.NewController(t1)
+	participant := []byte("seller")
+	spender := func(outpoint *types.UTXO, lockTime uint32) *types.Transaction {
+		tx := types.NewTransaction().WithInputs(types.NewTxIn(outpoint, nil, nil, nil, types.SequenceFinal-1))
+		tx.LockTime = lockTime
+		tx.ComputeHash()
+		return tx
+	}
+	var escrows []*types.Escrow
+	for range 4 {
+		tx := types.NewTransaction()
+		tx.ComputeHash()
+		escrows = append(escrows, &types.Escrow{Outpoint: types.NewUTXO(tx.ID, tx.GetHash(), 0), Seller: participant})
+	}
+	refunded, released, settled, open := escrows[0], escrows[1], escrows[2], escrows[3]
+
+	store := NewMockCustomStore(ctrl)
+	store.EscrowStore.EXPECT().GetByParticipant(participant).Return(escrows, nil).Times(1)
+	for _, escrow := range []*types.Escrow{refunded, released, open} {
+		store.UTXOStore.EXPECT().Get(escrow.Outpoint).Return(&types.UnspentOutput{UTXO: escrow.Outpoint}, nil).Times(1)
+	}
+	// a confirmed transaction spent the settled escrow
+	store.UTXOStore.EXPECT().Get(settled.Outpoint).Return(nil, nil).Times(1)
+	txPool := inMem.NewTxPool()
+	// the refund waiting for the timeout leaves the escrow open, the pending release doesn't
+	require.NoError(t1, txPool.AddTx(spender(refunded.Outpoint, 20)))
+	require.NoError(t1, txPool.AddTx(spender(released.Outpoint, 0)))
+
+	transactor := service.NewTransactor(store, txPool, NewMockRaftAPI(ctrl), types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
+	listed, err := transactor.ListEscrows(participant)
+	require.NoError(t1, err)
+	require.Equal(t1, []*types.Escrow{refunded, open}, listed)
+}
+
+func TestTransactor_StandingOrders(t1 *testing.T) {
+	orderID := []byte("order")
+	tests := []struct {
+		name    string
+		record  func(owner *ecdsa.PrivateKey) *types.StandingOrderRecord
+		action  func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey) (*types.Transaction, error)
+		check   func(t1 *testing.T, tx *types.Transaction, owner, receiver *ecdsa.PrivateKey)
+		wantErr bool
+	}{
+		{
+			name: "ok order authorizes the payments from the payer",
+			action: func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey) (*types.Transaction, error) {
+				return transactor.CreateStandingOrder(&types.StandingOrderRequest{
+					Payer:       owner,
+					Receiver:    crypto.PublicKeyHash(&receiver.PublicKey),
+					Amount:      *types.NewAmount(30),
+					Interval:    3600,
+					MaxPayments: 12,
+					Fee:         5,
+				})
+			},
+			check: func(t1 *testing.T, tx *types.Transaction, owner, receiver *ecdsa.PrivateKey) {
+				order := tx.StandingOrder
+				require.NotNil(t1, order)
+				require.Equal(t1, types.NewStandingOrderID(tx.ID), order.ID)
+				require.Equal(t1, crypto.PublicKeyToBytes(&owner.PublicKey), order.Payer)
+				require.Equal(t1, crypto.PublicKeyHash(&receiver.PublicKey), order.Receiver)
+				// the first payment is due right away
+				require.NotZero(t1, order.Start)
+				require.Len(t1, tx.Outputs, 1)
+				require.Equal(t1, uint64(95), tx.Outputs[0].Amount.Value)
+			},
+			wantErr: false,
+		},
+		{
+			name: "err order without an interval",
+			action: func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey) (*types.Transaction, error) {
+				return transactor.CreateStandingOrder(&types.StandingOrderRequest{
+					Payer:    owner,
+					Receiver: crypto.PublicKeyHash(&receiver.PublicKey),
+					Amount:   *types.NewAmount(30),
+					Fee:      5,
+				})
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok payer cancels the order",
+			record: func(owner *ecdsa.PrivateKey) *types.StandingOrderRecord {
+				return &types.StandingOrderRecord{Order: &types.StandingOrder{ID: orderID, Payer: crypto.PublicKeyToBytes(&owner.PublicKey)}}
+			},
+			action: func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey) (*types.Transaction, error) {
+				return transactor.CancelStandingOrder(&types.StandingOrderCancelRequest{Payer: owner, OrderID: orderID, Fee: 5})
+			},
+			check: func(t1 *testing.T, tx *types.Transaction, owner, receiver *ecdsa.PrivateKey) {
+				require.Equal(t1, orderID, tx.CancelOrder)
+			},
+			wantErr: false,
+		},
+		{
+			name: "err order of another payer",
+			record: func(owner *ecdsa.PrivateKey) *types.StandingOrderRecord {
+				return &types.StandingOrderRecord{Order: &types.StandingOrder{ID: orderID, Payer: []byte("payer")}}
+			},
+			action: func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey) (*types.Transaction, error) {
+				return transactor.CancelStandingOrder(&types.StandingOrderCancelRequest{Payer: owner, OrderID: orderID, Fee: 5})
+			},
+			wantErr: true,
+		},
+		{
+			name: "err order already cancelled",
+			record: func(owner *ecdsa.PrivateKey) *types.StandingOrderRecord {
+				return &types.StandingOrderRecord{
+					Order:     &types.StandingOrder{ID: orderID, Payer: crypto.PublicKeyToBytes(&owner.PublicKey)},
+					Cancelled: true,
+				}
+			},
+			action: func(transactor *service.Transactor, owner, receiver *ecdsa.PrivateKey) (*types.Transaction, error) {
+				return transactor.CancelStandingOrder(&types.StandingOrderCancelRequest{Payer: owner, OrderID: orderID, Fee: 5})
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			ctrl := gomock.NewController(t1)
+			owner := crypto.GenerateKeyEllipticP256()
+			receiver := crypto.GenerateKeyEllipticP256()
+			ownerAddress := crypto.PublicKeyHash(&owner.PublicKey)
+			prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &owner.PublicKey)
+			prevTx.ComputeHash()
+			utxos := []*types.UnspentOutput{{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0), Output: prevTx.Outputs[0]}}
+
+			store := NewMockCustomStore(ctrl)
+			// the signing keys are of no user
+			store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+			store.UTXOStore.EXPECT().GetByOwner(ownerAddress).Return(utxos, nil).Times(1)
+			if tt.record != nil {
+				store.OrderStore.EXPECT().Get(orderID).Return(tt.record(owner), nil).Times(1)
+			}
+			txPool := NewMockTxPool(ctrl)
+			txPool.EXPECT().GetUTXOs(ownerAddress).Return(nil).Times(1)
+			txPool.EXPECT().IsSpent(gomock.Any()).Return(false).Times(len(utxos))
+			raftApi := NewMockRaftAPI(ctrl)
+			if !tt.wantErr {
+				raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(applyFuture{}).Times(1)
+			}
+
+			transactor := service.NewTransactor(store, txPool, raftApi, types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
+			tx, err := tt.action(transactor, owner, receiver)
+			if tt.wantErr {
+				require.Error(t1, err)
+				return
+			}
+			require.NoError(t1, err)
+			tt.check(t1, tx, owner, receiver)
+			require.NoError(t1, types.VerifySignatures(tx, types.DefaultChainID, func(prev *types.UTXO) (*types.TxOut, error) {
+				return prevTx.Outputs[prev.Index], nil
+			}))
+		})
+	}
+}
+
+func TestTransactor_ListStandingOrders(t1 *testing.T) {
+	ctrl := gomock.NewController(t1)
+	user := crypto.GenerateKeyEllipticP256()
+	pubKey := crypto.PublicKeyToBytes(&user.PublicKey)
+	paid := &types.StandingOrderRecord{Order: &types.StandingOrder{ID: []byte("paid"), Payer: pubKey, Receiver: []byte("shop")}}
+	// payments are received by the address of the key
+	received := &types.StandingOrderRecord{
+		Order:    &types.StandingOrder{ID: []byte("received"), Payer: []byte("employer"), Receiver: crypto.PublicKeyHash(&user.PublicKey)},
+		Payments: []types.OrderPayment{{Seq: 0, BlockHeight: 3}, {Seq: 1, BlockHeight: 9, Failure: "insufficient funds"}},
+	}
+	other := &types.StandingOrderRecord{Order: &types.StandingOrder{ID: []byte("other"), Payer: []byte("employer"), Receiver: []byte("shop")}}
+
+	store := NewMockCustomStore(ctrl)
+	store.OrderStore.EXPECT().GetAll().Return([]*types.StandingOrderRecord{other, paid, received}, nil).Times(1)
+
+	transactor := service.NewTransactor(store, NewMockTxPool(ctrl), NewMockRaftAPI(ctrl), types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, nil)
+	listed, err := transactor.ListStandingOrders(pubKey)
+	require.NoError(t1, err)
+	require.Equal(t1, []*types.StandingOrderRecord{paid, received}, listed)
+}
+
+func TestTransactor_CreateWalletTx(t1 *testing.T) {
+	tests := []struct {
+		name    string
+		amount  uint64
+		locked  bool
+		wantErr bool
+	}{
+		{
+			name:    "ok payment spends outputs of several keys",
+			amount:  50,
+			wantErr: false,
+		},
+		{
+			name:    "err insufficient balance of the wallet",
+			amount:  60,
+			wantErr: true,
+		},
+		{
+			name:    "err seed is locked",
+			amount:  50,
+			locked:  true,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			ctrl := gomock.NewController(t1)
+			seed := make([]byte, 32)
+			wallet, err := types.NewWallet(seed)
+			require.NoError(t1, err)
+			wallet.Receive, wallet.Change = 2, 1
+			user := &types.User{Username: "alice", Wallet: wallet}
+			// every used key of the wallet owns an output of 20
+			keys, err := wallet.Keys(seed)
+			require.NoError(t1, err)
+			prevTx := types.NewTransaction()
+			for _, key := range keys {
+				prevTx.WithOutput(types.NewAmount(20), &key.PublicKey)
+			}
+			prevTx.ComputeHash()
+
+			store := NewMockCustomStore(ctrl)
+			// the signing keys are of no user
+			store.UserStore.EXPECT().GetByAddress(gomock.Any()).Return(nil, nil).AnyTimes()
+			store.UserStore.EXPECT().Get(user.Username).Return(user, nil).Times(1)
+			keyStore := NewMockKeyStore(ctrl)
+			txPool := NewMockTxPool(ctrl)
+			raftApi := NewMockRaftAPI(ctrl)
+			if tt.locked {
+				keyStore.EXPECT().Key(user.Username).Return(nil, keystore.ErrLocked).Times(1)
+			} else {
+				keyStore.EXPECT().Key(user.Username).Return(seed, nil).Times(1)
+				for i, out := range prevTx.Outputs {
+					store.UTXOStore.EXPECT().GetByOwner(out.Address).
+						Return([]*types.UnspentOutput{{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), uint32(i)), Output: out}}, nil).
+						Times(1)
+				}
+				txPool.EXPECT().GetUTXOs(gomock.Any()).Return(nil).Times(len(keys))
+				txPool.EXPECT().IsSpent(gomock.Any()).Return(false).Times(len(keys))
+			}
+			if !tt.wantErr {
+				// the transaction and then the user using its next change key are applied through raft
+				gomock.InOrder(
+					raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(applyFuture{}).Times(1),
+					raftApi.EXPECT().Apply(userCommand(types.UserOpUpdate, user.Username), gomock.Any()).
+						Return(applyFuture{}).Times(1),
+				)
+			}
+
+			receiver := crypto.GenerateKeyEllipticP256()
+			transactor := service.NewTransactor(store, txPool, raftApi, types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, keyStore)
+			tx, err := transactor.CreateWalletTx(&types.WalletTransactionRequest{
+				Username: user.Username,
+				Payments: []types.Payment{{Receiver: crypto.PublicKeyHash(&receiver.PublicKey), Amount: *types.NewAmount(tt.amount)}},
+				Fee:      5,
+			})
+			if tt.locked {
+				require.ErrorIs(t1, err, keystore.ErrLocked)
+			}
+			if tt.wantErr {
+				require.Error(t1, err)
+				require.Equal(t1, uint32(1), wallet.Change)
+				return
+			}
+			require.NoError(t1, err)
+			require.Len(t1, tx.Inputs, 3)
+			// the change goes to the next change key, which is used from now on
+			changeKey, err := wallet.PublicKey(types.ChangeChain, 1)
+			require.NoError(t1, err)
+			require.Equal(t1, crypto.PublicKeyHash(changeKey), tx.Outputs[1].Address)
+			require.Equal(t1, uint64(5), tx.Outputs[1].Amount.Value)
+			require.Equal(t1, uint32(2), wallet.Change)
+			require.NoError(t1, types.VerifySignatures(tx, types.DefaultChainID, func(prev *types.UTXO) (*types.TxOut, error) {
+				return prevTx.Outputs[prev.Index], nil
+			}))
+		})
+	}
+}
+
+func TestTransactor_AddWallet(t1 *testing.T) {
+	ctrl := gomock.NewController(t1)
+	seed := make([]byte, 32)
+	wallet, err := types.NewWallet(seed)
+	require.NoError(t1, err)
+	user := &types.User{Username: "alice", Wallet: wallet}
+	// the restored wallet received at its fourth key and never paid change
+	funded, err := wallet.PublicKey(types.ReceiveChain, 3)
+	require.NoError(t1, err)
+	fundedAddress := crypto.PublicKeyHash(funded)
+	prevTx := types.NewTransaction().WithOutput(types.NewAmount(20), funded)
+	prevTx.ComputeHash()
+
+	store := NewMockCustomStore(ctrl)
+	store.UTXOStore.EXPECT().GetByOwner(gomock.Any()).DoAndReturn(func(owner []byte) ([]*types.UnspentOutput, error) {
+		if string(owner) == string(fundedAddress) {
+			return []*types.UnspentOutput{{UTXO: types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0), Output: prevTx.Outputs[0]}}, nil
+		}
+		return nil, nil
+	}).AnyTimes()
+	txPool := NewMockTxPool(ctrl)
+	txPool.EXPECT().GetUTXOs(gomock.Any()).Return(nil).AnyTimes()
+	txPool.EXPECT().IsSpent(gomock.Any()).Return(false).AnyTimes()
+	// the seed is sealed in the keystore, the replicated user keeps the public key of the wallet only
+	keyStore := NewMockKeyStore(ctrl)
+	keyStore.EXPECT().Store(user.Username, seed, "passphrase").Return(nil).Times(1)
+	raftApi := NewMockRaftAPI(ctrl)
+	raftApi.EXPECT().Apply(userCommand(types.UserOpCreate, user.Username), gomock.Any()).Return(applyFuture{}).Times(1)
+
+	transactor := service.NewTransactor(store, txPool, raftApi, types.DefaultChainID, 0, coinselect.LargestFirst{}, nil, keyStore)
+	require.NoError(t1, transactor.AddWallet(user, seed, "passphrase"))
+	require.Equal(t1, uint32(4), wallet.Receive)
+	require.Equal(t1, uint32(0), wallet.Change)
+}
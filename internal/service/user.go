@@ -1,31 +1,405 @@
 package service
 
-import "local-chain/internal/types"
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/mail"
+	"slices"
+	"time"
+
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/pkg/keystore"
+	"local-chain/internal/types"
+)
 
 type UserStore interface {
 	GetAll() ([]*types.User, error)
 	Get(username string) (*types.User, error)
+	GetByAddress(address []byte) (*types.User, error)
 	Put(user *types.User) error
+	Update(user *types.User) error
+	Delete() error
+}
+
+// KeyStore keeps the private keys of users encrypted, a key is usable while it is unlocked.
+type KeyStore interface {
+	Store(name string, key []byte, passphrase string) error
+	Replace(name string, key []byte, passphrase string) error
+	Delete(name string) error
+	Unlock(name, passphrase string, timeout time.Duration) error
+	Lock(name string)
+	Key(name string) ([]byte, error)
+}
+
+// Sweeper moves everything a key owns to another address.
+type Sweeper interface {
+	SweepTx(req *types.SweepRequest) (*types.Transaction, error)
+}
+
+// StandingOrderLister lists the standing orders a key pays or receives.
+type StandingOrderLister interface {
+	ListStandingOrders(pubKey []byte) ([]*types.StandingOrderRecord, error)
 }
 
+// User manages the user registry. Reads are served by the local user store, writes are applied through raft,
+// so every replica has the same registry. The keystore is local to the node: a key stays on the node it was added
+// on, another node, e.g. a new leader after a failover, can't sign for the user.
 type User struct {
 	userStore UserStore
+	keys      KeyStore
+	sweeper   Sweeper
+	orders    StandingOrderLister
+	raftApi   RaftAPI
 }
 
-func NewUserService(userStore UserStore) *User {
+func NewUserService(userStore UserStore, keys KeyStore, sweeper Sweeper, orders StandingOrderLister, raftApi RaftAPI) *User {
 	return &User{
 		userStore: userStore,
+		keys:      keys,
+		sweeper:   sweeper,
+		orders:    orders,
+		raftApi:   raftApi,
 	}
 }
 
-func (s *User) GetAllUsers() ([]*types.User, error) {
-	return s.userStore.GetAll()
+// ListUsers returns the users the filter selects.
+func (s *User) ListUsers(filter types.UserFilter) ([]*types.User, error) {
+	users, err := s.userStore.GetAll()
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(users, func(user *types.User) bool { return !filter.Match(user) }), nil
 }
 
 func (s *User) GetUser(username string) (*types.User, error) {
 	return s.userStore.Get(username)
 }
 
-func (s *User) AddUser(user *types.User) error {
-	return s.userStore.Put(user)
+// AddUser seals the private key of the user in the keystore under the passphrase,
+// the user store keeps the public key only.
+func (s *User) AddUser(user *types.User, passphrase string) error {
+	if err := checkEmail(user.Email); err != nil {
+		return err
+	}
+	user.Status = types.UserActive
+	if err := s.keys.Store(user.Username, user.PrivateKey, passphrase); err != nil {
+		return fmt.Errorf("error storing key : %w", err)
+	}
+	stored := *user
+	stored.PrivateKey = nil
+	if err := applyUser(s.raftApi, types.UserOpCreate, &stored); err != nil {
+		if deleteErr := s.keys.Delete(user.Username); deleteErr != nil {
+			return errors.Join(err, deleteErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// UpdateUser changes the personal data of the update, a nil field stays as it is.
+func (s *User) UpdateUser(update *types.UserUpdate) (*types.User, error) {
+	user, err := s.userStore.Get(update.Username)
+	if err != nil {
+		return nil, err
+	}
+	if user.Status == types.UserDeleted {
+		return nil, errors.New("user is deleted")
+	}
+	if update.DisplayName != nil {
+		user.DisplayName = *update.DisplayName
+	}
+	if update.Email != nil {
+		if err = checkEmail(*update.Email); err != nil {
+			return nil, err
+		}
+		user.Email = *update.Email
+	}
+	if err = applyUser(s.raftApi, types.UserOpUpdate, user); err != nil {
+		return nil, fmt.Errorf("error updating user : %v", err)
+	}
+	return user, nil
+}
+
+// DisableUser refuses the user as a sender and locks its key until the user is enabled.
+func (s *User) DisableUser(username string) error {
+	if err := s.setStatus(username, types.UserDisabled); err != nil {
+		return err
+	}
+	s.keys.Lock(username)
+	return nil
+}
+
+// EnableUser lets a disabled user send again.
+func (s *User) EnableUser(username string) error {
+	return s.setStatus(username, types.UserActive)
+}
+
+// DeleteUser drops the personal data, the private key and the wallet seed of the user. The username and the public
+// keys, the wallet's too, stay in the user store, so the chain history of the keys remains linked to the user
+// and the username isn't reused. The key is dropped once the tombstone is applied: a user that failed to be deleted
+// keeps its key, a key that failed to be dropped is dropped by deleting the user again.
+func (s *User) DeleteUser(username string) error {
+	user, err := s.userStore.Get(username)
+	if err != nil {
+		return err
+	}
+	user.Status = types.UserDeleted
+	user.DisplayName, user.Email = "", ""
+	user.PrivateKey = nil
+	if err = applyUser(s.raftApi, types.UserOpUpdate, user); err != nil {
+		return fmt.Errorf("error updating user : %w", err)
+	}
+	if err = s.keys.Delete(username); err != nil {
+		return fmt.Errorf("error deleting key : %w", err)
+	}
+	return nil
+}
+
+func (s *User) setStatus(username string, status types.UserStatus) error {
+	user, err := s.userStore.Get(username)
+	if err != nil {
+		return err
+	}
+	if user.Status == types.UserDeleted {
+		return errors.New("user is deleted")
+	}
+	user.Status = status
+	if err = applyUser(s.raftApi, types.UserOpUpdate, user); err != nil {
+		return fmt.Errorf("error updating user : %v", err)
+	}
+	return nil
+}
+
+// checkEmail validates an email address, empty for a user without one
+func checkEmail(email string) error {
+	if email == "" {
+		return nil
+	}
+	if address, err := mail.ParseAddress(email); err != nil || address.Address != email {
+		return fmt.Errorf("invalid email %q", email)
+	}
+	return nil
+}
+
+// Unlock makes the user's key usable for the timeout. A user stored with its private key before the keystore
+// gets the key sealed under the passphrase, and no longer stored in the clear.
+func (s *User) Unlock(username, passphrase string, timeout time.Duration) error {
+	user, err := s.userStore.Get(username)
+	if err != nil {
+		return err
+	}
+	if !user.Active() {
+		return fmt.Errorf("user is %s", user.Status)
+	}
+	if len(user.PrivateKey) > 0 {
+		if err = s.keys.Store(username, user.PrivateKey, passphrase); err != nil {
+			return fmt.Errorf("error storing key : %w", err)
+		}
+		user.PrivateKey = nil
+		if err = applyUser(s.raftApi, types.UserOpUpdate, user); err != nil {
+			return fmt.Errorf("error updating user : %v", err)
+		}
+	}
+	if err = s.keys.Unlock(username, passphrase, timeout); errors.Is(err, keystore.ErrNotFound) {
+		return errKeyNotOnNode(username, err)
+	}
+	return err
+}
+
+// Lock makes the user's key unusable until it is unlocked again.
+func (s *User) Lock(username string) {
+	s.keys.Lock(username)
+}
+
+// Signer returns the unlocked private key of the user: the first key of its wallet, derived from the seed
+// the keystore seals, or its own key. Users that aren't active have no signer.
+func (s *User) Signer(username string) (crypto.Signer, error) {
+	user, err := s.userStore.Get(username)
+	if err != nil {
+		return nil, err
+	}
+	if !user.Active() {
+		return nil, fmt.Errorf("user %s is %s", username, user.Status)
+	}
+	key, err := unlockedKey(s.keys, username)
+	if err != nil {
+		return nil, err
+	}
+	if user.Wallet != nil {
+		walletKey, err := user.Wallet.Key(key, types.ReceiveChain, 0)
+		if err != nil {
+			return nil, err
+		}
+		return walletKey, nil
+	}
+	return crypto.PrivateKeyFromBytes(key)
+}
+
+// PublicKey returns the current public key of the user.
+func (s *User) PublicKey(username string) (crypto.PublicKey, error) {
+	user, err := s.userStore.Get(username)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.PublicKeyFromBytes(user.PublicKey)
+}
+
+// AddressKey returns the public key of the address. An address is a hash of its key, so only the current key
+// of a registered user is known.
+func (s *User) AddressKey(address []byte) (crypto.PublicKey, error) {
+	user, err := s.userStore.GetByAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || !bytes.Equal(types.AddressOf(user.PublicKey), address) {
+		return nil, fmt.Errorf("address %s is not the current key of a user, its public key is unknown",
+			crypto.EncodeAddress(address))
+	}
+	return crypto.PublicKeyFromBytes(user.PublicKey)
+}
+
+// RotateKey replaces the key of the user: the outputs of the old key are swept to the new one, the keystore seals
+// the new key under the passphrase and the user records the old key, whose transactions stay on the chain.
+// The passphrase must unlock the current key, which ends locked together with the new one. A standing order is
+// the allowance of the key paying it, so the key isn't rotated while it pays an order that isn't cancelled or ended.
+// The keystore keeps the old key if the rotation fails.
+func (s *User) RotateKey(req *types.KeyRotationRequest) (*types.KeyRotation, *types.Transaction, error) {
+	user, err := s.userStore.Get(req.Username)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user.Wallet != nil {
+		return nil, nil, errors.New("keys of a wallet are rotated by restoring a wallet of a new seed")
+	}
+	orders, err := s.orders.ListStandingOrders(user.PublicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, record := range orders {
+		if bytes.Equal(record.Order.Payer, user.PublicKey) && record.Running() {
+			return nil, nil, fmt.Errorf("key pays standing order %x, cancel it before rotating the key", record.Order.ID)
+		}
+	}
+	if err = s.Unlock(req.Username, req.Passphrase, 0); err != nil {
+		return nil, nil, err
+	}
+	defer s.keys.Lock(req.Username)
+	oldPEM, err := s.keys.Key(req.Username)
+	if err != nil {
+		return nil, nil, err
+	}
+	oldKey, err := crypto.PrivateKeyFromBytes(oldPEM)
+	if err != nil {
+		return nil, nil, err
+	}
+	newKey := req.NewKey
+	if newKey == nil {
+		if newKey, err = crypto.GenerateKey(req.Algorithm); err != nil {
+			return nil, nil, fmt.Errorf("failed to generate key : %w", err)
+		}
+	}
+	newPEM, newPub := crypto.PrivateKeyToBytes(newKey)
+	if bytes.Equal(newPub, crypto.PublicKeyToBytes(oldKey.Public())) {
+		return nil, nil, errors.New("new key is the current key of the user")
+	}
+
+	// the new key is sealed before the sweep pays to it, the old one comes back if the sweep fails
+	if err = s.keys.Replace(req.Username, newPEM, req.Passphrase); err != nil {
+		return nil, nil, fmt.Errorf("error storing key : %w", err)
+	}
+	sweep, err := s.sweeper.SweepTx(&types.SweepRequest{
+		Sender:   oldKey,
+		Receiver: crypto.PublicKeyHash(newKey.Public()),
+		Fee:      req.Fee,
+	})
+	if err != nil {
+		if restoreErr := s.keys.Replace(req.Username, oldPEM, req.Passphrase); restoreErr != nil {
+			return nil, nil, errors.Join(err, restoreErr)
+		}
+		return nil, nil, fmt.Errorf("error sweeping outputs : %w", err)
+	}
+
+	rotation := types.KeyRotation{
+		OldKey:    user.PublicKey,
+		NewKey:    newPub,
+		RotatedAt: uint64(time.Now().Unix()),
+	}
+	if sweep != nil {
+		rotation.SweepTxID = sweep.ID
+	}
+	user.Rotations = append(user.Rotations, rotation)
+	user.PublicKey = newPub
+	// a key stored in the clear was moved to the keystore by Unlock
+	user.PrivateKey = nil
+	if err = applyUser(s.raftApi, types.UserOpUpdate, user); err != nil {
+		err = fmt.Errorf("error updating user : %w", err)
+		if restoreErr := s.keys.Replace(req.Username, oldPEM, req.Passphrase); restoreErr != nil {
+			return nil, nil, errors.Join(err, restoreErr)
+		}
+		return nil, nil, err
+	}
+	return &rotation, sweep, nil
+}
+
+// DeriveAddress hands out a fresh receive address of the user's wallet.
+func (s *User) DeriveAddress(username string) (string, error) {
+	user, err := s.userStore.Get(username)
+	if err != nil {
+		return "", err
+	}
+	if user.Status == types.UserDeleted {
+		return "", errors.New("user is deleted")
+	}
+	if user.Wallet == nil {
+		return "", errors.New("user has no wallet")
+	}
+	address, err := user.Wallet.NextAddress()
+	if err != nil {
+		return "", err
+	}
+	if err = applyUser(s.raftApi, types.UserOpUpdate, user); err != nil {
+		return "", fmt.Errorf("error updating user : %v", err)
+	}
+	return crypto.EncodeAddress(address), nil
+}
+
+// unlockedKey returns the unlocked key the keystore of the node seals for the user
+func unlockedKey(keys KeyStore, username string) ([]byte, error) {
+	key, err := keys.Key(username)
+	if errors.Is(err, keystore.ErrNotFound) {
+		return nil, errKeyNotOnNode(username, err)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("key of %s: %w", username, err)
+	}
+	return key, nil
+}
+
+// errKeyNotOnNode explains the missing key of a user: only the user record is replicated through raft
+func errKeyNotOnNode(username string, err error) error {
+	return fmt.Errorf("key of %s is kept by the node it was added on, this node can't sign for the user: %w",
+		username, err)
+}
+
+// applyUser replicates the user command through raft, the user store of every replica applies it
+func applyUser(raftApi RaftAPI, op types.UserOp, user *types.User) error {
+	cmdBytes, err := (&types.UserCommand{Op: op, User: user}).ToBytes()
+	if err != nil {
+		return fmt.Errorf("error while encoding user command: %w", err)
+	}
+	envelopeBytes, err := types.NewEnvelope(types.EnvelopeTypeUser, cmdBytes).ToBytes()
+	if err != nil {
+		return fmt.Errorf("error while encoding envelope: %w", err)
+	}
+	future := raftApi.Apply(envelopeBytes, applyTimeout)
+	if err = future.Error(); err != nil {
+		return fmt.Errorf("error while applying user command to raft: %w", err)
+	}
+	if response := future.Response(); response != nil {
+		if err, ok := response.(error); ok {
+			return err
+		}
+	}
+	return nil
 }
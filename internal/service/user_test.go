@@ -0,0 +1,417 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"local-chain/internal/adapters/outbound/leveldb"
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/pkg/keystore"
+	"local-chain/internal/service"
+	"local-chain/internal/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/hashicorp/raft"
+	"github.com/stretchr/testify/require"
+	goleveldb "github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+)
+
+const passphrase = "correct horse"
+
+// userFixture is a user service over an in-memory user store and a keystore in a temporary directory,
+// the user commands are applied to the store the way the FSM does
+type userFixture struct {
+	users   service.UserStore
+	keys    *keystore.Keystore
+	sweeper *MockSweeper
+	orders  *MockStandingOrderLister
+	service *service.User
+}
+
+func newUserFixture(t1 *testing.T, ctrl *gomock.Controller) *userFixture {
+	store := leveldb.New(func(string) leveldb.Database {
+		db, err := goleveldb.Open(storage.NewMemStorage(), nil)
+		require.NoError(t1, err)
+		return db
+	})
+	keys, err := keystore.New(t1.TempDir(), keystore.LightScryptN)
+	require.NoError(t1, err)
+	raftApi := NewMockRaftAPI(ctrl)
+	raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).DoAndReturn(applyToUsers(t1, store.User())).AnyTimes()
+	sweeper := NewMockSweeper(ctrl)
+	orders := NewMockStandingOrderLister(ctrl)
+	return &userFixture{
+		users:   store.User(),
+		keys:    keys,
+		sweeper: sweeper,
+		orders:  orders,
+		service: service.NewUserService(store.User(), keys, sweeper, orders, raftApi),
+	}
+}
+
+// addUser adds an active user of a new key sealed under the passphrase
+func (f *userFixture) addUser(t1 *testing.T, username, email string) crypto.Signer {
+	key := crypto.GenerateKeyEllipticP256()
+	privateKey, publicKey := crypto.PrivateKeyToBytes(key)
+	require.NoError(t1, f.service.AddUser(&types.User{
+		Username:    username,
+		DisplayName: username,
+		Email:       email,
+		PublicKey:   publicKey,
+		PrivateKey:  privateKey,
+	}, passphrase))
+	return key
+}
+
+// applyToUsers applies user command envelopes to the user store the way the FSM does
+func applyToUsers(t1 *testing.T, users service.UserStore) func(cmd []byte, timeout time.Duration) raft.ApplyFuture {
+	return func(cmd []byte, timeout time.Duration) raft.ApplyFuture {
+		envelope, err := types.EnvelopeFromBytes(cmd)
+		require.NoError(t1, err)
+		userCmd := &types.UserCommand{}
+		require.NoError(t1, userCmd.FromBytes(envelope.Data))
+		switch userCmd.Op {
+		case types.UserOpCreate:
+			err = users.Put(userCmd.User)
+		case types.UserOpUpdate:
+			err = users.Update(userCmd.User)
+		}
+		if err != nil {
+			return applyFuture{response: err}
+		}
+		return applyFuture{}
+	}
+}
+
+func TestUser_UpdateUser(t1 *testing.T) {
+	newName := "Alice Liddell"
+	newEmail := "alice@wonderland.example"
+	badEmail := "alice at wonderland"
+	tests := []struct {
+		name      string
+		update    types.UserUpdate
+		deleted   bool
+		wantName  string
+		wantEmail string
+		wantErr   bool
+	}{
+		{
+			name:      "ok display name, the email stays",
+			update:    types.UserUpdate{Username: "alice", DisplayName: &newName},
+			wantName:  newName,
+			wantEmail: "alice@example.com",
+		},
+		{
+			name:      "ok email, the display name stays",
+			update:    types.UserUpdate{Username: "alice", Email: &newEmail},
+			wantName:  "alice",
+			wantEmail: newEmail,
+		},
+		{
+			name:    "err invalid email",
+			update:  types.UserUpdate{Username: "alice", Email: &badEmail},
+			wantErr: true,
+		},
+		{
+			name:    "err user is deleted",
+			update:  types.UserUpdate{Username: "alice", DisplayName: &newName},
+			deleted: true,
+			wantErr: true,
+		},
+		{
+			name:    "err unknown user",
+			update:  types.UserUpdate{Username: "bob", DisplayName: &newName},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			f := newUserFixture(t1, gomock.NewController(t1))
+			f.addUser(t1, "alice", "alice@example.com")
+			if tt.deleted {
+				require.NoError(t1, f.service.DeleteUser("alice"))
+			}
+
+			user, err := f.service.UpdateUser(&tt.update)
+			if tt.wantErr {
+				require.Error(t1, err)
+				return
+			}
+			require.NoError(t1, err)
+			stored, err := f.service.GetUser("alice")
+			require.NoError(t1, err)
+			require.Equal(t1, user, stored)
+			require.Equal(t1, tt.wantName, stored.DisplayName)
+			require.Equal(t1, tt.wantEmail, stored.Email)
+		})
+	}
+}
+
+func TestUser_DisableEnableUser(t1 *testing.T) {
+	f := newUserFixture(t1, gomock.NewController(t1))
+	f.addUser(t1, "alice", "")
+	require.NoError(t1, f.service.Unlock("alice", passphrase, 0))
+
+	// a disabled user has no signer and its key is locked until it is enabled
+	require.NoError(t1, f.service.DisableUser("alice"))
+	user, err := f.service.GetUser("alice")
+	require.NoError(t1, err)
+	require.Equal(t1, types.UserDisabled, user.Status)
+	_, err = f.keys.Key("alice")
+	require.ErrorIs(t1, err, keystore.ErrLocked)
+	_, err = f.service.Signer("alice")
+	require.Error(t1, err)
+	require.Error(t1, f.service.Unlock("alice", passphrase, 0))
+
+	require.NoError(t1, f.service.EnableUser("alice"))
+	user, err = f.service.GetUser("alice")
+	require.NoError(t1, err)
+	require.Equal(t1, types.UserActive, user.Status)
+	require.NoError(t1, f.service.Unlock("alice", passphrase, 0))
+	_, err = f.service.Signer("alice")
+	require.NoError(t1, err)
+
+	// a deleted user stays deleted
+	require.NoError(t1, f.service.DeleteUser("alice"))
+	require.Error(t1, f.service.EnableUser("alice"))
+	require.Error(t1, f.service.DisableUser("alice"))
+	user, err = f.service.GetUser("alice")
+	require.NoError(t1, err)
+	require.Equal(t1, types.UserDeleted, user.Status)
+}
+
+func TestUser_DeleteUser(t1 *testing.T) {
+	f := newUserFixture(t1, gomock.NewController(t1))
+	key := f.addUser(t1, "alice", "alice@example.com")
+	require.NoError(t1, f.service.DeleteUser("alice"))
+
+	// the personal data and the key are gone, the username and the public key stay
+	user, err := f.service.GetUser("alice")
+	require.NoError(t1, err)
+	require.Equal(t1, types.UserDeleted, user.Status)
+	require.Empty(t1, user.DisplayName)
+	require.Empty(t1, user.Email)
+	require.Empty(t1, user.PrivateKey)
+	require.Equal(t1, crypto.PublicKeyToBytes(key.Public()), user.PublicKey)
+	_, err = f.keys.Key("alice")
+	require.ErrorIs(t1, err, keystore.ErrNotFound)
+	byAddress, err := f.users.GetByAddress(crypto.PublicKeyHash(key.Public()))
+	require.NoError(t1, err)
+	require.Equal(t1, "alice", byAddress.Username)
+
+	// the username isn't reused
+	privateKey, publicKey := crypto.PrivateKeyToBytes(crypto.GenerateKeyEllipticP256())
+	require.Error(t1, f.service.AddUser(&types.User{Username: "alice", PublicKey: publicKey, PrivateKey: privateKey}, passphrase))
+	user, err = f.service.GetUser("alice")
+	require.NoError(t1, err)
+	require.Equal(t1, types.UserDeleted, user.Status)
+	require.Equal(t1, crypto.PublicKeyToBytes(key.Public()), user.PublicKey)
+	_, err = f.keys.Key("alice")
+	require.ErrorIs(t1, err, keystore.ErrNotFound)
+}
+
+func TestUser_DeleteUser_KeepsKeyOfUserNotDeleted(t1 *testing.T) {
+	ctrl := gomock.NewController(t1)
+	_, publicKey := crypto.PrivateKeyToBytes(crypto.GenerateKeyEllipticP256())
+	users := NewMockUserStore(ctrl)
+	users.EXPECT().Get("alice").Return(&types.User{Username: "alice", PublicKey: publicKey}, nil).Times(1)
+	raftApi := NewMockRaftAPI(ctrl)
+	raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(applyFuture{err: raft.ErrNotLeader}).Times(1)
+	// the key isn't dropped
+	keys := NewMockKeyStore(ctrl)
+
+	err := service.NewUserService(users, keys, NewMockSweeper(ctrl), NewMockStandingOrderLister(ctrl), raftApi).DeleteUser("alice")
+	require.ErrorIs(t1, err, raft.ErrNotLeader)
+}
+
+func TestUser_ListUsers(t1 *testing.T) {
+	f := newUserFixture(t1, gomock.NewController(t1))
+	f.addUser(t1, "alice", "")
+	f.addUser(t1, "albert", "")
+	f.addUser(t1, "bob", "")
+	f.addUser(t1, "carol", "")
+	require.NoError(t1, f.service.DisableUser("albert"))
+	require.NoError(t1, f.service.DeleteUser("carol"))
+
+	disabled := types.UserDisabled
+	deleted := types.UserDeleted
+	tests := []struct {
+		name   string
+		filter types.UserFilter
+		want   []string
+	}{
+		{
+			name:   "ok every user but the deleted ones",
+			filter: types.UserFilter{},
+			want:   []string{"albert", "alice", "bob"},
+		},
+		{
+			name:   "ok deleted users too",
+			filter: types.UserFilter{Deleted: true},
+			want:   []string{"albert", "alice", "bob", "carol"},
+		},
+		{
+			name:   "ok users of a status",
+			filter: types.UserFilter{Status: &disabled},
+			want:   []string{"albert"},
+		},
+		{
+			name:   "ok deleted users by their status",
+			filter: types.UserFilter{Status: &deleted},
+			want:   []string{"carol"},
+		},
+		{
+			name:   "ok users of a username prefix",
+			filter: types.UserFilter{Prefix: "al"},
+			want:   []string{"albert", "alice"},
+		},
+		{
+			name:   "ok no user of the prefix",
+			filter: types.UserFilter{Prefix: "dave"},
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			users, err := f.service.ListUsers(tt.filter)
+			require.NoError(t1, err)
+			var usernames []string
+			for _, user := range users {
+				usernames = append(usernames, user.Username)
+			}
+			require.ElementsMatch(t1, tt.want, usernames)
+		})
+	}
+}
+
+func TestUser_RotateKey(t1 *testing.T) {
+	tests := []struct {
+		name string
+		// orders are the standing orders of the key of the user
+		orders    func(pubKey []byte) []*types.StandingOrderRecord
+		sweepErr  error
+		updateErr error
+		wantSweep bool
+		wantErr   bool
+	}{
+		{
+			name:      "ok old key linked to the new one",
+			wantSweep: true,
+		},
+		{
+			name: "ok orders the key receives or doesn't pay anymore",
+			orders: func(pubKey []byte) []*types.StandingOrderRecord {
+				return []*types.StandingOrderRecord{
+					{Order: &types.StandingOrder{ID: []byte("received"), Receiver: types.AddressOf(pubKey), Interval: 60}},
+					{Order: &types.StandingOrder{ID: []byte("cancelled"), Payer: pubKey, Interval: 60}, Cancelled: true},
+					{
+						Order:    &types.StandingOrder{ID: []byte("ended"), Payer: pubKey, Interval: 60, MaxPayments: 1},
+						Payments: []types.OrderPayment{{Seq: 0}},
+					},
+				}
+			},
+			wantSweep: true,
+		},
+		{
+			name: "err key pays a running standing order",
+			orders: func(pubKey []byte) []*types.StandingOrderRecord {
+				return []*types.StandingOrderRecord{
+					{Order: &types.StandingOrder{ID: []byte("rent"), Payer: pubKey, Interval: 60}},
+				}
+			},
+			wantErr: true,
+		},
+		{
+			name:      "err sweep fails, the keystore keeps the old key",
+			sweepErr:  errors.New("fee above the coin balance"),
+			wantSweep: true,
+			wantErr:   true,
+		},
+		{
+			name:      "err user update fails, the keystore keeps the old key",
+			updateErr: raft.ErrNotLeader,
+			wantSweep: true,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			ctrl := gomock.NewController(t1)
+			f := newUserFixture(t1, ctrl)
+			oldKey := f.addUser(t1, "alice", "")
+			oldPub := crypto.PublicKeyToBytes(oldKey.Public())
+			oldPEM, _ := crypto.PrivateKeyToBytes(oldKey)
+			newKey := crypto.GenerateKeyEllipticP256()
+			newPub := crypto.PublicKeyToBytes(&newKey.PublicKey)
+
+			var orders []*types.StandingOrderRecord
+			if tt.orders != nil {
+				orders = tt.orders(oldPub)
+			}
+			f.orders.EXPECT().ListStandingOrders(oldPub).Return(orders, nil).Times(1)
+			sweep := types.NewTransaction()
+			if tt.wantSweep {
+				f.sweeper.EXPECT().SweepTx(gomock.Any()).DoAndReturn(func(req *types.SweepRequest) (*types.Transaction, error) {
+					require.Equal(t1, oldPub, crypto.PublicKeyToBytes(req.Sender.Public()))
+					require.Equal(t1, crypto.PublicKeyHash(&newKey.PublicKey), req.Receiver)
+					if tt.sweepErr != nil {
+						return nil, tt.sweepErr
+					}
+					return sweep, nil
+				}).Times(1)
+			}
+			users := f.service
+			if tt.updateErr != nil {
+				raftApi := NewMockRaftAPI(ctrl)
+				raftApi.EXPECT().Apply(gomock.Any(), gomock.Any()).Return(applyFuture{err: tt.updateErr}).Times(1)
+				users = service.NewUserService(f.users, f.keys, f.sweeper, f.orders, raftApi)
+			}
+
+			rotation, sweepTx, err := users.RotateKey(&types.KeyRotationRequest{
+				Username: "alice", Passphrase: passphrase, NewKey: newKey, Fee: 1,
+			})
+			if tt.wantErr {
+				require.Error(t1, err)
+				if tt.updateErr != nil {
+					require.ErrorIs(t1, err, tt.updateErr)
+				}
+				// the user and its key stay as they were
+				user, err := f.service.GetUser("alice")
+				require.NoError(t1, err)
+				require.Equal(t1, oldPub, user.PublicKey)
+				require.Empty(t1, user.Rotations)
+				require.NoError(t1, f.keys.Unlock("alice", passphrase, 0))
+				key, err := f.keys.Key("alice")
+				require.NoError(t1, err)
+				require.Equal(t1, oldPEM, key)
+				return
+			}
+			require.NoError(t1, err)
+			require.Equal(t1, sweep, sweepTx)
+			require.Equal(t1, oldPub, rotation.OldKey)
+			require.Equal(t1, newPub, rotation.NewKey)
+			require.Equal(t1, sweep.ID, rotation.SweepTxID)
+
+			// the user records the old key linked to the new one
+			user, err := f.service.GetUser("alice")
+			require.NoError(t1, err)
+			require.Equal(t1, newPub, user.PublicKey)
+			require.Equal(t1, []types.KeyRotation{*rotation}, user.Rotations)
+			// the username resolves to the new key, both keys stay the user's
+			pubKey, err := f.service.PublicKey("alice")
+			require.NoError(t1, err)
+			require.Equal(t1, newPub, crypto.PublicKeyToBytes(pubKey))
+			for _, address := range [][]byte{crypto.PublicKeyHash(oldKey.Public()), crypto.PublicKeyHash(&newKey.PublicKey)} {
+				byAddress, err := f.users.GetByAddress(address)
+				require.NoError(t1, err)
+				require.Equal(t1, "alice", byAddress.Username)
+			}
+			// the passphrase unlocks the new key
+			require.NoError(t1, f.service.Unlock("alice", passphrase, 0))
+			signer, err := f.service.Signer("alice")
+			require.NoError(t1, err)
+			require.Equal(t1, newPub, crypto.PublicKeyToBytes(signer.Public()))
+		})
+	}
+}
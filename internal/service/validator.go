@@ -0,0 +1,419 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"slices"
+
+	"local-chain/internal/pkg/merkle"
+
+	"local-chain/internal/types"
+
+	"github.com/google/uuid"
+)
+
+// BlockValidationError is returned when a proposed block breaks a consensus rule.
+// TxID is set when the rule is broken by a particular transaction of the block.
+type BlockValidationError struct {
+	TxID uuid.UUID
+	Err  error
+}
+
+func (e *BlockValidationError) Error() string {
+	if e.TxID == uuid.Nil {
+		return fmt.Sprintf("invalid block: %v", e.Err)
+	}
+	return fmt.Sprintf("invalid block: transaction %s: %v", e.TxID, e.Err)
+}
+
+func (e *BlockValidationError) Unwrap() error {
+	return e.Err
+}
+
+// BlockValidator checks blocks proposed by the leader before they are applied.
+// Validation depends only on the block and the replicated state, so every replica reaches the same verdict.
+type BlockValidator struct {
+	store        Store
+	chainID      string
+	maxBlockSize int
+	// minters are the public keys authorized to mint tokens
+	minters [][]byte
+}
+
+func NewBlockValidator(store Store, chainID string, maxBlockSize int, minters [][]byte) *BlockValidator {
+	return &BlockValidator{
+		store:        store,
+		chainID:      chainID,
+		maxBlockSize: maxBlockSize,
+		minters:      minters,
+	}
+}
+
+// Validate checks the block's hashes, height and size and every transaction in it: signatures, that inputs exist
+// and are unspent (also within the block itself), that outputs are spendable, that inputs pay exactly the outputs and the fee and that
+// the lock time and the input sequence locks have expired at the block's height and timestamp.
+// The fee collector transaction, if any, must be the last one and pay exactly the fees collected in the block.
+// Standing order payments must be due in the block and pay exactly as the order says.
+func (v *BlockValidator) Validate(envelope *types.BlockTxsEnvelope) error {
+	if envelope.Block == nil {
+		return &BlockValidationError{Err: errors.New("block is missing")}
+	}
+	if len(envelope.Txs) == 0 {
+		return &BlockValidationError{Err: errors.New("block has no transactions")}
+	}
+	for _, tx := range envelope.Txs {
+		hash := tx.Hash
+		tx.ComputeHash()
+		if !bytes.Equal(hash, tx.Hash) {
+			return &BlockValidationError{TxID: tx.ID, Err: errors.New("transaction hash mismatch")}
+		}
+	}
+	merkleTree, err := merkle.NewMerkleTree(envelope.Txs...)
+	if err != nil {
+		return &BlockValidationError{Err: fmt.Errorf("failed to create merkle tree: %w", err)}
+	}
+	if !bytes.Equal(merkleTree.Root.Hash, envelope.Block.MerkleRoot) {
+		return &BlockValidationError{Err: errors.New("merkle root does not match block transactions")}
+	}
+	if !bytes.Equal(envelope.Block.Hash, envelope.Block.ComputeHash()) {
+		return &BlockValidationError{Err: errors.New("block hash mismatch")}
+	}
+
+	if err = v.validateHeight(envelope.Block); err != nil {
+		return &BlockValidationError{Err: err}
+	}
+
+	var size int
+	for _, tx := range envelope.Txs {
+		size += tx.Size()
+	}
+	if v.maxBlockSize > 0 && size > v.maxBlockSize {
+		return &BlockValidationError{Err: fmt.Errorf("block size %d exceeds the maximum %d", size, v.maxBlockSize)}
+	}
+
+	// outputs are keyed by the transaction ID, a repeated ID would overwrite the outputs of another transaction
+	ids := make(map[uuid.UUID]struct{}, len(envelope.Txs))
+	for _, tx := range envelope.Txs {
+		if _, ok := ids[tx.ID]; ok {
+			return &BlockValidationError{TxID: tx.ID, Err: errors.New("transaction is repeated in the block")}
+		}
+		ids[tx.ID] = struct{}{}
+		if err = checkNotConfirmed(v.store, tx.ID); err != nil {
+			return &BlockValidationError{TxID: tx.ID, Err: err}
+		}
+	}
+
+	view := newBlockUTXOView(v.store, envelope.Block)
+	executed := make(map[string]struct{})
+	var fees uint64
+	for i, tx := range envelope.Txs {
+		if tx.Execution != nil {
+			if err = v.validateExecution(tx, envelope.Block, view, executed); err != nil {
+				return &BlockValidationError{TxID: tx.ID, Err: err}
+			}
+			view.apply(tx)
+			continue
+		}
+		if tx.IsFeeCollector() {
+			if err = validateFeeCollector(tx, i == len(envelope.Txs)-1, fees); err != nil {
+				return &BlockValidationError{TxID: tx.ID, Err: err}
+			}
+			view.apply(tx)
+			continue
+		}
+		if err = v.validateTx(tx, envelope.Block, view); err != nil {
+			return &BlockValidationError{TxID: tx.ID, Err: err}
+		}
+		view.apply(tx)
+		if fees, err = types.AddValues(fees, tx.Fee); err != nil {
+			return &BlockValidationError{TxID: tx.ID, Err: fmt.Errorf("fees of the block: %w", err)}
+		}
+	}
+	return nil
+}
+
+// validateHeight checks that the block follows the latest stored block: lock times are checked against
+// the height and the timestamp, so both must grow
+func (v *BlockValidator) validateHeight(block *types.Block) error {
+	last, err := v.store.Blockchain().GetLast()
+	if err != nil {
+		return fmt.Errorf("failed to get the latest block: %w", err)
+	}
+	if last == nil {
+		return nil
+	}
+	if block.Height != last.Height+1 {
+		return fmt.Errorf("block height %d does not follow the latest block height %d", block.Height, last.Height)
+	}
+	if block.Timestamp <= last.Timestamp {
+		return fmt.Errorf("block timestamp %d is not after the latest block timestamp %d", block.Timestamp, last.Timestamp)
+	}
+	return nil
+}
+
+func validateFeeCollector(tx *types.Transaction, last bool, fees uint64) error {
+	if !last {
+		return errors.New("fee collector transaction must be the last transaction of the block")
+	}
+	if tx.Issuance != nil || tx.Mint != nil {
+		return errors.New("fee collector transaction issues an asset or mints a token")
+	}
+	if tx.StandingOrder != nil || len(tx.CancelOrder) > 0 {
+		return errors.New("fee collector transaction creates or cancels a standing order")
+	}
+	var outputsValue uint64
+	for _, out := range tx.Outputs {
+		if out.IsAsset() || out.IsToken() {
+			return errors.New("fee collector transaction pays an asset or a token")
+		}
+		value, err := types.AddValues(outputsValue, out.Amount.Value)
+		if err != nil {
+			return fmt.Errorf("fee collector outputs: %w", err)
+		}
+		outputsValue = value
+	}
+	if outputsValue != fees {
+		return fmt.Errorf("fee collector pays %d, collected fees are %d", outputsValue, fees)
+	}
+	return nil
+}
+
+func (v *BlockValidator) validateTx(tx *types.Transaction, block *types.Block, view *blockUTXOView) error {
+	if len(tx.Inputs) == 0 {
+		return errors.New("transaction has no inputs")
+	}
+	spent := make(map[string]struct{}, len(tx.Inputs))
+	spentOutputs := make(map[*types.UTXO]*types.UnspentOutput, len(tx.Inputs))
+	prevouts := make([]*types.TxOut, 0, len(tx.Inputs))
+	for i, in := range tx.Inputs {
+		if in.Prev == nil {
+			return fmt.Errorf("input %d does not reference an output", i)
+		}
+		if _, ok := spent[outpointKey(in.Prev)]; ok {
+			return fmt.Errorf("input %d spends output %s twice", i, outpointKey(in.Prev))
+		}
+		spent[outpointKey(in.Prev)] = struct{}{}
+		utxo, err := view.unspentOutput(in.Prev)
+		if err != nil {
+			return fmt.Errorf("input %d: %w", i, err)
+		}
+		spentOutputs[in.Prev] = utxo
+		prevouts = append(prevouts, utxo.Output)
+	}
+	if err := types.VerifySignatures(tx, v.chainID, func(prev *types.UTXO) (*types.TxOut, error) {
+		return spentOutputs[prev].Output, nil
+	}); err != nil {
+		return err
+	}
+	if err := types.CheckFinal(tx, block.Height, block.Timestamp, func(prev *types.UTXO) (uint64, uint64, error) {
+		return spentOutputs[prev].Height, spentOutputs[prev].Timestamp, nil
+	}); err != nil {
+		return err
+	}
+	for i, out := range tx.Outputs {
+		if err := out.CheckLock(); err != nil {
+			return fmt.Errorf("output %d: %w", i, err)
+		}
+	}
+	if err := checkValues(tx, prevouts, v.minters); err != nil {
+		return err
+	}
+	return checkStandingOrders(v.store, tx, prevouts)
+}
+
+// validateExecution checks a standing order payment the block producer created: the payment must be due in the block
+// and pay the receiver exactly the order amount from the payer's outputs, the change going back to the payer.
+// A failed payment pays nothing and the payer must indeed not have the amount.
+func (v *BlockValidator) validateExecution(
+	tx *types.Transaction,
+	block *types.Block,
+	view *blockUTXOView,
+	executed map[string]struct{},
+) error {
+	execution := tx.Execution
+	if _, ok := executed[string(execution.OrderID)]; ok {
+		return errors.New("standing order is executed twice in the block")
+	}
+	executed[string(execution.OrderID)] = struct{}{}
+	if tx.Fee != 0 || tx.LockTime != 0 || tx.Issuance != nil || tx.Mint != nil || tx.StandingOrder != nil || len(tx.CancelOrder) > 0 {
+		return errors.New("standing order payment does more than paying the order")
+	}
+	record, err := v.store.StandingOrder().Get(execution.OrderID)
+	if err != nil {
+		return fmt.Errorf("error getting standing order : %v", err)
+	}
+	if record == nil {
+		return errors.New("standing order does not exist")
+	}
+	if seq, due := record.Due(block.Timestamp); !due || seq != execution.Seq {
+		return fmt.Errorf("payment %d of the standing order is not due", execution.Seq)
+	}
+	order := record.Order
+	payer := types.AddressOf(order.Payer)
+
+	if execution.Failure != "" {
+		if len(tx.Inputs) > 0 || len(tx.Outputs) > 0 {
+			return errors.New("failed standing order payment spends or pays")
+		}
+		available, err := view.paymentValue(payer)
+		if err != nil {
+			return err
+		}
+		if available >= order.Amount.Value {
+			return fmt.Errorf("standing order payment failed, yet the payer has %d of %d available", available, order.Amount.Value)
+		}
+		return nil
+	}
+
+	spent := make(map[string]struct{}, len(tx.Inputs))
+	prevouts := make([]*types.TxOut, 0, len(tx.Inputs))
+	for i, in := range tx.Inputs {
+		if in.Prev == nil {
+			return fmt.Errorf("input %d does not reference an output", i)
+		}
+		if _, ok := spent[outpointKey(in.Prev)]; ok {
+			return fmt.Errorf("input %d spends output %s twice", i, outpointKey(in.Prev))
+		}
+		spent[outpointKey(in.Prev)] = struct{}{}
+		utxo, err := view.unspentOutput(in.Prev)
+		if err != nil {
+			return fmt.Errorf("input %d: %w", i, err)
+		}
+		if !utxo.Output.IsPaymentOutput(payer) {
+			return fmt.Errorf("input %d does not spend a payment output of the payer", i)
+		}
+		prevouts = append(prevouts, utxo.Output)
+	}
+	if len(tx.Outputs) == 0 || len(tx.Outputs) > 2 {
+		return errors.New("standing order payment must pay the receiver and at most the change")
+	}
+	if !tx.Outputs[0].IsPaymentOutput(order.Receiver) || tx.Outputs[0].Amount.Value != order.Amount.Value {
+		return fmt.Errorf("standing order payment does not pay the receiver %d", order.Amount.Value)
+	}
+	if len(tx.Outputs) == 2 && !tx.Outputs[1].IsPaymentOutput(payer) {
+		return errors.New("standing order payment change does not go back to the payer")
+	}
+	return types.CheckValues(tx, prevouts)
+}
+
+// checkStandingOrders checks what the transaction does to standing orders: a new order must be valid
+// and signed by its payer, a cancelled order must be open and the cancellation signed by its payer
+func checkStandingOrders(store Store, tx *types.Transaction, prevouts []*types.TxOut) error {
+	if err := types.CheckStandingOrder(tx, prevouts); err != nil {
+		return err
+	}
+	if len(tx.CancelOrder) == 0 {
+		return nil
+	}
+	record, err := store.StandingOrder().Get(tx.CancelOrder)
+	if err != nil {
+		return fmt.Errorf("error getting standing order : %v", err)
+	}
+	if record == nil {
+		return errors.New("standing order does not exist")
+	}
+	if record.Cancelled {
+		return errors.New("standing order is already cancelled")
+	}
+	if !slices.ContainsFunc(prevouts, func(out *types.TxOut) bool { return out.IsLockedTo(record.Order.Payer) }) {
+		return errors.New("standing order cancellation is not signed by the payer")
+	}
+	return nil
+}
+
+// checkNotConfirmed refuses the ID of a confirmed transaction, whose outputs a new one would overwrite
+func checkNotConfirmed(store Store, id uuid.UUID) error {
+	confirmed, err := store.Transaction().Has(id)
+	if err != nil {
+		return fmt.Errorf("error getting transaction : %v", err)
+	}
+	if confirmed {
+		return fmt.Errorf("transaction %s is already confirmed", id)
+	}
+	return nil
+}
+
+// checkValues checks the transaction conserves the native coin, every asset and every token,
+// a token mint must be signed by one of the authorized minters
+func checkValues(tx *types.Transaction, prevouts []*types.TxOut, minters [][]byte) error {
+	if err := types.CheckValues(tx, prevouts); err != nil {
+		return err
+	}
+	if tx.Mint != nil && !slices.ContainsFunc(minters, func(minter []byte) bool { return bytes.Equal(minter, tx.Mint.Minter) }) {
+		return errors.New("token minter is not authorized")
+	}
+	return types.CheckTokens(tx, prevouts)
+}
+
+// blockUTXOView is the UTXO set as seen by a transaction of the block being validated:
+// the stored set plus outputs created, minus outputs spent, by the preceding transactions of the block.
+// Outputs created within the block are confirmed by the block itself.
+type blockUTXOView struct {
+	store   Store
+	block   *types.Block
+	created map[string]*types.UnspentOutput
+	spent   map[string]struct{}
+}
+
+func newBlockUTXOView(store Store, block *types.Block) *blockUTXOView {
+	return &blockUTXOView{
+		store:   store,
+		block:   block,
+		created: make(map[string]*types.UnspentOutput),
+		spent:   make(map[string]struct{}),
+	}
+}
+
+func (v *blockUTXOView) unspentOutput(prev *types.UTXO) (*types.UnspentOutput, error) {
+	key := outpointKey(prev)
+	if _, ok := v.spent[key]; ok {
+		return nil, fmt.Errorf("output %s is already spent", key)
+	}
+	if utxo, ok := v.created[key]; ok {
+		return utxo, nil
+	}
+	utxo, err := v.store.Utxo().Get(prev)
+	if err != nil {
+		return nil, fmt.Errorf("error getting utxo : %v", err)
+	}
+	if utxo == nil {
+		return nil, fmt.Errorf("output %s does not exist or is already spent", key)
+	}
+	return utxo, nil
+}
+
+// paymentValue sums the confirmed outputs of the address that can pay standing orders and the block doesn't spend
+func (v *blockUTXOView) paymentValue(address []byte) (uint64, error) {
+	utxos, err := v.store.Utxo().GetByOwner(address)
+	if err != nil {
+		return 0, fmt.Errorf("error getting utxos : %v", err)
+	}
+	var value uint64
+	for _, utxo := range utxos {
+		if _, ok := v.spent[outpointKey(utxo.UTXO)]; ok || !utxo.Output.IsPaymentOutput(address) {
+			continue
+		}
+		value += utxo.Output.Amount.Value
+	}
+	return value, nil
+}
+
+func (v *blockUTXOView) apply(tx *types.Transaction) {
+	for _, in := range tx.Inputs {
+		v.spent[outpointKey(in.Prev)] = struct{}{}
+	}
+	for index, out := range tx.Outputs {
+		utxo := types.NewUTXO(tx.ID, tx.Hash, uint32(index))
+		v.created[outpointKey(utxo)] = &types.UnspentOutput{
+			UTXO:      utxo,
+			Output:    out,
+			Height:    v.block.Height,
+			Timestamp: v.block.Timestamp,
+		}
+	}
+}
+
+func outpointKey(utxo *types.UTXO) string {
+	return fmt.Sprintf("%s:%d", utxo.TxID, utxo.Index)
+}
@@ -0,0 +1,604 @@
+package service_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/pkg/merkle"
+	"local-chain/internal/pkg/script"
+
+	"local-chain/internal/service"
+
+	"local-chain/internal/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockValidator_Validate(t1 *testing.T) {
+	newBlock := func(t1 *testing.T, txs ...*types.Transaction) *types.BlockTxsEnvelope {
+		tree, err := merkle.NewMerkleTree(txs...)
+		require.NoError(t1, err)
+		return types.NewBlockTxsEnvelope(types.NewBlock(1, nil, tree.Root.Hash), txs)
+	}
+	spendLocked := func(t1 *testing.T, key crypto.Signer, prev *types.Transaction, index, lockTime, sequence uint32, to crypto.PublicKey, amounts ...uint64) *types.Transaction {
+		tx := types.NewTransaction().WithInputs(types.NewTxIn(
+			types.NewUTXO(prev.ID, prev.GetHash(), index), crypto.PublicKeyToBytes(key.Public()), nil, nil, sequence,
+		))
+		tx.LockTime = lockTime
+		for _, amount := range amounts {
+			tx.WithOutput(types.NewAmount(amount), to)
+		}
+		tx.ComputeHash()
+		require.NoError(t1, tx.SignInputs(key, types.DefaultChainID))
+		return tx
+	}
+	spend := func(t1 *testing.T, key crypto.Signer, prev *types.Transaction, index uint32, to crypto.PublicKey, amounts ...uint64) *types.Transaction {
+		return spendLocked(t1, key, prev, index, 0, types.SequenceFinal, to, amounts...)
+	}
+	spendMultisig := func(t1 *testing.T, store *MockCustomStore, signers int) *types.Transaction {
+		keys := []*ecdsa.PrivateKey{crypto.GenerateKeyEllipticP256(), crypto.GenerateKeyEllipticP256(), crypto.GenerateKeyEllipticP256()}
+		lock, err := types.NewMultisigLock(2, &keys[0].PublicKey, &keys[1].PublicKey, &keys[2].PublicKey)
+		require.NoError(t1, err)
+		prevTx := types.NewTransaction()
+		prevTx.AddOutput(types.NewMultisigTxOut(prevTx.ID, *types.NewAmount(100), lock))
+		prevTx.ComputeHash()
+		utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+		store.UTXOStore.EXPECT().Get(utxo).
+			Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+		tx := types.NewTransaction().
+			WithInputs(types.NewTxIn(utxo, nil, nil, nil, types.SequenceFinal)).
+			WithOutput(types.NewAmount(100), &keys[0].PublicKey)
+		tx.ComputeHash()
+		psbt := types.NewPartiallySignedTx(tx, prevTx.Outputs)
+		for _, key := range keys[:signers] {
+			_, err = psbt.Sign(key, types.DefaultChainID)
+			require.NoError(t1, err)
+		}
+		return tx
+	}
+	spendHashLock := func(t1 *testing.T, store *MockCustomStore, preimage []byte) *types.Transaction {
+		key := crypto.GenerateKeyEllipticP256()
+		hash := sha256.Sum256([]byte("secret"))
+		locking, err := script.HashLock(hash[:], crypto.PublicKeyToBytes(&key.PublicKey))
+		require.NoError(t1, err)
+		prevTx := types.NewTransaction()
+		prevTx.AddOutput(types.NewScriptTxOut(prevTx.ID, *types.NewAmount(100), locking))
+		prevTx.ComputeHash()
+		utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+		store.UTXOStore.EXPECT().Get(utxo).
+			Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+		tx := types.NewTransaction().
+			WithInputs(types.NewTxIn(utxo, nil, nil, nil, types.SequenceFinal)).
+			WithOutput(types.NewAmount(100), &key.PublicKey)
+		tx.ComputeHash()
+		sig, err := tx.ScriptSignature(key, types.DefaultChainID)
+		require.NoError(t1, err)
+		tx.Inputs[0].Script, err = script.Unlock(sig, preimage)
+		require.NoError(t1, err)
+		return tx
+	}
+	spendToData := func(t1 *testing.T, store *MockCustomStore, dataValue uint64) *types.Transaction {
+		from := crypto.GenerateKeyEllipticP256()
+		prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+		prevTx.ComputeHash()
+		utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+		store.UTXOStore.EXPECT().Get(utxo).
+			Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+		tx := types.NewTransaction().
+			WithInputs(types.NewTxIn(utxo, crypto.PublicKeyToBytes(&from.PublicKey), nil, nil, types.SequenceFinal))
+		out, err := types.NewDataTxOut(tx.ID, []byte("document hash"))
+		require.NoError(t1, err)
+		out.Amount.Value = dataValue
+		tx.AddOutput(out)
+		tx.WithOutput(types.NewAmount(100-dataValue), &from.PublicKey)
+		tx.ComputeHash()
+		require.NoError(t1, tx.SignInputs(from, types.DefaultChainID))
+		return tx
+	}
+	// issueAndMove issues an asset minting minted of the supply of 1000, then moves moved of it within the block
+	issueAndMove := func(t1 *testing.T, store *MockCustomStore, minted, moved uint64) *types.BlockTxsEnvelope {
+		issuer := crypto.GenerateKeyEllipticP256()
+		to := crypto.GenerateKeyEllipticP256()
+		issuerPubKey := crypto.PublicKeyToBytes(&issuer.PublicKey)
+		prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &issuer.PublicKey)
+		prevTx.ComputeHash()
+		utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+		store.UTXOStore.EXPECT().Get(utxo).
+			Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+		issueTx := types.NewTransaction().
+			WithInputs(types.NewTxIn(utxo, issuerPubKey, nil, nil, types.SequenceFinal))
+		issueTx.Issuance = &types.Asset{
+			ID:     types.NewAssetID(issueTx.ID, "GOLD"),
+			Name:   "GOLD",
+			Supply: 1000,
+			Issuer: issuerPubKey,
+		}
+		supply := types.NewTxOut(issueTx.ID, *types.NewAmount(minted), crypto.PublicKeyHash(&issuer.PublicKey))
+		supply.AssetID = issueTx.Issuance.ID
+		issueTx.AddOutput(supply)
+		issueTx.WithOutput(types.NewAmount(100), &issuer.PublicKey)
+		issueTx.ComputeHash()
+		require.NoError(t1, issueTx.SignInputs(issuer, types.DefaultChainID))
+
+		moveTx := types.NewTransaction().WithInputs(types.NewTxIn(
+			types.NewUTXO(issueTx.ID, issueTx.GetHash(), 0), issuerPubKey, nil, nil, types.SequenceFinal,
+		))
+		moveOut := types.NewTxOut(moveTx.ID, *types.NewAmount(moved), crypto.PublicKeyHash(&to.PublicKey))
+		moveOut.AssetID = issueTx.Issuance.ID
+		moveTx.AddOutput(moveOut)
+		moveTx.ComputeHash()
+		require.NoError(t1, moveTx.SignInputs(issuer, types.DefaultChainID))
+		return newBlock(t1, issueTx, moveTx)
+	}
+	// the validator authorizes the minter only
+	minter := crypto.GenerateKeyEllipticP256()
+	// mintAndMove mints a token by the key, then moves it to the receivers within the block
+	mintAndMove := func(t1 *testing.T, store *MockCustomStore, key *ecdsa.PrivateKey, receivers int) *types.BlockTxsEnvelope {
+		keyPubKey := crypto.PublicKeyToBytes(&key.PublicKey)
+		prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &key.PublicKey)
+		prevTx.ComputeHash()
+		utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+		store.UTXOStore.EXPECT().Get(utxo).
+			Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+		mintTx := types.NewTransaction().
+			WithInputs(types.NewTxIn(utxo, keyPubKey, nil, nil, types.SequenceFinal))
+		metadataHash := sha256.Sum256([]byte("certificate"))
+		mintTx.Mint = &types.Token{
+			ID:           types.NewTokenID(mintTx.ID, metadataHash[:]),
+			MetadataHash: metadataHash[:],
+			Minter:       keyPubKey,
+		}
+		mintTx.AddOutput(types.NewTokenTxOut(mintTx.ID, mintTx.Mint, crypto.PublicKeyHash(&key.PublicKey)))
+		mintTx.WithOutput(types.NewAmount(100), &key.PublicKey)
+		mintTx.ComputeHash()
+		require.NoError(t1, mintTx.SignInputs(key, types.DefaultChainID))
+
+		moveTx := types.NewTransaction().WithInputs(types.NewTxIn(
+			types.NewUTXO(mintTx.ID, mintTx.GetHash(), 0), keyPubKey, nil, nil, types.SequenceFinal,
+		))
+		for range receivers {
+			to := crypto.GenerateKeyEllipticP256()
+			moveTx.AddOutput(types.NewTokenTxOut(moveTx.ID, mintTx.Mint, crypto.PublicKeyHash(&to.PublicKey)))
+		}
+		moveTx.ComputeHash()
+		require.NoError(t1, moveTx.SignInputs(key, types.DefaultChainID))
+		return newBlock(t1, mintTx, moveTx)
+	}
+	// standingOrder stores an order paying 30 every hour from the start, the payer owns an output of the balance
+	standingOrder := func(store *MockCustomStore, start, balance uint64) (*types.StandingOrder, *types.UnspentOutput) {
+		payer := crypto.GenerateKeyEllipticP256()
+		receiver := crypto.GenerateKeyEllipticP256()
+		order := &types.StandingOrder{
+			ID:       []byte("order"),
+			Payer:    crypto.PublicKeyToBytes(&payer.PublicKey),
+			Receiver: crypto.PublicKeyHash(&receiver.PublicKey),
+			Amount:   *types.NewAmount(30),
+			Start:    start,
+			Interval: 3600,
+		}
+		store.OrderStore.EXPECT().Get(order.ID).Return(&types.StandingOrderRecord{Order: order}, nil).Times(1)
+		prevTx := types.NewTransaction().WithOutput(types.NewAmount(balance), &payer.PublicKey)
+		prevTx.ComputeHash()
+		utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+		return order, &types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}
+	}
+	// payOrder pays the receiver and the change from the payer's output
+	payOrder := func(order *types.StandingOrder, utxo *types.UnspentOutput, paid, change uint64) *types.Transaction {
+		tx := types.NewOrderExecutionTx(order, 0)
+		tx.AddInput(types.NewTxIn(utxo.UTXO, order.Payer, nil, nil, types.SequenceFinal))
+		tx.AddOutput(types.NewTxOut(tx.ID, *types.NewAmount(paid), order.Receiver))
+		tx.AddOutput(types.NewTxOut(tx.ID, *types.NewAmount(change), types.AddressOf(order.Payer)))
+		tx.ComputeHash()
+		return tx
+	}
+	unspent := func(store *MockCustomStore, prevTx *types.Transaction, height uint64) {
+		utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+		store.UTXOStore.EXPECT().Get(utxo).
+			Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0], Height: height}, nil).Times(1)
+	}
+
+	tests := []struct {
+		name    string
+		block   func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope
+		wantErr bool
+		errIs   error
+	}{
+		{
+			name: "ok chain of transactions within the block",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				from := crypto.GenerateKeyEllipticP256()
+				to := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				prevTx.ComputeHash()
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				tx1 := spend(t1, from, prevTx, 0, &to.PublicKey, 100)
+				tx2 := spend(t1, to, tx1, 0, &from.PublicKey, 60, 40)
+				return newBlock(t1, tx1, tx2)
+			},
+			wantErr: false,
+		},
+		{
+			name: "ok chain of transactions signed by keys of every algorithm",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				ed, err := crypto.GenerateKey(crypto.Ed25519)
+				require.NoError(t1, err)
+				secp, err := crypto.GenerateKey(crypto.Secp256k1)
+				require.NoError(t1, err)
+				p256 := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), ed.Public())
+				prevTx.ComputeHash()
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				tx1 := spend(t1, ed, prevTx, 0, secp.Public(), 100)
+				tx2 := spend(t1, secp, tx1, 0, &p256.PublicKey, 100)
+				tx3 := spend(t1, p256, tx2, 0, ed.Public(), 100)
+				return newBlock(t1, tx1, tx2, tx3)
+			},
+			wantErr: false,
+		},
+		{
+			name: "ok fees credited by the fee collector",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				from := crypto.GenerateKeyEllipticP256()
+				to := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				prevTx.ComputeHash()
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				tx := spend(t1, from, prevTx, 0, &to.PublicKey, 90)
+				tx.Fee = 10
+				// the fee is hashed, the signatures don't commit to it
+				tx.ComputeHash()
+				return newBlock(t1, tx, types.NewFeeCollectorTx(10, crypto.PublicKeyToBytes(&to.PublicKey)))
+			},
+			wantErr: false,
+		},
+		{
+			name: "err fee collector pays more than collected fees",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				from := crypto.GenerateKeyEllipticP256()
+				to := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				prevTx.ComputeHash()
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				tx := spend(t1, from, prevTx, 0, &to.PublicKey, 90)
+				tx.Fee = 10
+				// the fee is hashed, the signatures don't commit to it
+				tx.ComputeHash()
+				return newBlock(t1, tx, types.NewFeeCollectorTx(1000, crypto.PublicKeyToBytes(&to.PublicKey)))
+			},
+			wantErr: true,
+		},
+		{
+			name: "err collected fees overflow",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				from := crypto.GenerateKeyEllipticP256()
+				to := crypto.GenerateKeyEllipticP256()
+				var txs []*types.Transaction
+				for range 2 {
+					prevTx := types.NewTransaction().WithOutput(types.NewAmount(math.MaxUint64), &from.PublicKey)
+					prevTx.ComputeHash()
+					unspent(store, prevTx, 0)
+					tx := spend(t1, from, prevTx, 0, &to.PublicKey, 0)
+					tx.Fee = math.MaxUint64
+					tx.ComputeHash()
+					txs = append(txs, tx)
+				}
+				// the fees wrap around to the value the fee collector pays
+				txs = append(txs, types.NewFeeCollectorTx(math.MaxUint64-1, crypto.PublicKeyToBytes(&to.PublicKey)))
+				return newBlock(t1, txs...)
+			},
+			wantErr: true,
+			errIs:   types.ErrValueOverflow,
+		},
+		{
+			name: "err transaction repeated in the block",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				from := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				tx := spend(t1, from, prevTx, 0, &from.PublicKey, 100)
+				return newBlock(t1, tx, tx)
+			},
+			wantErr: true,
+		},
+		{
+			name: "err transaction already confirmed",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				from := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				tx := spend(t1, from, prevTx, 0, &from.PublicKey, 100)
+				store.TransactionStore.EXPECT().Has(tx.ID).Return(true, nil).Times(1)
+				return newBlock(t1, tx)
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok data output anchors a document hash",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				return newBlock(t1, spendToData(t1, store, 0))
+			},
+			wantErr: false,
+		},
+		{
+			name: "err data output carries value",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				return newBlock(t1, spendToData(t1, store, 10))
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok asset issued and moved within the block",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				return issueAndMove(t1, store, 1000, 1000)
+			},
+			wantErr: false,
+		},
+		{
+			name: "err issuance mints more than the supply",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				return issueAndMove(t1, store, 1001, 1001)
+			},
+			wantErr: true,
+		},
+		{
+			name: "err asset outputs exceed asset inputs",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				return issueAndMove(t1, store, 1000, 1200)
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok token minted and transferred within the block",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				return mintAndMove(t1, store, minter, 1)
+			},
+			wantErr: false,
+		},
+		{
+			name: "err token minted by an unauthorized key",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				return mintAndMove(t1, store, crypto.GenerateKeyEllipticP256(), 1)
+			},
+			wantErr: true,
+		},
+		{
+			name: "err token transferred to two owners",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				return mintAndMove(t1, store, minter, 2)
+			},
+			wantErr: true,
+		},
+		{
+			name: "err token burned",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				return mintAndMove(t1, store, minter, 0)
+			},
+			wantErr: true,
+		},
+		{
+			name: "err merkle root does not match",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				from := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				block := newBlock(t1, spend(t1, from, prevTx, 0, &from.PublicKey, 100))
+				block.Block = types.NewBlock(1, nil, []byte("forged"))
+				return block
+			},
+			wantErr: true,
+		},
+		{
+			name: "err output spent twice within the block",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				from := crypto.GenerateKeyEllipticP256()
+				to := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				prevTx.ComputeHash()
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				return newBlock(t1,
+					spend(t1, from, prevTx, 0, &to.PublicKey, 100),
+					spend(t1, from, prevTx, 0, &from.PublicKey, 100),
+				)
+			},
+			wantErr: true,
+		},
+		{
+			name: "err outputs exceed inputs",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				from := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				prevTx.ComputeHash()
+				utxo := types.NewUTXO(prevTx.ID, prevTx.GetHash(), 0)
+				store.UTXOStore.EXPECT().Get(utxo).
+					Return(&types.UnspentOutput{UTXO: utxo, Output: prevTx.Outputs[0]}, nil).Times(1)
+
+				return newBlock(t1, spend(t1, from, prevTx, 0, &from.PublicKey, 100, 1))
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok multisig output spent by 2 of 3 keys",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				return newBlock(t1, spendMultisig(t1, store, 2))
+			},
+			wantErr: false,
+		},
+		{
+			name: "err multisig output spent by 1 of 3 keys",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				return newBlock(t1, spendMultisig(t1, store, 1))
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok hash lock output spent with the preimage",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				return newBlock(t1, spendHashLock(t1, store, []byte("secret")))
+			},
+			wantErr: false,
+		},
+		{
+			name: "err hash lock output spent with a wrong preimage",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				return newBlock(t1, spendHashLock(t1, store, []byte("guess")))
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok lock time has passed",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				from := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				prevTx.ComputeHash()
+				unspent(store, prevTx, 0)
+
+				lockTime := uint32(time.Now().Add(-time.Minute).Unix())
+				return newBlock(t1, spendLocked(t1, from, prevTx, 0, lockTime, types.SequenceFinal-1, &from.PublicKey, 100))
+			},
+			wantErr: false,
+		},
+		{
+			name: "err lock time height not reached",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				from := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				prevTx.ComputeHash()
+				unspent(store, prevTx, 0)
+
+				return newBlock(t1, spendLocked(t1, from, prevTx, 0, 5, types.SequenceFinal-1, &from.PublicKey, 100))
+			},
+			wantErr: true,
+			errIs:   types.ErrNonFinal,
+		},
+		{
+			name: "ok lock time ignored when every input is final",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				from := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				prevTx.ComputeHash()
+				unspent(store, prevTx, 0)
+
+				return newBlock(t1, spendLocked(t1, from, prevTx, 0, 5, types.SequenceFinal, &from.PublicKey, 100))
+			},
+			wantErr: false,
+		},
+		{
+			name: "err relative lock of the input not expired",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				from := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				prevTx.ComputeHash()
+				unspent(store, prevTx, 0)
+
+				// the output must have 3 confirmations, the block is only the first one after it
+				return newBlock(t1, spendLocked(t1, from, prevTx, 0, 0, 3, &from.PublicKey, 100))
+			},
+			wantErr: true,
+			errIs:   types.ErrNonFinal,
+		},
+		{
+			name: "ok standing order payment due pays the receiver",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				order, utxo := standingOrder(store, 1, 100)
+				store.UTXOStore.EXPECT().Get(utxo.UTXO).Return(utxo, nil).Times(1)
+
+				return newBlock(t1, payOrder(order, utxo, 30, 70))
+			},
+			wantErr: false,
+		},
+		{
+			name: "err standing order payment not due yet",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				order, utxo := standingOrder(store, uint64(time.Now().Add(time.Hour).Unix()), 100)
+
+				return newBlock(t1, payOrder(order, utxo, 30, 70))
+			},
+			wantErr: true,
+		},
+		{
+			name: "err standing order payment pays the receiver less than the amount",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				order, utxo := standingOrder(store, 1, 100)
+				store.UTXOStore.EXPECT().Get(utxo.UTXO).Return(utxo, nil).Times(1)
+
+				return newBlock(t1, payOrder(order, utxo, 20, 80))
+			},
+			wantErr: true,
+		},
+		{
+			name: "ok standing order payment failed for insufficient funds",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				order, utxo := standingOrder(store, 1, 10)
+				store.UTXOStore.EXPECT().GetByOwner(types.AddressOf(order.Payer)).Return([]*types.UnspentOutput{utxo}, nil).Times(1)
+
+				return newBlock(t1, types.NewOrderFailureTx(order, 0, "insufficient funds"))
+			},
+			wantErr: false,
+		},
+		{
+			name: "err standing order payment failed though the payer can pay",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				order, utxo := standingOrder(store, 1, 100)
+				store.UTXOStore.EXPECT().GetByOwner(types.AddressOf(order.Payer)).Return([]*types.UnspentOutput{utxo}, nil).Times(1)
+
+				return newBlock(t1, types.NewOrderFailureTx(order, 0, "insufficient funds"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "err block height does not follow the latest block",
+			block: func(t1 *testing.T, store *MockCustomStore) *types.BlockTxsEnvelope {
+				from := crypto.GenerateKeyEllipticP256()
+				prevTx := types.NewTransaction().WithOutput(types.NewAmount(100), &from.PublicKey)
+				block := newBlock(t1, spend(t1, from, prevTx, 0, &from.PublicKey, 100))
+				block.Block.Height = 7
+				block.Block.Hash = block.Block.ComputeHash()
+				return block
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t1.Run(tt.name, func(t1 *testing.T) {
+			ctrl := gomock.NewController(t1)
+			store := NewMockCustomStore(ctrl)
+			store.BStore.EXPECT().GetLast().Return(&types.Block{Height: 0, Timestamp: 1}, nil).AnyTimes()
+			block := tt.block(t1, store)
+			// the transactions of the block are new unless the case says otherwise
+			store.TransactionStore.EXPECT().Has(gomock.Any()).Return(false, nil).AnyTimes()
+			err := service.NewBlockValidator(store, types.DefaultChainID, 0, [][]byte{crypto.PublicKeyToBytes(&minter.PublicKey)}).Validate(block)
+			if !tt.wantErr {
+				require.NoError(t1, err)
+				return
+			}
+			var validationErr *service.BlockValidationError
+			require.True(t1, errors.As(err, &validationErr), "error should be a BlockValidationError: %v", err)
+			if tt.errIs != nil {
+				require.ErrorIs(t1, err, tt.errIs)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"bytes"
+
+	"local-chain/internal/pkg/crypto"
+)
+
+// AddressOf returns the address of the PEM encoded public key, nil if the key is not valid.
+func AddressOf(pubKey []byte) []byte {
+	key, err := crypto.PublicKeyFromBytes(pubKey)
+	if err != nil {
+		return nil
+	}
+	return crypto.PublicKeyHash(key)
+}
+
+// IsLockedTo reports whether the output is locked to the PEM encoded public key alone: to the key's address,
+// or to the key itself for outputs created before addresses.
+func (out *TxOut) IsLockedTo(pubKey []byte) bool {
+	if out.IsScript() || out.IsMultisig() {
+		return false
+	}
+	if len(out.Address) > 0 {
+		address := AddressOf(pubKey)
+		return address != nil && bytes.Equal(out.Address, address)
+	}
+	return bytes.Equal(out.PubKey, pubKey)
+}
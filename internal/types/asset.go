@@ -0,0 +1,156 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"maps"
+	"slices"
+
+	"local-chain/internal/pkg/crypto"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxAssetNameSize is the longest asset name in bytes
+	MaxAssetNameSize = 32
+	// MaxAssetDecimals is the largest number of decimals of an asset
+	MaxAssetDecimals = 18
+)
+
+// Asset is a user-issued fungible asset. The issuance transaction defines it and mints the whole supply to the issuer,
+// outputs carrying the asset ID move it afterwards. Outputs without an asset ID carry the native coin.
+type Asset struct {
+	ID       []byte
+	Name     string
+	Decimals uint32
+	Supply   uint64
+	Issuer   []byte
+}
+
+// NewAssetID derives the ID of the asset issued by the transaction, no two issuances share one.
+func NewAssetID(txID uuid.UUID, name string) []byte {
+	hash := sha256.New()
+	hash.Write(txID[:])
+	writeBytes(hash, []byte(name))
+	return hash.Sum(nil)
+}
+
+// AssetIssueRequest issues an asset, the issuer pays the fee with the native coin.
+type AssetIssueRequest struct {
+	Issuer   crypto.Signer
+	Name     string
+	Decimals uint32
+	Supply   uint64
+	Fee      uint64
+}
+
+// AssetBalance is the value of an asset owned by a key. Asset is nil for an asset whose issuance isn't confirmed yet.
+type AssetBalance struct {
+	AssetID []byte
+	Asset   *Asset
+	Value   uint64
+}
+
+// Balance is the native coin balance and the per-asset balances of a key, in asset ID order.
+type Balance struct {
+	Amount Amount
+	Assets []AssetBalance
+}
+
+// IsAsset reports whether the output carries an asset instead of the native coin.
+func (out *TxOut) IsAsset() bool {
+	return len(out.AssetID) > 0
+}
+
+// check validates the definition of an asset issued by the transaction
+func (a *Asset) check(txID uuid.UUID) error {
+	if a.Name == "" || len(a.Name) > MaxAssetNameSize {
+		return fmt.Errorf("asset name must be 1 to %d bytes", MaxAssetNameSize)
+	}
+	if a.Decimals > MaxAssetDecimals {
+		return fmt.Errorf("asset decimals %d exceed the maximum %d", a.Decimals, MaxAssetDecimals)
+	}
+	if a.Supply == 0 {
+		return errors.New("asset supply must be positive")
+	}
+	if _, err := crypto.PublicKeyFromBytes(a.Issuer); err != nil {
+		return fmt.Errorf("asset issuer: %w", err)
+	}
+	if !bytes.Equal(a.ID, NewAssetID(txID, a.Name)) {
+		return errors.New("asset ID is not derived from the issuance transaction")
+	}
+	return nil
+}
+
+func (a *Asset) write(hash hash.Hash) {
+	writeBytes(hash, a.ID)
+	writeBytes(hash, []byte(a.Name))
+	writeUint32(hash, a.Decimals)
+	writeUint64(hash, a.Supply)
+	writeBytes(hash, a.Issuer)
+}
+
+// CheckValues checks the transaction conserves every asset separately: the inputs of the native coin pay exactly
+// its outputs and the fee, the inputs of an asset pay exactly its outputs. The asset the transaction issues has no
+// inputs, its outputs mint exactly the supply and the issuer signs the issuance by spending one of its outputs.
+// spent holds the outputs the inputs spend, in the input order.
+func CheckValues(tx *Transaction, spent []*TxOut) error {
+	inputs, err := assetValues(spent)
+	if err != nil {
+		return fmt.Errorf("inputs: %w", err)
+	}
+	outputs, err := assetValues(tx.Outputs)
+	if err != nil {
+		return fmt.Errorf("outputs: %w", err)
+	}
+
+	if issuance := tx.Issuance; issuance != nil {
+		if err = issuance.check(tx.ID); err != nil {
+			return err
+		}
+		if inputs[string(issuance.ID)] > 0 {
+			return errors.New("issuance spends outputs of the asset it issues")
+		}
+		if outputs[string(issuance.ID)] != issuance.Supply {
+			return fmt.Errorf("issuance mints %d, the supply is %d", outputs[string(issuance.ID)], issuance.Supply)
+		}
+		if !slices.ContainsFunc(spent, func(out *TxOut) bool { return out.IsLockedTo(issuance.Issuer) }) {
+			return errors.New("issuance is not signed by the issuer")
+		}
+		inputs[string(issuance.ID)] = issuance.Supply
+	}
+
+	spending, err := AddValues(outputs[""], tx.Fee)
+	if err != nil {
+		return fmt.Errorf("outputs value %d and fee %d: %w", outputs[""], tx.Fee, err)
+	}
+	if inputs[""] != spending {
+		return fmt.Errorf("outputs value %d and fee %d do not match inputs value %d", outputs[""], tx.Fee, inputs[""])
+	}
+	// assets are checked in ID order, so every replica reports the same mismatch
+	assetIDs := slices.Sorted(maps.Keys(inputs))
+	assetIDs = append(assetIDs, slices.Sorted(maps.Keys(outputs))...)
+	for _, assetID := range assetIDs {
+		if assetID != "" && inputs[assetID] != outputs[assetID] {
+			return fmt.Errorf("asset %x: outputs value %d do not match inputs value %d", assetID, outputs[assetID], inputs[assetID])
+		}
+	}
+	return nil
+}
+
+// assetValues sums the values of the outputs by asset, the native coin under the empty asset ID
+func assetValues(outs []*TxOut) (map[string]uint64, error) {
+	values := make(map[string]uint64)
+	for _, out := range outs {
+		value, err := AddValues(values[string(out.AssetID)], out.Amount.Value)
+		if err != nil {
+			return nil, fmt.Errorf("value of asset %x: %w", out.AssetID, err)
+		}
+		values[string(out.AssetID)] = value
+	}
+	return values, nil
+}
@@ -9,24 +9,30 @@ import (
 )
 
 type Block struct {
-	Timestamp  uint64
+	Timestamp uint64
+	// Height is the number of blocks before this one
+	Height     uint64
 	PrevHash   []byte
 	Hash       []byte
 	MerkleRoot []byte
 }
 
-func NewBlock(prevHash []byte, merkleRoot []byte) *Block {
-	return &Block{
-		Timestamp: uint64(time.Now().UnixNano()),
-		PrevHash:  prevHash,
-		Hash:      merkleRoot,
+func NewBlock(height uint64, prevHash []byte, merkleRoot []byte) *Block {
+	block := &Block{
+		Timestamp:  uint64(time.Now().UnixNano()),
+		Height:     height,
+		PrevHash:   prevHash,
+		MerkleRoot: merkleRoot,
 	}
+	block.Hash = block.ComputeHash()
+	return block
 }
 
 // ComputeHash computes the hash of a block.
 func (b *Block) ComputeHash() []byte {
 	hash := sha512.New()
 	hash.Write([]byte(strconv.FormatUint(b.Timestamp, 10)))
+	hash.Write([]byte(strconv.FormatUint(b.Height, 10)))
 	hash.Write(b.PrevHash)
 	hash.Write(b.MerkleRoot)
 	return hash.Sum(nil)
@@ -7,6 +7,7 @@ type EnvelopeType string
 const (
 	EnvelopeTypeBlock       EnvelopeType = "block_type"
 	EnvelopeTypeTransaction EnvelopeType = "transaction_type"
+	EnvelopeTypeUser        EnvelopeType = "user_type"
 )
 
 type Envelope struct {
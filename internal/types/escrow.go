@@ -0,0 +1,58 @@
+package types
+
+import (
+	"local-chain/internal/pkg/crypto"
+)
+
+// EscrowRequest locks Amount of the buyer in an escrow output for the seller, the arbiter settles disputes.
+// The buyer gets the amount back at Timeout, a lock time: a block height, or a unix time in seconds
+// from LockTimeThreshold on. Fee is paid by the escrow transaction and again, out of the amount, by the refund.
+type EscrowRequest struct {
+	Buyer   crypto.Signer
+	Seller  crypto.PublicKey
+	Arbiter crypto.PublicKey
+	Amount  Amount
+	Timeout uint32
+	Fee     uint64
+}
+
+// EscrowReleaseRequest pays the escrow output to the seller, signed by both the buyer and the seller.
+type EscrowReleaseRequest struct {
+	Buyer    crypto.Signer
+	Seller   crypto.Signer
+	Outpoint *UTXO
+	Fee      uint64
+}
+
+// EscrowDisputeRequest settles a dispute: the arbiter and the party it rules for, the buyer or the seller,
+// pay the escrow output to that party.
+type EscrowDisputeRequest struct {
+	Arbiter  crypto.Signer
+	Party    crypto.Signer
+	Outpoint *UTXO
+	Fee      uint64
+}
+
+// EscrowRefundRequest pays the escrow output back to the buyer once the timeout has passed.
+type EscrowRefundRequest struct {
+	Buyer    crypto.Signer
+	Outpoint *UTXO
+	Fee      uint64
+}
+
+// Escrow is a confirmed escrow output with its parties.
+type Escrow struct {
+	Outpoint *UTXO
+	Amount   Amount
+	Buyer    []byte
+	Seller   []byte
+	Arbiter  []byte
+	Timeout  uint32
+	// BlockHeight is the height of the block that confirmed the escrow
+	BlockHeight uint64
+}
+
+// Participants returns the keys of the parties of the escrow.
+func (e *Escrow) Participants() [][]byte {
+	return [][]byte{e.Buyer, e.Seller, e.Arbiter}
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Size is the encoded size of the transaction in bytes, fee rates and the block size limit are measured in it.
+func (tx *Transaction) Size() int {
+	data, err := tx.ToBytes()
+	if err != nil {
+		return 0
+	}
+	return len(data)
+}
+
+// FeeRate is the fee the transaction pays per kilobyte. The fee is multiplied in 128 bits, a rate above
+// the largest value is capped to it.
+func (tx *Transaction) FeeRate() uint64 {
+	size := uint64(tx.Size())
+	if size == 0 {
+		return 0
+	}
+	hi, lo := bits.Mul64(tx.Fee, 1000)
+	if hi >= size {
+		return math.MaxUint64
+	}
+	rate, _ := bits.Div64(hi, lo, size)
+	return rate
+}
+
+// MinFee is the fee a transaction of the given size pays at the fee rate per kilobyte, rounded up.
+// It fails with ErrValueOverflow if the fee is above the largest value.
+func MinFee(feeRate uint64, size int) (uint64, error) {
+	hi, lo := bits.Mul64(feeRate, uint64(size))
+	if hi != 0 {
+		return 0, ErrValueOverflow
+	}
+	fee := lo / 1000
+	if lo%1000 != 0 {
+		fee++
+	}
+	return fee, nil
+}
+
+// IsFeeCollector reports whether the transaction credits the fees collected in a block: it is the only kind of
+// transaction without inputs besides failed standing order payments, the block producer adds it as the last
+// transaction of the block.
+func (tx *Transaction) IsFeeCollector() bool {
+	return len(tx.Inputs) == 0 && tx.Execution == nil
+}
+
+// NewFeeCollectorTx creates the transaction paying the fees collected in a block to the collector address.
+func NewFeeCollectorTx(fees uint64, address []byte) *Transaction {
+	tx := NewTransaction()
+	tx.AddOutput(NewTxOut(tx.ID, *NewAmount(fees), address))
+	tx.ComputeHash()
+	return tx
+}
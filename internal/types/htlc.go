@@ -0,0 +1,40 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	"local-chain/internal/pkg/crypto"
+)
+
+// HTLCRequest locks Amount of the sender in a hash-time-locked contract: the receiver claims it with the preimage
+// of Hash (SHA-256) before Deadline, the sender gets it back after Deadline. Deadline is a lock time:
+// a block height, or a unix time in seconds from LockTimeThreshold on.
+type HTLCRequest struct {
+	Sender   crypto.Signer
+	Receiver crypto.PublicKey
+	Amount   Amount
+	Hash     []byte
+	Deadline uint32
+	Fee      uint64
+}
+
+// HTLCClaimRequest pays the contract output to its receiver, revealing the preimage.
+type HTLCClaimRequest struct {
+	Receiver crypto.Signer
+	Outpoint *UTXO
+	Preimage []byte
+	Fee      uint64
+}
+
+// HTLCRefundRequest pays the contract output back to its sender once the deadline has passed.
+type HTLCRefundRequest struct {
+	Sender   crypto.Signer
+	Outpoint *UTXO
+	Fee      uint64
+}
+
+// HashPreimage hashes the secret of a hash-time-locked contract.
+func HashPreimage(preimage []byte) []byte {
+	hash := sha256.Sum256(preimage)
+	return hash[:]
+}
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+const (
+	// LockTimeThreshold splits lock times: below it the lock time is a block height, from it on a unix time in seconds
+	LockTimeThreshold = 500_000_000
+	// SequenceFinal opts the input out of relative locks, a transaction whose inputs are all final ignores its lock time
+	SequenceFinal = math.MaxUint32
+	// SequenceLockTimeDisableFlag turns off the relative lock of the input
+	SequenceLockTimeDisableFlag = 1 << 31
+	// SequenceLockTimeTypeFlag makes the relative lock count time in SequenceLockTimeGranularity units instead of blocks
+	SequenceLockTimeTypeFlag = 1 << 22
+	// SequenceLockTimeMask is the part of the sequence holding the relative lock value
+	SequenceLockTimeMask = 0x0000ffff
+	// SequenceLockTimeGranularity is the shift turning a relative time lock value into seconds (units of 512 seconds)
+	SequenceLockTimeGranularity = 9
+)
+
+// ErrNonFinal is returned for a transaction whose lock time or input sequence locks haven't expired yet.
+// Such a transaction stays valid, it can be mined in a later block.
+var ErrNonFinal = errors.New("transaction is not final")
+
+// ConfirmationFunc resolves the height and the timestamp of the block that confirmed the output an input spends.
+type ConfirmationFunc func(prev *UTXO) (height uint64, timestamp uint64, err error)
+
+// IsFinal reports whether the lock time allows the transaction in the block at the height and the timestamp
+// (unix nanoseconds).
+func (tx *Transaction) IsFinal(height, timestamp uint64) bool {
+	if tx.LockTime == 0 {
+		return true
+	}
+	lockedUntil := timestamp / uint64(time.Second)
+	if tx.LockTime < LockTimeThreshold {
+		lockedUntil = height
+	}
+	if uint64(tx.LockTime) < lockedUntil {
+		return true
+	}
+	for _, in := range tx.Inputs {
+		if in.NSequence != SequenceFinal {
+			return false
+		}
+	}
+	return true
+}
+
+// LockTimeInForce reports whether the lock time holds the transaction back: it is set and an input doesn't opt out
+// with a final sequence. Such a pending transaction is an offer a conflicting transaction not locked in time replaces.
+func (tx *Transaction) LockTimeInForce() bool {
+	if tx.LockTime == 0 {
+		return false
+	}
+	for _, in := range tx.Inputs {
+		if in.NSequence != SequenceFinal {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckFinal checks the lock time and the relative locks of every input against the block at the height and
+// the timestamp (unix nanoseconds). The errors wrap ErrNonFinal.
+func CheckFinal(tx *Transaction, height, timestamp uint64, confirmedAt ConfirmationFunc) error {
+	if !tx.IsFinal(height, timestamp) {
+		return fmt.Errorf("%w: locked until %d", ErrNonFinal, tx.LockTime)
+	}
+	for i, in := range tx.Inputs {
+		if in.NSequence&SequenceLockTimeDisableFlag != 0 || in.Prev == nil {
+			continue
+		}
+		prevHeight, prevTimestamp, err := confirmedAt(in.Prev)
+		if err != nil {
+			return fmt.Errorf("input %d: %w", i, err)
+		}
+		value := uint64(in.NSequence & SequenceLockTimeMask)
+		if in.NSequence&SequenceLockTimeTypeFlag != 0 {
+			unlocksAt := prevTimestamp + value<<SequenceLockTimeGranularity*uint64(time.Second)
+			if timestamp < unlocksAt {
+				return fmt.Errorf("%w: input %d is locked until %d", ErrNonFinal, i, unlocksAt)
+			}
+			continue
+		}
+		if height < prevHeight+value {
+			return fmt.Errorf("%w: input %d is locked until height %d", ErrNonFinal, i, prevHeight+value)
+		}
+	}
+	return nil
+}
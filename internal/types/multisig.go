@@ -0,0 +1,177 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/pkg/script"
+
+	"github.com/google/uuid"
+)
+
+// multisigOwnerPrefix tells multisig owners from public keys in the owner index
+const multisigOwnerPrefix = "multisig:"
+
+// MultisigLock locks an output to Threshold signatures of any of PubKeys.
+type MultisigLock struct {
+	Threshold uint32
+	PubKeys   [][]byte
+}
+
+// NewMultisigLock checks the threshold and the keys and normalizes the keys, so the same signers always
+// produce the same owner.
+func NewMultisigLock(threshold uint32, pubKeys ...crypto.PublicKey) (*MultisigLock, error) {
+	lock := &MultisigLock{Threshold: threshold}
+	for _, pubKey := range pubKeys {
+		lock.PubKeys = append(lock.PubKeys, crypto.PublicKeyToBytes(pubKey))
+	}
+	slices.SortFunc(lock.PubKeys, bytes.Compare)
+	if err := lock.Check(); err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+// Check validates the threshold against the keys: it is between 1 and the number of distinct valid keys.
+func (l *MultisigLock) Check() error {
+	if l.Threshold == 0 {
+		return errors.New("multisig threshold must be at least 1")
+	}
+	if int(l.Threshold) > len(l.PubKeys) {
+		return fmt.Errorf("multisig threshold %d exceeds the number of keys %d", l.Threshold, len(l.PubKeys))
+	}
+	for i, pubKey := range l.PubKeys {
+		if _, err := crypto.PublicKeyFromBytes(pubKey); err != nil {
+			return fmt.Errorf("multisig key %d: %w", i, err)
+		}
+		if slices.ContainsFunc(l.PubKeys[:i], func(other []byte) bool { return bytes.Equal(other, pubKey) }) {
+			return fmt.Errorf("multisig key %d is listed twice", i)
+		}
+	}
+	return nil
+}
+
+// Owner identifies outputs locked by the lock in the owner index, the way a public key identifies its outputs.
+func (l *MultisigLock) Owner() []byte {
+	keys := slices.Clone(l.PubKeys)
+	slices.SortFunc(keys, bytes.Compare)
+	hash := sha512.New()
+	writeUint32(hash, l.Threshold)
+	for _, pubKey := range keys {
+		writeBytes(hash, pubKey)
+	}
+	return append([]byte(multisigOwnerPrefix), hash.Sum(nil)...)
+}
+
+func (l *MultisigLock) hasKey(pubKey []byte) bool {
+	return slices.ContainsFunc(l.PubKeys, func(key []byte) bool { return bytes.Equal(key, pubKey) })
+}
+
+// Signature is a signature of one of the keys of a multisig output spent by the input.
+type Signature struct {
+	PubKey     []byte
+	SignatureR *big.Int
+	SignatureS *big.Int
+}
+
+// NewMultisigTxOut creates an output spendable by Threshold signatures of the lock's keys.
+func NewMultisigTxOut(id uuid.UUID, amount Amount, lock *MultisigLock) *TxOut {
+	return &TxOut{
+		TxID:      id,
+		Amount:    amount,
+		Threshold: lock.Threshold,
+		PubKeys:   lock.PubKeys,
+	}
+}
+
+// IsMultisig reports whether the output is locked to several keys instead of PubKey.
+func (out *TxOut) IsMultisig() bool {
+	return out.Threshold > 0
+}
+
+// Multisig returns the lock of a multisig output.
+func (out *TxOut) Multisig() *MultisigLock {
+	return &MultisigLock{Threshold: out.Threshold, PubKeys: out.PubKeys}
+}
+
+// Owner is the key the output is indexed by: the address, the owner of the multisig lock or of the script.
+// Outputs locked to a public key before addresses are indexed by the key's address too.
+func (out *TxOut) Owner() []byte {
+	if out.IsScript() {
+		return ScriptOwner(out.Script)
+	}
+	if out.IsMultisig() {
+		return out.Multisig().Owner()
+	}
+	if len(out.Address) > 0 {
+		return out.Address
+	}
+	return AddressOf(out.PubKey)
+}
+
+// CheckLock validates whatever the output is locked to, so it can be spent later.
+func (out *TxOut) CheckLock() error {
+	if out.IsToken() {
+		if err := out.checkTokenOutput(); err != nil {
+			return err
+		}
+	}
+	if out.IsScript() {
+		if len(out.PubKey) > 0 || len(out.Address) > 0 || len(out.PubKeys) > 0 || out.Threshold > 0 {
+			return errors.New("script output is locked to keys as well")
+		}
+		if out.IsData() {
+			return out.checkDataOutput()
+		}
+		_, err := script.Parse(out.Script)
+		return err
+	}
+	if out.IsMultisig() {
+		return out.Multisig().Check()
+	}
+	if len(out.PubKeys) > 0 {
+		return errors.New("keys of a multisig output without a threshold")
+	}
+	if len(out.Address) > 0 {
+		if len(out.PubKey) > 0 {
+			return errors.New("output is locked to an address and a public key")
+		}
+		if len(out.Address) != crypto.AddressSize {
+			return fmt.Errorf("address of %d bytes instead of %d", len(out.Address), crypto.AddressSize)
+		}
+		return nil
+	}
+	_, err := crypto.PublicKeyFromBytes(out.PubKey)
+	return err
+}
+
+// verifyMultisig checks the input carries valid signatures of at least the threshold of distinct keys of the output.
+func verifyMultisig(in *TxIn, output *TxOut, digest []byte) error {
+	lock := output.Multisig()
+	signed := make(map[string]struct{}, len(in.Signatures))
+	for _, sig := range in.Signatures {
+		if !lock.hasKey(sig.PubKey) {
+			return errors.New("signer is not a key of the multisig output")
+		}
+		if _, ok := signed[string(sig.PubKey)]; ok {
+			return errors.New("multisig key signed twice")
+		}
+		pubKey, err := crypto.PublicKeyFromBytes(sig.PubKey)
+		if err != nil {
+			return err
+		}
+		if sig.SignatureR == nil || sig.SignatureS == nil || !crypto.Verify(pubKey, digest, sig.SignatureR, sig.SignatureS) {
+			return errors.New("invalid multisig signature")
+		}
+		signed[string(sig.PubKey)] = struct{}{}
+	}
+	if len(signed) < int(lock.Threshold) {
+		return fmt.Errorf("%d of %d required multisig signatures", len(signed), lock.Threshold)
+	}
+	return nil
+}
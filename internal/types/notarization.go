@@ -0,0 +1,81 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/pkg/script"
+
+	"github.com/google/uuid"
+)
+
+// MaxDataSize is the largest payload of a data output, enough for a SHA-512 document hash
+const MaxDataSize = 80
+
+// NotarizeRequest anchors the data, typically a document hash, in a data output of a transaction of the sender.
+type NotarizeRequest struct {
+	Sender crypto.Signer
+	Data   []byte
+	Fee    uint64
+}
+
+// Notarization proves the data was anchored: the transaction carrying it, the block confirming the transaction
+// and the Merkle proof linking the transaction hash to the block's Merkle root.
+type Notarization struct {
+	Tx    *Transaction
+	Block *Block
+	Proof MerkleProof
+}
+
+// MerkleStep is the hash of a sibling on the path from a transaction up to the Merkle root.
+// Left tells the sibling is hashed on the left: H(sibling || current).
+type MerkleStep struct {
+	Hash []byte
+	Left bool
+}
+
+// MerkleProof is the path of sibling hashes from a transaction up to the Merkle root.
+type MerkleProof []MerkleStep
+
+// NewDataTxOut creates a zero-value output carrying the data, the output can never be spent.
+func NewDataTxOut(id uuid.UUID, data []byte) (*TxOut, error) {
+	if err := checkData(data); err != nil {
+		return nil, err
+	}
+	lockingScript, err := script.NullData(data)
+	if err != nil {
+		return nil, err
+	}
+	return &TxOut{TxID: id, Script: lockingScript}, nil
+}
+
+// IsData reports whether the output carries data instead of value.
+func (out *TxOut) IsData() bool {
+	_, ok := script.NullDataPayload(out.Script)
+	return ok
+}
+
+// Data returns the payload of a data output.
+func (out *TxOut) Data() []byte {
+	data, _ := script.NullDataPayload(out.Script)
+	return data
+}
+
+// checkDataOutput validates a data output: it carries no value and at most MaxDataSize bytes
+func (out *TxOut) checkDataOutput() error {
+	if out.Amount.Value != 0 {
+		return fmt.Errorf("data output carries value %d", out.Amount.Value)
+	}
+	return checkData(out.Data())
+}
+
+func checkData(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("data output carries no data")
+	}
+	if len(data) > MaxDataSize {
+		return fmt.Errorf("data of %d bytes exceeds the maximum %d", len(data), MaxDataSize)
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package types
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"local-chain/internal/pkg/crypto"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// PartiallySignedTx is a transaction passed between the holders of the keys it needs signatures of.
+// It carries the outputs the inputs spend, so a signer can see what is spent and sign without a node.
+type PartiallySignedTx struct {
+	Tx *Transaction
+	// Prevouts are the outputs spent by the inputs, in the input order
+	Prevouts []*TxOut
+}
+
+func NewPartiallySignedTx(tx *Transaction, prevouts []*TxOut) *PartiallySignedTx {
+	return &PartiallySignedTx{
+		Tx:       tx,
+		Prevouts: prevouts,
+	}
+}
+
+// Sign adds the key's signature to every input spending an output the key can sign for and returns
+// the number of signed inputs. A signature the key already made is replaced.
+func (p *PartiallySignedTx) Sign(key crypto.Signer, chainID string) (int, error) {
+	if err := p.check(); err != nil {
+		return 0, err
+	}
+	pubKey := crypto.PublicKeyToBytes(key.Public())
+	digest := p.Tx.SigHash(chainID)
+	var signed int
+	for i, in := range p.Tx.Inputs {
+		prevout := p.Prevouts[i]
+		if !prevout.IsMultisig() && !prevout.IsLockedTo(pubKey) ||
+			prevout.IsMultisig() && !prevout.Multisig().hasKey(pubKey) {
+			continue
+		}
+		r, s, err := crypto.Sign(key, digest)
+		if err != nil {
+			return signed, fmt.Errorf("failed to sign input %d: %w", i, err)
+		}
+		signed++
+		if !prevout.IsMultisig() {
+			in.PubKey, in.SignatureR, in.SignatureS = pubKey, r, s
+			continue
+		}
+		in.Signatures = addSignature(in.Signatures, &Signature{PubKey: pubKey, SignatureR: r, SignatureS: s})
+	}
+	if signed == 0 {
+		return 0, errors.New("the key can't sign any input of the transaction")
+	}
+	return signed, nil
+}
+
+// Combine merges signatures collected on another copy of the same transaction.
+func (p *PartiallySignedTx) Combine(other *PartiallySignedTx) error {
+	if err := p.check(); err != nil {
+		return err
+	}
+	if err := other.check(); err != nil {
+		return err
+	}
+	// the chain ID only prefixes the digest, any value tells whether both copies sign the same content
+	if !bytes.Equal(p.Tx.SigHash(""), other.Tx.SigHash("")) {
+		return errors.New("partially signed transactions sign different transactions")
+	}
+	for i, in := range p.Tx.Inputs {
+		otherIn := other.Tx.Inputs[i]
+		if !in.isSigned() && otherIn.isSigned() {
+			in.PubKey, in.SignatureR, in.SignatureS = otherIn.PubKey, otherIn.SignatureR, otherIn.SignatureS
+		}
+		for _, sig := range otherIn.Signatures {
+			in.Signatures = addSignature(in.Signatures, sig)
+		}
+	}
+	return nil
+}
+
+// Complete checks every input has as many signatures as the output it spends requires.
+// Signatures themselves are verified when the transaction is submitted.
+func (p *PartiallySignedTx) Complete() error {
+	if err := p.check(); err != nil {
+		return err
+	}
+	for i, in := range p.Tx.Inputs {
+		prevout := p.Prevouts[i]
+		if prevout.IsMultisig() {
+			if len(in.Signatures) < int(prevout.Threshold) {
+				return fmt.Errorf("input %d has %d of %d required signatures", i, len(in.Signatures), prevout.Threshold)
+			}
+			continue
+		}
+		if !in.isSigned() {
+			return fmt.Errorf("input %d is not signed", i)
+		}
+	}
+	return nil
+}
+
+func (p *PartiallySignedTx) check() error {
+	if p.Tx == nil {
+		return errors.New("partially signed transaction has no transaction")
+	}
+	if len(p.Prevouts) != len(p.Tx.Inputs) {
+		return fmt.Errorf("partially signed transaction has %d spent outputs for %d inputs", len(p.Prevouts), len(p.Tx.Inputs))
+	}
+	return nil
+}
+
+func (p *PartiallySignedTx) ToBytes() ([]byte, error) {
+	return rlp.EncodeToBytes(p)
+}
+
+func (p *PartiallySignedTx) FromBytes(data []byte) error {
+	return rlp.DecodeBytes(data, p)
+}
+
+// Encode returns the blob signers pass to each other.
+func (p *PartiallySignedTx) Encode() (string, error) {
+	data, err := p.ToBytes()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(data), nil
+}
+
+// DecodePartiallySignedTx parses a blob made by Encode.
+func DecodePartiallySignedTx(blob string) (*PartiallySignedTx, error) {
+	data, err := base64.StdEncoding.DecodeString(blob)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode partially signed transaction: %w", err)
+	}
+	p := &PartiallySignedTx{}
+	if err = p.FromBytes(data); err != nil {
+		return nil, fmt.Errorf("failed to decode partially signed transaction: %w", err)
+	}
+	return p, nil
+}
+
+// isSigned reports whether the input carries a single key signature, a missing one decodes as zero
+func (in *TxIn) isSigned() bool {
+	return in.SignatureR != nil && in.SignatureR.Sign() != 0 && in.SignatureS != nil && in.SignatureS.Sign() != 0
+}
+
+// addSignature adds the signature or replaces the one of the same key
+func addSignature(signatures []*Signature, sig *Signature) []*Signature {
+	for i, existing := range signatures {
+		if bytes.Equal(existing.PubKey, sig.PubKey) {
+			signatures[i] = sig
+			return signatures
+		}
+	}
+	return append(signatures, sig)
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"local-chain/internal/pkg/crypto"
+
+	"github.com/google/uuid"
+)
+
+// KeyRotation links a key the user replaced to the key replacing it. The sweep transaction moved the outputs
+// of the old key to the new one, it is uuid.Nil if the old key owned nothing.
+type KeyRotation struct {
+	OldKey    []byte
+	NewKey    []byte
+	SweepTxID uuid.UUID
+	// RotatedAt is the unix time in seconds of the rotation
+	RotatedAt uint64
+}
+
+// KeyRotationRequest replaces the key of the user, the passphrase unlocks the current key and seals the new one.
+type KeyRotationRequest struct {
+	Username   string
+	Passphrase string
+	// NewKey is the key replacing the user's one, a key of the Algorithm is generated if it is nil
+	NewKey    crypto.Signer
+	Algorithm crypto.Algorithm
+	// Fee is paid by the sweep transaction
+	Fee uint64
+}
+
+// SweepRequest moves every output the sender's key owns to the receiver address.
+type SweepRequest struct {
+	Sender   crypto.Signer
+	Receiver []byte
+	Fee      uint64
+}
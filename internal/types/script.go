@@ -0,0 +1,84 @@
+package types
+
+import (
+	"crypto/sha512"
+
+	"local-chain/internal/pkg/crypto"
+
+	"github.com/google/uuid"
+)
+
+// scriptOwnerPrefix tells script owners from public keys in the owner index
+const scriptOwnerPrefix = "script:"
+
+// NewScriptTxOut creates an output spendable by an input whose unlocking script satisfies the locking script.
+func NewScriptTxOut(id uuid.UUID, amount Amount, lockingScript []byte) *TxOut {
+	return &TxOut{
+		TxID:   id,
+		Amount: amount,
+		Script: lockingScript,
+	}
+}
+
+// IsScript reports whether the output is locked by a script instead of keys.
+func (out *TxOut) IsScript() bool {
+	return len(out.Script) > 0
+}
+
+// ScriptOwner identifies outputs locked by the script in the owner index.
+func ScriptOwner(lockingScript []byte) []byte {
+	hash := sha512.Sum512(lockingScript)
+	return append([]byte(scriptOwnerPrefix), hash[:]...)
+}
+
+// ScriptSignature signs the transaction for an unlocking script, the signature is ASN.1 DER encoded.
+// The transaction must be complete but for the unlocking scripts: the signature commits to all inputs and outputs.
+func (tx *Transaction) ScriptSignature(key crypto.Signer, chainID string) ([]byte, error) {
+	return crypto.SignASN1(key, tx.SigHash(chainID))
+}
+
+// scriptChecker verifies signatures and locks for the scripts of an input
+type scriptChecker struct {
+	tx     *Transaction
+	in     *TxIn
+	digest []byte
+}
+
+func (c *scriptChecker) CheckSig(sig, pubKey []byte) bool {
+	key, err := crypto.PublicKeyFromBytes(pubKey)
+	if err != nil {
+		return false
+	}
+	return crypto.VerifyASN1(key, c.digest, sig)
+}
+
+// CheckLockTime requires a transaction lock time of the same kind, height or time, at least the script's one.
+// The transaction can't be mined before its lock time, so neither can the output be spent.
+func (c *scriptChecker) CheckLockTime(lockTime int64) bool {
+	if lockTime > int64(^uint32(0)) || c.in.NSequence == SequenceFinal {
+		return false
+	}
+	if (lockTime < LockTimeThreshold) != (c.tx.LockTime < LockTimeThreshold) {
+		return false
+	}
+	return lockTime <= int64(c.tx.LockTime)
+}
+
+// CheckSequence requires an input relative lock of the same kind, blocks or time, at least the script's one.
+// A script sequence with the disable flag set always passes.
+func (c *scriptChecker) CheckSequence(sequence int64) bool {
+	if sequence > int64(^uint32(0)) {
+		return false
+	}
+	required := uint32(sequence)
+	if required&SequenceLockTimeDisableFlag != 0 {
+		return true
+	}
+	if c.in.NSequence&SequenceLockTimeDisableFlag != 0 {
+		return false
+	}
+	if required&SequenceLockTimeTypeFlag != c.in.NSequence&SequenceLockTimeTypeFlag {
+		return false
+	}
+	return required&SequenceLockTimeMask <= c.in.NSequence&SequenceLockTimeMask
+}
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/pkg/script"
+)
+
+// DefaultChainID is used when the node is not configured with its own chain ID.
+const DefaultChainID = "local-chain"
+
+// PrevOutputFunc resolves the output an input spends.
+type PrevOutputFunc func(prev *UTXO) (*TxOut, error)
+
+// SigHash computes the digest every input of the transaction signs.
+// It commits to the chain ID, the outpoints and sequences of all inputs, all outputs with their locks and the lock time,
+// so a signature can't be replayed into a transaction that pays someone else or into another chain.
+func (tx *Transaction) SigHash(chainID string) []byte {
+	hash := sha512.New()
+	writeBytes(hash, []byte(chainID))
+	hash.Write(tx.ID[:])
+	writeUint32(hash, tx.LockTime)
+	writeUint32(hash, uint32(len(tx.Inputs)))
+	for _, in := range tx.Inputs {
+		in.write(hash)
+	}
+	writeUint32(hash, uint32(len(tx.Outputs)))
+	for _, out := range tx.Outputs {
+		out.write(hash)
+	}
+	if tx.Issuance != nil {
+		tx.Issuance.write(hash)
+	}
+	if tx.Mint != nil {
+		tx.Mint.write(hash)
+	}
+	tx.writeOrder(hash)
+	return hash.Sum(nil)
+}
+
+// SignInputs signs every input that spends an output of the key owner.
+// Inputs spending multisig outputs are signed through a PartiallySignedTx, it knows the outputs they spend.
+func (tx *Transaction) SignInputs(key crypto.Signer, chainID string) error {
+	pubKey := crypto.PublicKeyToBytes(key.Public())
+	digest := tx.SigHash(chainID)
+	for i, in := range tx.Inputs {
+		if !bytes.Equal(in.PubKey, pubKey) {
+			continue
+		}
+		r, s, err := crypto.Sign(key, digest)
+		if err != nil {
+			return fmt.Errorf("failed to sign input %d: %w", i, err)
+		}
+		in.SignatureR, in.SignatureS = r, s
+	}
+	return nil
+}
+
+// VerifySignatures checks that every input is signed over the transaction's SigHash by the key
+// the spent output is locked to, or by the threshold of the keys of a multisig output, or that the unlocking
+// script of the input satisfies the locking script of a script output.
+// It is the single verification path shared by the transactor, the FSM and transaction verification.
+func VerifySignatures(tx *Transaction, chainID string, prevOutput PrevOutputFunc) error {
+	digest := tx.SigHash(chainID)
+	for i, in := range tx.Inputs {
+		if in.Prev == nil {
+			return fmt.Errorf("input %d does not reference an output", i)
+		}
+		output, err := prevOutput(in.Prev)
+		if err != nil {
+			return fmt.Errorf("input %d: %w", i, err)
+		}
+		if output.IsScript() {
+			if err = script.Execute(in.Script, output.Script, &scriptChecker{tx: tx, in: in, digest: digest}); err != nil {
+				return fmt.Errorf("input %d: %w", i, err)
+			}
+			continue
+		}
+		if output.IsMultisig() {
+			if err = verifyMultisig(in, output, digest); err != nil {
+				return fmt.Errorf("input %d: %w", i, err)
+			}
+			continue
+		}
+		pubKey, err := crypto.PublicKeyFromBytes(in.PubKey)
+		if err != nil {
+			return fmt.Errorf("input %d: %w", i, err)
+		}
+		if !output.IsLockedTo(in.PubKey) {
+			return fmt.Errorf("input %d: signer does not own output %s:%d", i, in.Prev.TxID, in.Prev.Index)
+		}
+		if in.SignatureR == nil || in.SignatureS == nil {
+			return fmt.Errorf("input %d: signature is missing", i)
+		}
+		if !crypto.Verify(pubKey, digest, in.SignatureR, in.SignatureS) {
+			return fmt.Errorf("input %d: invalid signature", i)
+		}
+	}
+	return nil
+}
+
+// write writes the outpoint and the sequence of the input, what its signature commits to
+func (in *TxIn) write(w io.Writer) {
+	if in.Prev != nil {
+		_, _ = w.Write(in.Prev.TxID[:])
+		writeUint32(w, in.Prev.Index)
+	}
+	writeUint32(w, in.NSequence)
+}
+
+// write writes the amount and the locks of the output
+func (out *TxOut) write(w io.Writer) {
+	writeBytes(w, out.PubKey)
+	writeBytes(w, out.Address)
+	_, _ = w.Write(out.Amount.ToBytes())
+	writeUint32(w, out.Threshold)
+	writeUint32(w, uint32(len(out.PubKeys)))
+	for _, pubKey := range out.PubKeys {
+		writeBytes(w, pubKey)
+	}
+	writeBytes(w, out.Script)
+	writeBytes(w, out.AssetID)
+	writeBytes(w, out.TokenID)
+	writeBytes(w, out.MetadataHash)
+}
+
+func writeUint32(w io.Writer, v uint32) {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, v)
+	_, _ = w.Write(buf)
+}
+
+func writeUint64(w io.Writer, v uint64) {
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, v)
+	_, _ = w.Write(buf)
+}
+
+func writeBytes(w io.Writer, b []byte) {
+	writeUint32(w, uint32(len(b)))
+	_, _ = w.Write(b)
+}
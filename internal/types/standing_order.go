@@ -0,0 +1,203 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"slices"
+	"time"
+
+	"local-chain/internal/pkg/crypto"
+
+	"github.com/google/uuid"
+)
+
+// StandingOrder is a recurring payment of Amount from the payer to the receiver: payment n is due at
+// Start + n*Interval (unix seconds). The transaction creating the order, signed by the payer, is the payer's
+// allowance for the block producer to execute the payments from the payer's outputs.
+type StandingOrder struct {
+	ID    []byte
+	Payer []byte
+	// Receiver is the address the payments are locked to
+	Receiver []byte
+	Amount   Amount
+	// Start is the unix time in seconds the first payment is due at
+	Start uint64
+	// Interval is the time in seconds between two payments
+	Interval uint64
+	// MaxPayments ends the order after that many payments, failed ones included, zero means no limit
+	MaxPayments uint32
+	// End ends the order at the unix time in seconds, no payment is due after it, zero means no end
+	End uint64
+}
+
+// NewStandingOrderID derives the ID of the order the transaction creates.
+func NewStandingOrderID(txID uuid.UUID) []byte {
+	hash := sha256.New()
+	hash.Write([]byte("standing order"))
+	hash.Write(txID[:])
+	return hash.Sum(nil)
+}
+
+// StandingOrderRequest creates a standing order of the payer, the payer pays the creation fee with the native coin.
+// Start is the unix time in seconds of the first payment, the next block if zero.
+type StandingOrderRequest struct {
+	Payer crypto.Signer
+	// Receiver is the address of the receiver
+	Receiver    []byte
+	Amount      Amount
+	Start       uint64
+	Interval    uint64
+	MaxPayments uint32
+	End         uint64
+	Fee         uint64
+}
+
+// StandingOrderCancelRequest cancels a standing order of the payer, the payer pays the fee with the native coin.
+type StandingOrderCancelRequest struct {
+	Payer   crypto.Signer
+	OrderID []byte
+	Fee     uint64
+}
+
+// OrderExecution marks a transaction the block producer created to execute payment Seq of a standing order.
+// A failed payment is a transaction without inputs and outputs telling why the payment failed.
+type OrderExecution struct {
+	OrderID []byte
+	Seq     uint32
+	Failure string
+}
+
+// OrderPayment is the audit record of an executed or failed payment of a standing order.
+type OrderPayment struct {
+	Seq            uint32
+	TxID           uuid.UUID
+	BlockHeight    uint64
+	BlockTimestamp uint64
+	// Failure tells why the payment failed, empty when the receiver was paid
+	Failure string
+}
+
+// StandingOrderRecord is a confirmed standing order with its state and the audit records of its payments.
+type StandingOrderRecord struct {
+	Order     *StandingOrder
+	Cancelled bool
+	Payments  []OrderPayment
+}
+
+// NewOrderExecutionTx creates the transaction executing payment seq of the order, without inputs and outputs yet.
+func NewOrderExecutionTx(order *StandingOrder, seq uint32) *Transaction {
+	tx := NewTransaction()
+	tx.Execution = &OrderExecution{OrderID: order.ID, Seq: seq}
+	return tx
+}
+
+// NewOrderFailureTx creates the transaction recording the failure of payment seq of the order.
+func NewOrderFailureTx(order *StandingOrder, seq uint32, failure string) *Transaction {
+	tx := NewOrderExecutionTx(order, seq)
+	tx.Execution.Failure = failure
+	tx.ComputeHash()
+	return tx
+}
+
+// Due returns the sequence number of the next payment and whether it is due in a block with the timestamp
+// (unix nanoseconds). Nothing is due once the order is cancelled or ended.
+func (r *StandingOrderRecord) Due(timestamp uint64) (uint32, bool) {
+	seq := uint32(len(r.Payments))
+	if !r.Running() {
+		return seq, false
+	}
+	return seq, r.Order.DueAt(seq) <= timestamp/uint64(time.Second)
+}
+
+// Running reports whether payments of the order are still to come: it is neither cancelled nor ended.
+func (r *StandingOrderRecord) Running() bool {
+	return !r.Cancelled && !r.Order.Ended(uint32(len(r.Payments)))
+}
+
+// DueAt returns the unix time in seconds payment seq is due at.
+func (o *StandingOrder) DueAt(seq uint32) uint64 {
+	return o.Start + uint64(seq)*o.Interval
+}
+
+// Ended reports whether payment seq is past the end of the order.
+func (o *StandingOrder) Ended(seq uint32) bool {
+	return o.MaxPayments > 0 && seq >= o.MaxPayments || o.End > 0 && o.DueAt(seq) > o.End
+}
+
+// IsPaymentOutput reports whether the output pays a standing order to, or from, the address: the native coin
+// locked to the address alone.
+func (out *TxOut) IsPaymentOutput(address []byte) bool {
+	return !out.IsScript() && !out.IsMultisig() && !out.IsAsset() && !out.IsToken() && bytes.Equal(out.Owner(), address)
+}
+
+// check validates the terms of the order the transaction creates
+func (o *StandingOrder) check(txID uuid.UUID) error {
+	if !bytes.Equal(o.ID, NewStandingOrderID(txID)) {
+		return errors.New("standing order ID is not derived from the creating transaction")
+	}
+	if len(o.Payer) == 0 || len(o.Receiver) == 0 {
+		return errors.New("standing order payer and receiver must be provided")
+	}
+	if len(o.Receiver) != crypto.AddressSize {
+		return errors.New("standing order receiver is not an address")
+	}
+	if o.Amount.Value == 0 {
+		return errors.New("standing order amount must be positive")
+	}
+	if o.Interval == 0 {
+		return errors.New("standing order interval must be positive")
+	}
+	if o.End > 0 && o.End < o.Start {
+		return fmt.Errorf("standing order ends at %d before it starts at %d", o.End, o.Start)
+	}
+	return nil
+}
+
+func (o *StandingOrder) write(hash hash.Hash) {
+	writeBytes(hash, o.ID)
+	writeBytes(hash, o.Payer)
+	writeBytes(hash, o.Receiver)
+	hash.Write(o.Amount.ToBytes())
+	writeUint64(hash, o.Start)
+	writeUint64(hash, o.Interval)
+	writeUint32(hash, o.MaxPayments)
+	writeUint64(hash, o.End)
+}
+
+func (e *OrderExecution) write(hash hash.Hash) {
+	writeBytes(hash, e.OrderID)
+	writeUint32(hash, e.Seq)
+	writeBytes(hash, []byte(e.Failure))
+}
+
+// writeOrder writes what the transaction does to standing orders
+func (tx *Transaction) writeOrder(hash hash.Hash) {
+	if tx.StandingOrder != nil {
+		tx.StandingOrder.write(hash)
+	}
+	if len(tx.CancelOrder) > 0 {
+		writeBytes(hash, tx.CancelOrder)
+	}
+	if tx.Execution != nil {
+		tx.Execution.write(hash)
+	}
+}
+
+// CheckStandingOrder checks the order the transaction creates: its terms are valid and the payer signs it
+// by spending one of its outputs. spent holds the outputs the inputs spend.
+func CheckStandingOrder(tx *Transaction, spent []*TxOut) error {
+	order := tx.StandingOrder
+	if order == nil {
+		return nil
+	}
+	if err := order.check(tx.ID); err != nil {
+		return err
+	}
+	if !slices.ContainsFunc(spent, func(out *TxOut) bool { return out.IsLockedTo(order.Payer) }) {
+		return errors.New("standing order is not signed by the payer")
+	}
+	return nil
+}
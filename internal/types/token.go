@@ -0,0 +1,162 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"maps"
+	"slices"
+
+	"local-chain/internal/pkg/crypto"
+
+	"github.com/google/uuid"
+)
+
+// MetadataHashSize is the size of the metadata hash of a token, a SHA-256 hash of the item's document
+const MetadataHashSize = sha256.Size
+
+// Token is a unique non-fungible token. The mint transaction defines it and pays it to its first owner,
+// an output carrying the token ID and the metadata hash moves it afterwards, the whole token at a time.
+type Token struct {
+	ID           []byte
+	MetadataHash []byte
+	// Minter is the authorized key that minted the token
+	Minter []byte
+}
+
+// NewTokenID derives the ID of the token minted by the transaction, no two mints share one.
+func NewTokenID(txID uuid.UUID, metadataHash []byte) []byte {
+	hash := sha256.New()
+	hash.Write([]byte("token"))
+	hash.Write(txID[:])
+	writeBytes(hash, metadataHash)
+	return hash.Sum(nil)
+}
+
+// TokenMintRequest mints a token to the owner's address, the minter's if not set.
+// The minter pays the fee with the native coin.
+type TokenMintRequest struct {
+	Minter       crypto.Signer
+	Owner        []byte
+	MetadataHash []byte
+	Fee          uint64
+}
+
+// TokenTransferRequest transfers a token of the owner to the receiver's address, the owner pays the fee with
+// the native coin.
+type TokenTransferRequest struct {
+	Owner    crypto.Signer
+	Receiver []byte
+	TokenID  []byte
+	Fee      uint64
+}
+
+// TokenTransfer is a confirmed change of the owner of a token, the mint included. Owner is the index key
+// of the output, the address of a single key owner.
+type TokenTransfer struct {
+	TxID           uuid.UUID
+	Owner          []byte
+	BlockHeight    uint64
+	BlockTimestamp uint64
+}
+
+// TokenProvenance is a token with its current owner and every confirmed owner, the first one first.
+type TokenProvenance struct {
+	Token   *Token
+	Owner   []byte
+	History []TokenTransfer
+}
+
+// NewTokenTxOut creates a zero-value output carrying the token, locked to the owner's address.
+func NewTokenTxOut(id uuid.UUID, token *Token, owner []byte) *TxOut {
+	return &TxOut{TxID: id, Address: owner, TokenID: token.ID, MetadataHash: token.MetadataHash}
+}
+
+// IsToken reports whether the output carries a token instead of value.
+func (out *TxOut) IsToken() bool {
+	return len(out.TokenID) > 0
+}
+
+// checkTokenOutput validates a token output: it carries the token only, never value or an asset
+func (out *TxOut) checkTokenOutput() error {
+	if out.Amount.Value != 0 {
+		return fmt.Errorf("token output carries value %d", out.Amount.Value)
+	}
+	if out.IsAsset() || out.IsData() {
+		return errors.New("token output carries an asset or data")
+	}
+	if len(out.TokenID) != sha256.Size {
+		return fmt.Errorf("token ID must be %d bytes", sha256.Size)
+	}
+	if len(out.MetadataHash) != MetadataHashSize {
+		return fmt.Errorf("token metadata hash must be %d bytes", MetadataHashSize)
+	}
+	return nil
+}
+
+// check validates the definition of a token minted by the transaction
+func (t *Token) check(txID uuid.UUID) error {
+	if len(t.MetadataHash) != MetadataHashSize {
+		return fmt.Errorf("token metadata hash must be %d bytes", MetadataHashSize)
+	}
+	if len(t.Minter) == 0 {
+		return errors.New("token minter must be provided")
+	}
+	if !bytes.Equal(t.ID, NewTokenID(txID, t.MetadataHash)) {
+		return errors.New("token ID is not derived from the mint transaction")
+	}
+	return nil
+}
+
+func (t *Token) write(hash hash.Hash) {
+	writeBytes(hash, t.ID)
+	writeBytes(hash, t.MetadataHash)
+	writeBytes(hash, t.Minter)
+}
+
+// CheckTokens checks the transaction moves every token it spends whole to exactly one output, with the metadata
+// hash unchanged. The token the transaction mints has no input and the minter signs the mint by spending one of its
+// outputs, whether the minter is authorized is up to the node. spent holds the outputs the inputs spend.
+func CheckTokens(tx *Transaction, spent []*TxOut) error {
+	owned := make(map[string][]byte)
+	for _, out := range spent {
+		if out.IsToken() {
+			owned[string(out.TokenID)] = out.MetadataHash
+		}
+	}
+	if mint := tx.Mint; mint != nil {
+		if err := mint.check(tx.ID); err != nil {
+			return err
+		}
+		if !slices.ContainsFunc(spent, func(out *TxOut) bool { return out.IsLockedTo(mint.Minter) }) {
+			return errors.New("mint is not signed by the minter")
+		}
+		owned[string(mint.ID)] = mint.MetadataHash
+	}
+	// a token moves to one output only
+	moved := make(map[string]struct{})
+	for i, out := range tx.Outputs {
+		if !out.IsToken() {
+			continue
+		}
+		if _, ok := moved[string(out.TokenID)]; ok {
+			return fmt.Errorf("output %d carries token %x moved to another output", i, out.TokenID)
+		}
+		metadataHash, ok := owned[string(out.TokenID)]
+		if !ok {
+			return fmt.Errorf("output %d carries token %x the transaction does not spend", i, out.TokenID)
+		}
+		if !bytes.Equal(metadataHash, out.MetadataHash) {
+			return fmt.Errorf("output %d changes the metadata of token %x", i, out.TokenID)
+		}
+		delete(owned, string(out.TokenID))
+		moved[string(out.TokenID)] = struct{}{}
+	}
+	if len(owned) > 0 {
+		// the lowest token ID is reported, so every replica reports the same one
+		return fmt.Errorf("token %x is spent without an output carrying it", slices.Sorted(maps.Keys(owned))[0])
+	}
+	return nil
+}
@@ -1,12 +1,11 @@
 package types
 
 import (
-	"crypto/ecdsa"
-	"crypto/rand"
 	"crypto/sha512"
 	"encoding/binary"
-	"fmt"
+	"errors"
 	"math/big"
+	"math/bits"
 	"sort"
 	"time"
 
@@ -19,16 +18,31 @@ import (
 const CurrencyUnit = 100000000
 
 type Transaction struct {
-	ID             uuid.UUID
-	Timestamp      uint64
-	nLockTime      uint32
+	ID        uuid.UUID
+	Timestamp uint64
+	// LockTime is the block height (below LockTimeThreshold) or the unix time in seconds
+	// the transaction can't be mined before, zero means no lock
+	LockTime       uint32
 	BlockTimestamp uint64
+	BlockHeight    uint64
 
 	Salt [16]byte
 	Hash []byte
 
 	Inputs  []*TxIn
 	Outputs []*TxOut
+	// Fee is the value of the inputs not spent by the outputs, it is credited to the block's fee collector
+	Fee uint64
+	// Issuance defines the asset the transaction issues, if any
+	Issuance *Asset `rlp:"nil"`
+	// Mint defines the token the transaction mints, if any
+	Mint *Token `rlp:"nil"`
+	// StandingOrder defines the standing order the transaction creates, if any
+	StandingOrder *StandingOrder `rlp:"nil"`
+	// CancelOrder is the ID of the standing order the transaction cancels, if any
+	CancelOrder []byte
+	// Execution marks a payment of a standing order executed by the block producer
+	Execution *OrderExecution `rlp:"nil"`
 
 	UTXO []*UTXO
 }
@@ -50,11 +64,11 @@ func (tx *Transaction) AddInput(input *TxIn) {
 	tx.Inputs = append(tx.Inputs, input)
 }
 
-func (tx *Transaction) WithOutput(amount *Amount, key *ecdsa.PublicKey) *Transaction {
+func (tx *Transaction) WithOutput(amount *Amount, key crypto.PublicKey) *Transaction {
 	tx.Outputs = append(tx.Outputs, &TxOut{
-		TxID:   tx.ID,
-		Amount: *amount,
-		PubKey: crypto.PublicKeyToBytes(key),
+		TxID:    tx.ID,
+		Amount:  *amount,
+		Address: crypto.PublicKeyHash(key),
 	})
 	return tx
 }
@@ -63,25 +77,42 @@ func (tx *Transaction) AddOutput(output *TxOut) {
 	tx.Outputs = append(tx.Outputs, output)
 }
 
+// ComputeHash hashes the ID, the timestamp and the lock time of the transaction, the outpoints and sequences
+// of its inputs, its outputs, its fee and what it issues, mints or does to a standing order. Every variable length
+// field is length prefixed, as in SigHash, so no two transactions hash the same data. The signatures aren't hashed.
 func (tx *Transaction) ComputeHash() {
-	data := make([]byte, 0, 256)
-	data = append(data, tx.ID[:]...)
-	timestamp := make([]byte, 8)
-	binary.LittleEndian.PutUint64(timestamp, tx.Timestamp)
-	data = append(data, timestamp...)
-	nLockTime := make([]byte, 8)
-	binary.LittleEndian.PutUint64(timestamp, uint64(tx.nLockTime))
-	data = append(data, nLockTime...)
+	hash := sha512.New()
+	hash.Write(tx.ID[:])
+	writeUint64(hash, tx.Timestamp)
+	writeUint32(hash, tx.LockTime)
+	writeUint32(hash, uint32(len(tx.Inputs)))
+	for _, in := range tx.Inputs {
+		in.write(hash)
+	}
+	writeUint32(hash, uint32(len(tx.Outputs)))
 	for _, out := range tx.Outputs {
-		data = append(data, out.TxID[:]...)
-		data = append(data, out.PubKey...)
-		data = append(data, out.Amount.ToBytes()...)
+		hash.Write(out.TxID[:])
+		out.write(hash)
 	}
-	hash := sha512.New()
-	hash.Write(data)
+	writeUint64(hash, tx.Fee)
+	if tx.Issuance != nil {
+		tx.Issuance.write(hash)
+	}
+	if tx.Mint != nil {
+		tx.Mint.write(hash)
+	}
+	tx.writeOrder(hash)
 	tx.Hash = hash.Sum(nil)
 }
 
+func (tx *Transaction) ToBytes() ([]byte, error) {
+	return rlp.EncodeToBytes(tx)
+}
+
+func (tx *Transaction) FromBytes(data []byte) error {
+	return rlp.DecodeBytes(data, tx)
+}
+
 func (tx *Transaction) GetHash() []byte {
 	if tx.Hash == nil {
 		tx.ComputeHash()
@@ -90,11 +121,18 @@ func (tx *Transaction) GetHash() []byte {
 }
 
 type TxIn struct {
-	Prev       *UTXO
-	PubKey     []byte
+	Prev *UTXO
+	// PubKey is the PKIX PEM key of the signer, its algorithm identifier tags the signature scheme, see crypto.Scheme
+	PubKey []byte
+	// SignatureR and SignatureS are the signature of the scheme of PubKey
 	SignatureR *big.Int
 	SignatureS *big.Int
-	NSequence  uint32
+	// NSequence holds the relative lock of the input, SequenceFinal opts out of every lock
+	NSequence uint32
+	// Signatures sign an input spending a multisig output, PubKey and the single signature are empty then
+	Signatures []*Signature
+	// Script is the unlocking script of an input spending a script output
+	Script []byte
 }
 
 func NewTxIn(utxo *UTXO, pubKey []byte, r, s *big.Int, n uint32) *TxIn {
@@ -110,14 +148,29 @@ func NewTxIn(utxo *UTXO, pubKey []byte, r, s *big.Int, n uint32) *TxIn {
 type TxOut struct {
 	TxID   uuid.UUID
 	Amount Amount
+	// PubKey is the PKIX PEM key outputs created before addresses are locked to, tagged with its algorithm
 	PubKey []byte
+	// Address is the hash of the algorithm-tagged public key the output is locked to, see crypto.PublicKeyHash;
+	// PubKey is empty then
+	Address []byte
+	// Threshold of PubKeys signatures spending a multisig output, zero for an output locked to PubKey
+	Threshold uint32
+	PubKeys   [][]byte
+	// Script is the locking script of a script output, see the script package; PubKey is empty then
+	Script []byte
+	// AssetID is the asset the output carries, empty for the native coin
+	AssetID []byte
+	// TokenID is the token the output carries, with the hash of its metadata; empty for value outputs
+	TokenID      []byte
+	MetadataHash []byte
 }
 
-func NewTxOut(id uuid.UUID, amount Amount, pubKey []byte) *TxOut {
+// NewTxOut creates an output locked to the address.
+func NewTxOut(id uuid.UUID, amount Amount, address []byte) *TxOut {
 	return &TxOut{
-		TxID:   id,
-		Amount: amount,
-		PubKey: pubKey,
+		TxID:    id,
+		Amount:  amount,
+		Address: address,
 	}
 }
 
@@ -130,24 +183,21 @@ type UTXO struct {
 
 func NewUTXO(id uuid.UUID, hash []byte, index uint32) *UTXO {
 	return &UTXO{
-		TxID:  id,
-		Index: index,
+		TxHash: hash,
+		TxID:   id,
+		Index:  index,
 	}
 }
 
 type UTXOs []*UTXO
 
-func (u *UTXO) Sign(key *ecdsa.PrivateKey) (*big.Int, *big.Int, error) {
-	r, s, err := ecdsa.Sign(rand.Reader, key, u.TxHash)
-	if err != nil {
-		return nil, nil, fmt.Errorf("failed to sign UTXO %s: %s", u.TxHash, err.Error())
-	}
-
-	return r, s, nil
-}
-
-func (u *UTXO) Verify(pubKey ecdsa.PublicKey, r, s *big.Int) bool {
-	return ecdsa.Verify(&pubKey, u.TxHash, r, s)
+// UnspentOutput is an output of a confirmed transaction that hasn't been spent yet.
+// Height and Timestamp are of the block that confirmed it, relative locks of inputs spending it count from them.
+type UnspentOutput struct {
+	UTXO      *UTXO
+	Output    *TxOut
+	Height    uint64
+	Timestamp uint64
 }
 
 type Amount struct {
@@ -162,6 +212,20 @@ func NewAmount(value uint64) *Amount {
 	}
 }
 
+// ErrValueOverflow is returned for values whose sum doesn't fit in an amount
+var ErrValueOverflow = errors.New("value overflows")
+
+// AddValues sums the values, failing with ErrValueOverflow instead of wrapping around.
+func AddValues(values ...uint64) (uint64, error) {
+	var sum, carry uint64
+	for _, value := range values {
+		if sum, carry = bits.Add64(sum, value, 0); carry != 0 {
+			return 0, ErrValueOverflow
+		}
+	}
+	return sum, nil
+}
+
 func (a *Amount) ToBytes() []byte {
 	amount := make([]byte, 8)
 	binary.LittleEndian.PutUint64(amount, uint64(a.Value))
@@ -188,11 +252,51 @@ func (t Transactions) SortByTimestamp() Transactions {
 	return t
 }
 
+func (t Transactions) IDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(t))
+	for _, tx := range t {
+		ids = append(ids, tx.ID)
+	}
+	return ids
+}
+
 type TransactionRequest struct {
-	Sender   *ecdsa.PrivateKey
-	Receiver *ecdsa.PublicKey
+	Sender crypto.Signer
+	// Receiver is the address of the receiver
+	Receiver []byte
 	Amount   Amount
+	Fee      uint64
+	LockTime uint32
 }
+
+// Payment is a single (receiver address, amount) pair of a batch transaction.
+// A payment with a Multisig lock or a locking Script pays to it instead of the receiver.
+// A payment with an AssetID pays the asset instead of the native coin.
+type Payment struct {
+	Receiver []byte
+	Multisig *MultisigLock
+	Script   []byte
+	AssetID  []byte
+	Amount   Amount
+}
+
+// BatchTransactionRequest pays every receiver from one transaction with a single change output.
+type BatchTransactionRequest struct {
+	Sender   crypto.Signer
+	Payments []Payment
+	Fee      uint64
+	LockTime uint32
+}
+
+// MultisigTransactionRequest spends outputs of a multisig lock, the change goes back to the lock.
+// The transaction is returned unsigned, holders of the lock's keys sign it one by one.
+type MultisigTransactionRequest struct {
+	Lock     *MultisigLock
+	Payments []Payment
+	Fee      uint64
+	LockTime uint32
+}
+
 type BalanceRequest struct {
-	Sender *ecdsa.PrivateKey
+	Owner crypto.PublicKey
 }
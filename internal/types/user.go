@@ -1,7 +1,147 @@
 package types
 
+import (
+	"fmt"
+	"strings"
+
+	"local-chain/internal/pkg/crypto"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// UserStatus is the stage of the user's lifecycle.
+type UserStatus uint8
+
+const (
+	// UserActive users send and receive
+	UserActive UserStatus = iota
+	// UserDisabled users are refused as senders until they are enabled again
+	UserDisabled
+	// UserDeleted users keep their username and keys only, so the chain history stays linked to them
+	UserDeleted
+)
+
+var userStatusNames = map[UserStatus]string{UserActive: "active", UserDisabled: "disabled", UserDeleted: "deleted"}
+
+func (s UserStatus) String() string {
+	if name, ok := userStatusNames[s]; ok {
+		return name
+	}
+	return fmt.Sprintf("status(%d)", uint8(s))
+}
+
+// ParseUserStatus parses the name of a status, as String prints it.
+func ParseUserStatus(name string) (UserStatus, error) {
+	for status, statusName := range userStatusNames {
+		if name == statusName {
+			return status, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown user status %q", name)
+}
+
 type User struct {
 	Username   string
 	PublicKey  []byte
 	PrivateKey []byte
+	// Wallet derives the public keys of a user created from a seed phrase, the user's key is its first receive key.
+	// It is nil for a user of a single key.
+	Wallet *Wallet `rlp:"nil"`
+	// DisplayName and Email are the personal data of the user, deletion drops them
+	DisplayName string
+	Email       string
+	Status      UserStatus
+	// Rotations are the keys the user replaced, oldest first: PublicKey is the new key of the last one.
+	Rotations []KeyRotation `rlp:"tail"`
+}
+
+// Active reports whether the user can send.
+func (u *User) Active() bool {
+	return u.Status == UserActive
+}
+
+// Addresses lists the addresses of every key the user has had: its current key, the keys it rotated away
+// and the used keys of its wallet.
+func (u *User) Addresses() ([][]byte, error) {
+	addresses := make([][]byte, 0, 1+len(u.Rotations))
+	if address := AddressOf(u.PublicKey); address != nil {
+		addresses = append(addresses, address)
+	}
+	for _, rotation := range u.Rotations {
+		if address := AddressOf(rotation.OldKey); address != nil {
+			addresses = append(addresses, address)
+		}
+	}
+	if u.Wallet != nil {
+		keys, err := u.Wallet.PublicKeys()
+		if err != nil {
+			return nil, fmt.Errorf("keys of the wallet of %s: %w", u.Username, err)
+		}
+		for _, key := range keys {
+			addresses = append(addresses, crypto.PublicKeyHash(key))
+		}
+	}
+	return addresses, nil
+}
+
+func (u *User) ToBytes() ([]byte, error) {
+	return rlp.EncodeToBytes(u)
+}
+
+func (u *User) FromBytes(data []byte) error {
+	return rlp.DecodeBytes(data, u)
+}
+
+// UserOp is the change a user command makes to the user registry.
+type UserOp uint8
+
+const (
+	// UserOpCreate adds a user of a new username
+	UserOpCreate UserOp = iota
+	// UserOpUpdate overwrites a stored user, a deleted user is updated to its tombstone
+	UserOpUpdate
+)
+
+// UserCommand is the change of the user registry replicated through raft, every replica applies it
+// to its user store. Only the user record is replicated, the keystore of the node keeps the private keys.
+type UserCommand struct {
+	Op   UserOp
+	User *User
+}
+
+func (c *UserCommand) ToBytes() ([]byte, error) {
+	return rlp.EncodeToBytes(c)
+}
+
+func (c *UserCommand) FromBytes(data []byte) error {
+	return rlp.DecodeBytes(data, c)
+}
+
+// UserUpdate changes the personal data of the user, a nil field is left as it is.
+type UserUpdate struct {
+	Username    string
+	DisplayName *string
+	Email       *string
+}
+
+// UserFilter selects users of a listing, the zero filter selects every user but the deleted ones.
+type UserFilter struct {
+	// Status selects the users of the status only
+	Status *UserStatus
+	// Deleted selects the deleted users too, a Status selects them regardless
+	Deleted bool
+	// Prefix selects the users of the username prefix
+	Prefix string
+}
+
+// Match reports whether the filter selects the user.
+func (f UserFilter) Match(user *User) bool {
+	if f.Status != nil {
+		if user.Status != *f.Status {
+			return false
+		}
+	} else if user.Status == UserDeleted && !f.Deleted {
+		return false
+	}
+	return strings.HasPrefix(user.Username, f.Prefix)
 }
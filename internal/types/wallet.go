@@ -0,0 +1,163 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	"local-chain/internal/pkg/crypto"
+	"local-chain/internal/pkg/hdwallet"
+)
+
+const (
+	// ReceiveChain is the branch of the wallet keys handed out to receive payments
+	ReceiveChain uint32 = 0
+	// ChangeChain is the branch of the wallet keys the change of its own payments goes to
+	ChangeChain uint32 = 1
+	// WalletGap is how many unused keys in a row a wallet restored from its seed looks at before it stops
+	WalletGap = 20
+)
+
+// walletAccount is the BIP44 path of the wallet account: purpose, coin type of test networks and the first account
+var walletAccount = []uint32{44 + hdwallet.HardenedOffset, 1 + hdwallet.HardenedOffset, hdwallet.HardenedOffset}
+
+// Wallet is a hierarchical deterministic wallet: every key is derived from the seed, a fresh one for every
+// address handed out, so payments aren't linked by a reused address and the seed phrase backs the wallet up.
+// The wallet keeps public data only, the keystore of the node seals the seed the private keys are derived from.
+type Wallet struct {
+	// XPub is the extended public key of the wallet account, it derives the public keys of the wallet
+	XPub []byte
+	// Receive and Change are the numbers of keys used on the receive and the change chain
+	Receive uint32
+	Change  uint32
+}
+
+// NewWallet creates a wallet of the seed, its first receive key is in use already: it is the key of the user.
+func NewWallet(seed []byte) (*Wallet, error) {
+	account, err := walletAccountKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	return &Wallet{XPub: account.Public().Bytes(), Receive: 1}, nil
+}
+
+// PublicKey derives the public key at the index of the chain.
+func (w *Wallet) PublicKey(chain, index uint32) (*ecdsa.PublicKey, error) {
+	account, err := hdwallet.ParseExtendedPublicKey(w.XPub)
+	if err != nil {
+		return nil, err
+	}
+	key, err := account.Derive([]uint32{chain, index})
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key %d/%d: %w", chain, index, err)
+	}
+	return key.Key, nil
+}
+
+// PublicKeys derives every used public key of the wallet, the receive keys first.
+func (w *Wallet) PublicKeys() ([]*ecdsa.PublicKey, error) {
+	account, err := hdwallet.ParseExtendedPublicKey(w.XPub)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*ecdsa.PublicKey, 0, w.Receive+w.Change)
+	for _, chain := range []struct{ chain, used uint32 }{{ReceiveChain, w.Receive}, {ChangeChain, w.Change}} {
+		if chain.used == 0 {
+			continue
+		}
+		chainKey, err := account.Child(chain.chain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive chain %d: %w", chain.chain, err)
+		}
+		for index := range chain.used {
+			key, err := chainKey.Child(index)
+			if err != nil {
+				return nil, fmt.Errorf("failed to derive key %d/%d: %w", chain.chain, index, err)
+			}
+			keys = append(keys, key.Key)
+		}
+	}
+	return keys, nil
+}
+
+// Key derives the private key at the index of the chain from the seed of the wallet.
+func (w *Wallet) Key(seed []byte, chain, index uint32) (*ecdsa.PrivateKey, error) {
+	account, err := w.account(seed)
+	if err != nil {
+		return nil, err
+	}
+	return walletKey(account, chain, index)
+}
+
+// Keys derives every used private key of the wallet from its seed, the receive keys first.
+func (w *Wallet) Keys(seed []byte) ([]*ecdsa.PrivateKey, error) {
+	account, err := w.account(seed)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*ecdsa.PrivateKey, 0, w.Receive+w.Change)
+	for _, chain := range []struct{ chain, used uint32 }{{ReceiveChain, w.Receive}, {ChangeChain, w.Change}} {
+		for index := range chain.used {
+			key, err := walletKey(account, chain.chain, index)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// NextAddress hands out the address of a fresh receive key.
+func (w *Wallet) NextAddress() ([]byte, error) {
+	key, err := w.PublicKey(ReceiveChain, w.Receive)
+	if err != nil {
+		return nil, err
+	}
+	w.Receive++
+	return crypto.PublicKeyHash(key), nil
+}
+
+// account derives the account key of the seed, which must be the seed of the wallet
+func (w *Wallet) account(seed []byte) (*hdwallet.ExtendedKey, error) {
+	account, err := walletAccountKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(account.Public().Bytes(), w.XPub) {
+		return nil, errors.New("seed is not the seed of the wallet")
+	}
+	return account, nil
+}
+
+// walletAccountKey derives the key of the wallet account from the seed
+func walletAccountKey(seed []byte) (*hdwallet.ExtendedKey, error) {
+	master, err := hdwallet.NewMasterKey(seed)
+	if err != nil {
+		return nil, err
+	}
+	account, err := master.Derive(walletAccount)
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive the wallet account: %w", err)
+	}
+	return account, nil
+}
+
+// walletKey derives the private key at the index of the chain from the account key
+func walletKey(account *hdwallet.ExtendedKey, chain, index uint32) (*ecdsa.PrivateKey, error) {
+	key, err := account.Derive([]uint32{chain, index})
+	if err != nil {
+		return nil, fmt.Errorf("failed to derive key %d/%d: %w", chain, index, err)
+	}
+	return key.Key, nil
+}
+
+// WalletTransactionRequest pays the payments from every key of the user's wallet,
+// the change goes to a fresh change key.
+type WalletTransactionRequest struct {
+	Username string
+	Payments []Payment
+	Fee      uint64
+	LockTime uint32
+}
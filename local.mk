@@ -3,6 +3,9 @@ RAFT_ADDR=127.0.0.1:8001
 GRPC_ADDR=127.0.0.1:9001
 DATA_DIR=./db
 BOOTSTRAP=true
+# passphrase of the users' keys, the admin's one is SUPER_USER_PASSPHRASE of the nodes
+USER_PASSPHRASE=secret
+ADMIN_PASSPHRASE=admin
 
 .PHONY: add-peer
 add-peer:
@@ -46,12 +49,13 @@ NAMES := \
 add-users:
 	@echo Generating users for $$(echo $(NAMES) | wc -w) names...
 	@for name in $(NAMES); do \
-  		bin/debug add-user --name $$name; \
+  		bin/debug add-user --name $$name --passphrase $(USER_PASSPHRASE); \
 	done
 
 .PHONY: send-money
 send-money:
 	@echo Give money to users for $$(echo $(NAMES) | wc -w) names...
+	@bin/debug unlock --name admin --passphrase $(ADMIN_PASSPHRASE)
 	@for name in $(NAMES); do \
   		bin/debug send -s admin -r $$name -a 1000; \
 	done
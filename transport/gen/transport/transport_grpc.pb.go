@@ -27,10 +27,46 @@ type LocalChainClient interface {
 	RemovePeer(ctx context.Context, in *RemovePeerRequest, opts ...grpc.CallOption) (*RemovePeerResponse, error)
 	AddVoter(ctx context.Context, in *AddVoterRequest, opts ...grpc.CallOption) (*AddVoterResponse, error)
 	AddTransaction(ctx context.Context, in *AddTransactionRequest, opts ...grpc.CallOption) (*AddTransactionResponse, error)
+	AddBatchTransaction(ctx context.Context, in *AddBatchTransactionRequest, opts ...grpc.CallOption) (*AddBatchTransactionResponse, error)
+	SubmitSignedTransaction(ctx context.Context, in *SubmitSignedTransactionRequest, opts ...grpc.CallOption) (*SubmitSignedTransactionResponse, error)
+	CreateMultisigTransaction(ctx context.Context, in *CreateMultisigTransactionRequest, opts ...grpc.CallOption) (*CreateMultisigTransactionResponse, error)
+	SubmitPartiallySignedTransaction(ctx context.Context, in *SubmitPartiallySignedTransactionRequest, opts ...grpc.CallOption) (*SubmitPartiallySignedTransactionResponse, error)
+	CreateHTLC(ctx context.Context, in *CreateHTLCRequest, opts ...grpc.CallOption) (*CreateHTLCResponse, error)
+	ClaimHTLC(ctx context.Context, in *ClaimHTLCRequest, opts ...grpc.CallOption) (*ClaimHTLCResponse, error)
+	RefundHTLC(ctx context.Context, in *RefundHTLCRequest, opts ...grpc.CallOption) (*RefundHTLCResponse, error)
+	Notarize(ctx context.Context, in *NotarizeRequest, opts ...grpc.CallOption) (*NotarizeResponse, error)
+	ProveNotarization(ctx context.Context, in *ProveNotarizationRequest, opts ...grpc.CallOption) (*ProveNotarizationResponse, error)
+	IssueAsset(ctx context.Context, in *IssueAssetRequest, opts ...grpc.CallOption) (*IssueAssetResponse, error)
+	ListAssets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAssetsResponse, error)
+	MintToken(ctx context.Context, in *MintTokenRequest, opts ...grpc.CallOption) (*MintTokenResponse, error)
+	TransferToken(ctx context.Context, in *TransferTokenRequest, opts ...grpc.CallOption) (*TransferTokenResponse, error)
+	GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*GetTokenResponse, error)
+	CreateEscrow(ctx context.Context, in *CreateEscrowRequest, opts ...grpc.CallOption) (*CreateEscrowResponse, error)
+	ReleaseEscrow(ctx context.Context, in *ReleaseEscrowRequest, opts ...grpc.CallOption) (*ReleaseEscrowResponse, error)
+	DisputeEscrow(ctx context.Context, in *DisputeEscrowRequest, opts ...grpc.CallOption) (*DisputeEscrowResponse, error)
+	RefundEscrow(ctx context.Context, in *RefundEscrowRequest, opts ...grpc.CallOption) (*RefundEscrowResponse, error)
+	ListEscrows(ctx context.Context, in *ListEscrowsRequest, opts ...grpc.CallOption) (*ListEscrowsResponse, error)
+	CreateStandingOrder(ctx context.Context, in *CreateStandingOrderRequest, opts ...grpc.CallOption) (*CreateStandingOrderResponse, error)
+	ListStandingOrders(ctx context.Context, in *ListStandingOrdersRequest, opts ...grpc.CallOption) (*ListStandingOrdersResponse, error)
+	CancelStandingOrder(ctx context.Context, in *CancelStandingOrderRequest, opts ...grpc.CallOption) (*CancelStandingOrderResponse, error)
 	GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error)
+	EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error)
 	AddUser(ctx context.Context, in *AddUserRequest, opts ...grpc.CallOption) (*AddUserResponse, error)
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
-	ListUsers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	// a disabled user is refused as a sender until it is enabled
+	DisableUser(ctx context.Context, in *DisableUserRequest, opts ...grpc.CallOption) (*DisableUserResponse, error)
+	EnableUser(ctx context.Context, in *EnableUserRequest, opts ...grpc.CallOption) (*EnableUserResponse, error)
+	// drops the personal data and the private key of the user, its username and keys stay for the chain history
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	// request fields carrying a PEM private key (sender, payer, ...) take the username of an unlocked user instead
+	UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UnlockUserResponse, error)
+	LockUser(ctx context.Context, in *LockUserRequest, opts ...grpc.CallOption) (*LockUserResponse, error)
+	RotateUserKey(ctx context.Context, in *RotateUserKeyRequest, opts ...grpc.CallOption) (*RotateUserKeyResponse, error)
+	CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error)
+	DeriveAddress(ctx context.Context, in *DeriveAddressRequest, opts ...grpc.CallOption) (*DeriveAddressResponse, error)
+	SendFromWallet(ctx context.Context, in *SendFromWalletRequest, opts ...grpc.CallOption) (*SendFromWalletResponse, error)
 	GetBlockKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetBlockKeysResponse, error)
 	GetBlock(ctx context.Context, in *GetBlockRequest, opts ...grpc.CallOption) (*GetBlockResponse, error)
 	GetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
@@ -81,6 +117,204 @@ func (c *localChainClient) AddTransaction(ctx context.Context, in *AddTransactio
 	return out, nil
 }
 
+func (c *localChainClient) AddBatchTransaction(ctx context.Context, in *AddBatchTransactionRequest, opts ...grpc.CallOption) (*AddBatchTransactionResponse, error) {
+	out := new(AddBatchTransactionResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/AddBatchTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) SubmitSignedTransaction(ctx context.Context, in *SubmitSignedTransactionRequest, opts ...grpc.CallOption) (*SubmitSignedTransactionResponse, error) {
+	out := new(SubmitSignedTransactionResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/SubmitSignedTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) CreateMultisigTransaction(ctx context.Context, in *CreateMultisigTransactionRequest, opts ...grpc.CallOption) (*CreateMultisigTransactionResponse, error) {
+	out := new(CreateMultisigTransactionResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/CreateMultisigTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) SubmitPartiallySignedTransaction(ctx context.Context, in *SubmitPartiallySignedTransactionRequest, opts ...grpc.CallOption) (*SubmitPartiallySignedTransactionResponse, error) {
+	out := new(SubmitPartiallySignedTransactionResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/SubmitPartiallySignedTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) CreateHTLC(ctx context.Context, in *CreateHTLCRequest, opts ...grpc.CallOption) (*CreateHTLCResponse, error) {
+	out := new(CreateHTLCResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/CreateHTLC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) ClaimHTLC(ctx context.Context, in *ClaimHTLCRequest, opts ...grpc.CallOption) (*ClaimHTLCResponse, error) {
+	out := new(ClaimHTLCResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/ClaimHTLC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) RefundHTLC(ctx context.Context, in *RefundHTLCRequest, opts ...grpc.CallOption) (*RefundHTLCResponse, error) {
+	out := new(RefundHTLCResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/RefundHTLC", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) Notarize(ctx context.Context, in *NotarizeRequest, opts ...grpc.CallOption) (*NotarizeResponse, error) {
+	out := new(NotarizeResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/Notarize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) ProveNotarization(ctx context.Context, in *ProveNotarizationRequest, opts ...grpc.CallOption) (*ProveNotarizationResponse, error) {
+	out := new(ProveNotarizationResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/ProveNotarization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) IssueAsset(ctx context.Context, in *IssueAssetRequest, opts ...grpc.CallOption) (*IssueAssetResponse, error) {
+	out := new(IssueAssetResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/IssueAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) ListAssets(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListAssetsResponse, error) {
+	out := new(ListAssetsResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/ListAssets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) MintToken(ctx context.Context, in *MintTokenRequest, opts ...grpc.CallOption) (*MintTokenResponse, error) {
+	out := new(MintTokenResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/MintToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) TransferToken(ctx context.Context, in *TransferTokenRequest, opts ...grpc.CallOption) (*TransferTokenResponse, error) {
+	out := new(TransferTokenResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/TransferToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) GetToken(ctx context.Context, in *GetTokenRequest, opts ...grpc.CallOption) (*GetTokenResponse, error) {
+	out := new(GetTokenResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/GetToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) CreateEscrow(ctx context.Context, in *CreateEscrowRequest, opts ...grpc.CallOption) (*CreateEscrowResponse, error) {
+	out := new(CreateEscrowResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/CreateEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) ReleaseEscrow(ctx context.Context, in *ReleaseEscrowRequest, opts ...grpc.CallOption) (*ReleaseEscrowResponse, error) {
+	out := new(ReleaseEscrowResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/ReleaseEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) DisputeEscrow(ctx context.Context, in *DisputeEscrowRequest, opts ...grpc.CallOption) (*DisputeEscrowResponse, error) {
+	out := new(DisputeEscrowResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/DisputeEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) RefundEscrow(ctx context.Context, in *RefundEscrowRequest, opts ...grpc.CallOption) (*RefundEscrowResponse, error) {
+	out := new(RefundEscrowResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/RefundEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) ListEscrows(ctx context.Context, in *ListEscrowsRequest, opts ...grpc.CallOption) (*ListEscrowsResponse, error) {
+	out := new(ListEscrowsResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/ListEscrows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) CreateStandingOrder(ctx context.Context, in *CreateStandingOrderRequest, opts ...grpc.CallOption) (*CreateStandingOrderResponse, error) {
+	out := new(CreateStandingOrderResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/CreateStandingOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) ListStandingOrders(ctx context.Context, in *ListStandingOrdersRequest, opts ...grpc.CallOption) (*ListStandingOrdersResponse, error) {
+	out := new(ListStandingOrdersResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/ListStandingOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) CancelStandingOrder(ctx context.Context, in *CancelStandingOrderRequest, opts ...grpc.CallOption) (*CancelStandingOrderResponse, error) {
+	out := new(CancelStandingOrderResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/CancelStandingOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *localChainClient) GetBalance(ctx context.Context, in *GetBalanceRequest, opts ...grpc.CallOption) (*GetBalanceResponse, error) {
 	out := new(GetBalanceResponse)
 	err := c.cc.Invoke(ctx, "/LocalChain/GetBalance", in, out, opts...)
@@ -90,6 +324,15 @@ func (c *localChainClient) GetBalance(ctx context.Context, in *GetBalanceRequest
 	return out, nil
 }
 
+func (c *localChainClient) EstimateFee(ctx context.Context, in *EstimateFeeRequest, opts ...grpc.CallOption) (*EstimateFeeResponse, error) {
+	out := new(EstimateFeeResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/EstimateFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *localChainClient) AddUser(ctx context.Context, in *AddUserRequest, opts ...grpc.CallOption) (*AddUserResponse, error) {
 	out := new(AddUserResponse)
 	err := c.cc.Invoke(ctx, "/LocalChain/AddUser", in, out, opts...)
@@ -108,7 +351,7 @@ func (c *localChainClient) GetUser(ctx context.Context, in *GetUserRequest, opts
 	return out, nil
 }
 
-func (c *localChainClient) ListUsers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+func (c *localChainClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
 	out := new(ListUsersResponse)
 	err := c.cc.Invoke(ctx, "/LocalChain/ListUsers", in, out, opts...)
 	if err != nil {
@@ -117,6 +360,96 @@ func (c *localChainClient) ListUsers(ctx context.Context, in *emptypb.Empty, opt
 	return out, nil
 }
 
+func (c *localChainClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
+	out := new(UpdateUserResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/UpdateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) DisableUser(ctx context.Context, in *DisableUserRequest, opts ...grpc.CallOption) (*DisableUserResponse, error) {
+	out := new(DisableUserResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/DisableUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) EnableUser(ctx context.Context, in *EnableUserRequest, opts ...grpc.CallOption) (*EnableUserResponse, error) {
+	out := new(EnableUserResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/EnableUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/DeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...grpc.CallOption) (*UnlockUserResponse, error) {
+	out := new(UnlockUserResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/UnlockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) LockUser(ctx context.Context, in *LockUserRequest, opts ...grpc.CallOption) (*LockUserResponse, error) {
+	out := new(LockUserResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/LockUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) RotateUserKey(ctx context.Context, in *RotateUserKeyRequest, opts ...grpc.CallOption) (*RotateUserKeyResponse, error) {
+	out := new(RotateUserKeyResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/RotateUserKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) CreateWallet(ctx context.Context, in *CreateWalletRequest, opts ...grpc.CallOption) (*CreateWalletResponse, error) {
+	out := new(CreateWalletResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/CreateWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) DeriveAddress(ctx context.Context, in *DeriveAddressRequest, opts ...grpc.CallOption) (*DeriveAddressResponse, error) {
+	out := new(DeriveAddressResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/DeriveAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *localChainClient) SendFromWallet(ctx context.Context, in *SendFromWalletRequest, opts ...grpc.CallOption) (*SendFromWalletResponse, error) {
+	out := new(SendFromWalletResponse)
+	err := c.cc.Invoke(ctx, "/LocalChain/SendFromWallet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *localChainClient) GetBlockKeys(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetBlockKeysResponse, error) {
 	out := new(GetBlockKeysResponse)
 	err := c.cc.Invoke(ctx, "/LocalChain/GetBlockKeys", in, out, opts...)
@@ -161,10 +494,46 @@ type LocalChainServer interface {
 	RemovePeer(context.Context, *RemovePeerRequest) (*RemovePeerResponse, error)
 	AddVoter(context.Context, *AddVoterRequest) (*AddVoterResponse, error)
 	AddTransaction(context.Context, *AddTransactionRequest) (*AddTransactionResponse, error)
+	AddBatchTransaction(context.Context, *AddBatchTransactionRequest) (*AddBatchTransactionResponse, error)
+	SubmitSignedTransaction(context.Context, *SubmitSignedTransactionRequest) (*SubmitSignedTransactionResponse, error)
+	CreateMultisigTransaction(context.Context, *CreateMultisigTransactionRequest) (*CreateMultisigTransactionResponse, error)
+	SubmitPartiallySignedTransaction(context.Context, *SubmitPartiallySignedTransactionRequest) (*SubmitPartiallySignedTransactionResponse, error)
+	CreateHTLC(context.Context, *CreateHTLCRequest) (*CreateHTLCResponse, error)
+	ClaimHTLC(context.Context, *ClaimHTLCRequest) (*ClaimHTLCResponse, error)
+	RefundHTLC(context.Context, *RefundHTLCRequest) (*RefundHTLCResponse, error)
+	Notarize(context.Context, *NotarizeRequest) (*NotarizeResponse, error)
+	ProveNotarization(context.Context, *ProveNotarizationRequest) (*ProveNotarizationResponse, error)
+	IssueAsset(context.Context, *IssueAssetRequest) (*IssueAssetResponse, error)
+	ListAssets(context.Context, *emptypb.Empty) (*ListAssetsResponse, error)
+	MintToken(context.Context, *MintTokenRequest) (*MintTokenResponse, error)
+	TransferToken(context.Context, *TransferTokenRequest) (*TransferTokenResponse, error)
+	GetToken(context.Context, *GetTokenRequest) (*GetTokenResponse, error)
+	CreateEscrow(context.Context, *CreateEscrowRequest) (*CreateEscrowResponse, error)
+	ReleaseEscrow(context.Context, *ReleaseEscrowRequest) (*ReleaseEscrowResponse, error)
+	DisputeEscrow(context.Context, *DisputeEscrowRequest) (*DisputeEscrowResponse, error)
+	RefundEscrow(context.Context, *RefundEscrowRequest) (*RefundEscrowResponse, error)
+	ListEscrows(context.Context, *ListEscrowsRequest) (*ListEscrowsResponse, error)
+	CreateStandingOrder(context.Context, *CreateStandingOrderRequest) (*CreateStandingOrderResponse, error)
+	ListStandingOrders(context.Context, *ListStandingOrdersRequest) (*ListStandingOrdersResponse, error)
+	CancelStandingOrder(context.Context, *CancelStandingOrderRequest) (*CancelStandingOrderResponse, error)
 	GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error)
+	EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error)
 	AddUser(context.Context, *AddUserRequest) (*AddUserResponse, error)
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
-	ListUsers(context.Context, *emptypb.Empty) (*ListUsersResponse, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	// a disabled user is refused as a sender until it is enabled
+	DisableUser(context.Context, *DisableUserRequest) (*DisableUserResponse, error)
+	EnableUser(context.Context, *EnableUserRequest) (*EnableUserResponse, error)
+	// drops the personal data and the private key of the user, its username and keys stay for the chain history
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// request fields carrying a PEM private key (sender, payer, ...) take the username of an unlocked user instead
+	UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserResponse, error)
+	LockUser(context.Context, *LockUserRequest) (*LockUserResponse, error)
+	RotateUserKey(context.Context, *RotateUserKeyRequest) (*RotateUserKeyResponse, error)
+	CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error)
+	DeriveAddress(context.Context, *DeriveAddressRequest) (*DeriveAddressResponse, error)
+	SendFromWallet(context.Context, *SendFromWalletRequest) (*SendFromWalletResponse, error)
 	GetBlockKeys(context.Context, *emptypb.Empty) (*GetBlockKeysResponse, error)
 	GetBlock(context.Context, *GetBlockRequest) (*GetBlockResponse, error)
 	GetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
@@ -188,18 +557,117 @@ func (UnimplementedLocalChainServer) AddVoter(context.Context, *AddVoterRequest)
 func (UnimplementedLocalChainServer) AddTransaction(context.Context, *AddTransactionRequest) (*AddTransactionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddTransaction not implemented")
 }
+func (UnimplementedLocalChainServer) AddBatchTransaction(context.Context, *AddBatchTransactionRequest) (*AddBatchTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBatchTransaction not implemented")
+}
+func (UnimplementedLocalChainServer) SubmitSignedTransaction(context.Context, *SubmitSignedTransactionRequest) (*SubmitSignedTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitSignedTransaction not implemented")
+}
+func (UnimplementedLocalChainServer) CreateMultisigTransaction(context.Context, *CreateMultisigTransactionRequest) (*CreateMultisigTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMultisigTransaction not implemented")
+}
+func (UnimplementedLocalChainServer) SubmitPartiallySignedTransaction(context.Context, *SubmitPartiallySignedTransactionRequest) (*SubmitPartiallySignedTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPartiallySignedTransaction not implemented")
+}
+func (UnimplementedLocalChainServer) CreateHTLC(context.Context, *CreateHTLCRequest) (*CreateHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateHTLC not implemented")
+}
+func (UnimplementedLocalChainServer) ClaimHTLC(context.Context, *ClaimHTLCRequest) (*ClaimHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimHTLC not implemented")
+}
+func (UnimplementedLocalChainServer) RefundHTLC(context.Context, *RefundHTLCRequest) (*RefundHTLCResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundHTLC not implemented")
+}
+func (UnimplementedLocalChainServer) Notarize(context.Context, *NotarizeRequest) (*NotarizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Notarize not implemented")
+}
+func (UnimplementedLocalChainServer) ProveNotarization(context.Context, *ProveNotarizationRequest) (*ProveNotarizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProveNotarization not implemented")
+}
+func (UnimplementedLocalChainServer) IssueAsset(context.Context, *IssueAssetRequest) (*IssueAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueAsset not implemented")
+}
+func (UnimplementedLocalChainServer) ListAssets(context.Context, *emptypb.Empty) (*ListAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAssets not implemented")
+}
+func (UnimplementedLocalChainServer) MintToken(context.Context, *MintTokenRequest) (*MintTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintToken not implemented")
+}
+func (UnimplementedLocalChainServer) TransferToken(context.Context, *TransferTokenRequest) (*TransferTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferToken not implemented")
+}
+func (UnimplementedLocalChainServer) GetToken(context.Context, *GetTokenRequest) (*GetTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetToken not implemented")
+}
+func (UnimplementedLocalChainServer) CreateEscrow(context.Context, *CreateEscrowRequest) (*CreateEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateEscrow not implemented")
+}
+func (UnimplementedLocalChainServer) ReleaseEscrow(context.Context, *ReleaseEscrowRequest) (*ReleaseEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseEscrow not implemented")
+}
+func (UnimplementedLocalChainServer) DisputeEscrow(context.Context, *DisputeEscrowRequest) (*DisputeEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeEscrow not implemented")
+}
+func (UnimplementedLocalChainServer) RefundEscrow(context.Context, *RefundEscrowRequest) (*RefundEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundEscrow not implemented")
+}
+func (UnimplementedLocalChainServer) ListEscrows(context.Context, *ListEscrowsRequest) (*ListEscrowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEscrows not implemented")
+}
+func (UnimplementedLocalChainServer) CreateStandingOrder(context.Context, *CreateStandingOrderRequest) (*CreateStandingOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStandingOrder not implemented")
+}
+func (UnimplementedLocalChainServer) ListStandingOrders(context.Context, *ListStandingOrdersRequest) (*ListStandingOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStandingOrders not implemented")
+}
+func (UnimplementedLocalChainServer) CancelStandingOrder(context.Context, *CancelStandingOrderRequest) (*CancelStandingOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStandingOrder not implemented")
+}
 func (UnimplementedLocalChainServer) GetBalance(context.Context, *GetBalanceRequest) (*GetBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBalance not implemented")
 }
+func (UnimplementedLocalChainServer) EstimateFee(context.Context, *EstimateFeeRequest) (*EstimateFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateFee not implemented")
+}
 func (UnimplementedLocalChainServer) AddUser(context.Context, *AddUserRequest) (*AddUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddUser not implemented")
 }
 func (UnimplementedLocalChainServer) GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
 }
-func (UnimplementedLocalChainServer) ListUsers(context.Context, *emptypb.Empty) (*ListUsersResponse, error) {
+func (UnimplementedLocalChainServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
 }
+func (UnimplementedLocalChainServer) UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedLocalChainServer) DisableUser(context.Context, *DisableUserRequest) (*DisableUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableUser not implemented")
+}
+func (UnimplementedLocalChainServer) EnableUser(context.Context, *EnableUserRequest) (*EnableUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableUser not implemented")
+}
+func (UnimplementedLocalChainServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
+}
+func (UnimplementedLocalChainServer) UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlockUser not implemented")
+}
+func (UnimplementedLocalChainServer) LockUser(context.Context, *LockUserRequest) (*LockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockUser not implemented")
+}
+func (UnimplementedLocalChainServer) RotateUserKey(context.Context, *RotateUserKeyRequest) (*RotateUserKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateUserKey not implemented")
+}
+func (UnimplementedLocalChainServer) CreateWallet(context.Context, *CreateWalletRequest) (*CreateWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWallet not implemented")
+}
+func (UnimplementedLocalChainServer) DeriveAddress(context.Context, *DeriveAddressRequest) (*DeriveAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeriveAddress not implemented")
+}
+func (UnimplementedLocalChainServer) SendFromWallet(context.Context, *SendFromWalletRequest) (*SendFromWalletResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendFromWallet not implemented")
+}
 func (UnimplementedLocalChainServer) GetBlockKeys(context.Context, *emptypb.Empty) (*GetBlockKeysResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlockKeys not implemented")
 }
@@ -297,97 +765,691 @@ func _LocalChain_AddTransaction_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LocalChain_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBalanceRequest)
+func _LocalChain_AddBatchTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBatchTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LocalChainServer).GetBalance(ctx, in)
+		return srv.(LocalChainServer).AddBatchTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/LocalChain/GetBalance",
+		FullMethod: "/LocalChain/AddBatchTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LocalChainServer).GetBalance(ctx, req.(*GetBalanceRequest))
+		return srv.(LocalChainServer).AddBatchTransaction(ctx, req.(*AddBatchTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LocalChain_AddUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddUserRequest)
+func _LocalChain_SubmitSignedTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSignedTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LocalChainServer).AddUser(ctx, in)
+		return srv.(LocalChainServer).SubmitSignedTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/LocalChain/AddUser",
+		FullMethod: "/LocalChain/SubmitSignedTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LocalChainServer).AddUser(ctx, req.(*AddUserRequest))
+		return srv.(LocalChainServer).SubmitSignedTransaction(ctx, req.(*SubmitSignedTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LocalChain_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _LocalChain_CreateMultisigTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMultisigTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LocalChainServer).GetUser(ctx, in)
+		return srv.(LocalChainServer).CreateMultisigTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/LocalChain/GetUser",
+		FullMethod: "/LocalChain/CreateMultisigTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LocalChainServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(LocalChainServer).CreateMultisigTransaction(ctx, req.(*CreateMultisigTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LocalChain_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _LocalChain_SubmitPartiallySignedTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitPartiallySignedTransactionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LocalChainServer).ListUsers(ctx, in)
+		return srv.(LocalChainServer).SubmitPartiallySignedTransaction(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/LocalChain/ListUsers",
+		FullMethod: "/LocalChain/SubmitPartiallySignedTransaction",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LocalChainServer).ListUsers(ctx, req.(*emptypb.Empty))
+		return srv.(LocalChainServer).SubmitPartiallySignedTransaction(ctx, req.(*SubmitPartiallySignedTransactionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LocalChain_GetBlockKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _LocalChain_CreateHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateHTLCRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LocalChainServer).GetBlockKeys(ctx, in)
+		return srv.(LocalChainServer).CreateHTLC(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/LocalChain/GetBlockKeys",
+		FullMethod: "/LocalChain/CreateHTLC",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LocalChainServer).GetBlockKeys(ctx, req.(*emptypb.Empty))
+		return srv.(LocalChainServer).CreateHTLC(ctx, req.(*CreateHTLCRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LocalChain_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _LocalChain_ClaimHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimHTLCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).ClaimHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/ClaimHTLC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).ClaimHTLC(ctx, req.(*ClaimHTLCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_RefundHTLC_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundHTLCRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).RefundHTLC(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/RefundHTLC",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).RefundHTLC(ctx, req.(*RefundHTLCRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_Notarize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotarizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).Notarize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/Notarize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).Notarize(ctx, req.(*NotarizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_ProveNotarization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProveNotarizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).ProveNotarization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/ProveNotarization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).ProveNotarization(ctx, req.(*ProveNotarizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_IssueAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).IssueAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/IssueAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).IssueAsset(ctx, req.(*IssueAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_ListAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).ListAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/ListAssets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).ListAssets(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_MintToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MintTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).MintToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/MintToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).MintToken(ctx, req.(*MintTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_TransferToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).TransferToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/TransferToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).TransferToken(ctx, req.(*TransferTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_GetToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).GetToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/GetToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).GetToken(ctx, req.(*GetTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_CreateEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).CreateEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/CreateEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).CreateEscrow(ctx, req.(*CreateEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_ReleaseEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).ReleaseEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/ReleaseEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).ReleaseEscrow(ctx, req.(*ReleaseEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_DisputeEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisputeEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).DisputeEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/DisputeEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).DisputeEscrow(ctx, req.(*DisputeEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_RefundEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).RefundEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/RefundEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).RefundEscrow(ctx, req.(*RefundEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_ListEscrows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEscrowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).ListEscrows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/ListEscrows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).ListEscrows(ctx, req.(*ListEscrowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_CreateStandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStandingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).CreateStandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/CreateStandingOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).CreateStandingOrder(ctx, req.(*CreateStandingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_ListStandingOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStandingOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).ListStandingOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/ListStandingOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).ListStandingOrders(ctx, req.(*ListStandingOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_CancelStandingOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelStandingOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).CancelStandingOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/CancelStandingOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).CancelStandingOrder(ctx, req.(*CancelStandingOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_GetBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).GetBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/GetBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).GetBalance(ctx, req.(*GetBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_EstimateFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EstimateFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).EstimateFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/EstimateFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).EstimateFee(ctx, req.(*EstimateFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_AddUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).AddUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/AddUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).AddUser(ctx, req.(*AddUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/GetUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/UpdateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_DisableUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).DisableUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/DisableUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).DisableUser(ctx, req.(*DisableUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_EnableUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).EnableUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/EnableUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).EnableUser(ctx, req.(*EnableUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).DeleteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/DeleteUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).DeleteUser(ctx, req.(*DeleteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_UnlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).UnlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/UnlockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).UnlockUser(ctx, req.(*UnlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_LockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).LockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/LockUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).LockUser(ctx, req.(*LockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_RotateUserKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateUserKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).RotateUserKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/RotateUserKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).RotateUserKey(ctx, req.(*RotateUserKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_CreateWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).CreateWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/CreateWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).CreateWallet(ctx, req.(*CreateWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_DeriveAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeriveAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).DeriveAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/DeriveAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).DeriveAddress(ctx, req.(*DeriveAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_SendFromWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendFromWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).SendFromWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/SendFromWallet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).SendFromWallet(ctx, req.(*SendFromWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_GetBlockKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocalChainServer).GetBlockKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/LocalChain/GetBlockKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocalChainServer).GetBlockKeys(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocalChain_GetBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetBlockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
@@ -464,10 +1526,102 @@ var LocalChain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddTransaction",
 			Handler:    _LocalChain_AddTransaction_Handler,
 		},
+		{
+			MethodName: "AddBatchTransaction",
+			Handler:    _LocalChain_AddBatchTransaction_Handler,
+		},
+		{
+			MethodName: "SubmitSignedTransaction",
+			Handler:    _LocalChain_SubmitSignedTransaction_Handler,
+		},
+		{
+			MethodName: "CreateMultisigTransaction",
+			Handler:    _LocalChain_CreateMultisigTransaction_Handler,
+		},
+		{
+			MethodName: "SubmitPartiallySignedTransaction",
+			Handler:    _LocalChain_SubmitPartiallySignedTransaction_Handler,
+		},
+		{
+			MethodName: "CreateHTLC",
+			Handler:    _LocalChain_CreateHTLC_Handler,
+		},
+		{
+			MethodName: "ClaimHTLC",
+			Handler:    _LocalChain_ClaimHTLC_Handler,
+		},
+		{
+			MethodName: "RefundHTLC",
+			Handler:    _LocalChain_RefundHTLC_Handler,
+		},
+		{
+			MethodName: "Notarize",
+			Handler:    _LocalChain_Notarize_Handler,
+		},
+		{
+			MethodName: "ProveNotarization",
+			Handler:    _LocalChain_ProveNotarization_Handler,
+		},
+		{
+			MethodName: "IssueAsset",
+			Handler:    _LocalChain_IssueAsset_Handler,
+		},
+		{
+			MethodName: "ListAssets",
+			Handler:    _LocalChain_ListAssets_Handler,
+		},
+		{
+			MethodName: "MintToken",
+			Handler:    _LocalChain_MintToken_Handler,
+		},
+		{
+			MethodName: "TransferToken",
+			Handler:    _LocalChain_TransferToken_Handler,
+		},
+		{
+			MethodName: "GetToken",
+			Handler:    _LocalChain_GetToken_Handler,
+		},
+		{
+			MethodName: "CreateEscrow",
+			Handler:    _LocalChain_CreateEscrow_Handler,
+		},
+		{
+			MethodName: "ReleaseEscrow",
+			Handler:    _LocalChain_ReleaseEscrow_Handler,
+		},
+		{
+			MethodName: "DisputeEscrow",
+			Handler:    _LocalChain_DisputeEscrow_Handler,
+		},
+		{
+			MethodName: "RefundEscrow",
+			Handler:    _LocalChain_RefundEscrow_Handler,
+		},
+		{
+			MethodName: "ListEscrows",
+			Handler:    _LocalChain_ListEscrows_Handler,
+		},
+		{
+			MethodName: "CreateStandingOrder",
+			Handler:    _LocalChain_CreateStandingOrder_Handler,
+		},
+		{
+			MethodName: "ListStandingOrders",
+			Handler:    _LocalChain_ListStandingOrders_Handler,
+		},
+		{
+			MethodName: "CancelStandingOrder",
+			Handler:    _LocalChain_CancelStandingOrder_Handler,
+		},
 		{
 			MethodName: "GetBalance",
 			Handler:    _LocalChain_GetBalance_Handler,
 		},
+		{
+			MethodName: "EstimateFee",
+			Handler:    _LocalChain_EstimateFee_Handler,
+		},
 		{
 			MethodName: "AddUser",
 			Handler:    _LocalChain_AddUser_Handler,
@@ -480,6 +1634,46 @@ var LocalChain_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListUsers",
 			Handler:    _LocalChain_ListUsers_Handler,
 		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _LocalChain_UpdateUser_Handler,
+		},
+		{
+			MethodName: "DisableUser",
+			Handler:    _LocalChain_DisableUser_Handler,
+		},
+		{
+			MethodName: "EnableUser",
+			Handler:    _LocalChain_EnableUser_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _LocalChain_DeleteUser_Handler,
+		},
+		{
+			MethodName: "UnlockUser",
+			Handler:    _LocalChain_UnlockUser_Handler,
+		},
+		{
+			MethodName: "LockUser",
+			Handler:    _LocalChain_LockUser_Handler,
+		},
+		{
+			MethodName: "RotateUserKey",
+			Handler:    _LocalChain_RotateUserKey_Handler,
+		},
+		{
+			MethodName: "CreateWallet",
+			Handler:    _LocalChain_CreateWallet_Handler,
+		},
+		{
+			MethodName: "DeriveAddress",
+			Handler:    _LocalChain_DeriveAddress_Handler,
+		},
+		{
+			MethodName: "SendFromWallet",
+			Handler:    _LocalChain_SendFromWallet_Handler,
+		},
 		{
 			MethodName: "GetBlockKeys",
 			Handler:    _LocalChain_GetBlockKeys_Handler,